@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"log"
 	"net/http"
 	"os"
@@ -12,10 +13,13 @@ import (
 	firebase "firebase.google.com/go/v4"
 
 	"github.com/dokkiitech/grumble-back/internal/api"
+	"github.com/dokkiitech/grumble-back/internal/app"
 	"github.com/dokkiitech/grumble-back/internal/config"
 	"github.com/dokkiitech/grumble-back/internal/controller"
 	"github.com/dokkiitech/grumble-back/internal/controller/middleware"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
 	"github.com/dokkiitech/grumble-back/internal/infrastructure"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
 	"github.com/gin-contrib/cors"
@@ -82,54 +86,130 @@ func main() {
 	virtueService := sharedservice.NewVirtueService()
 	eventTimeService := sharedservice.NewEventTimeService()
 
-	// Initialize repositories
-	grumbleRepo := infrastructure.NewPostgresGrumbleRepository(dbPool, eventTimeService)
-	userRepo := infrastructure.NewPostgresUserRepository(dbPool)
-	vibeRepo := infrastructure.NewPostgresVibeRepository(dbPool)
+	// Initialize repositories and the moderation shared with the batch worker
+	repos := app.NewRepositories(dbPool)
+	moderation, err := app.NewModeration(cfg, repos, logger)
+	if err != nil {
+		logger.Error("Failed to initialize moderation", "error", err)
+		log.Fatalf("Moderation error: %v", err)
+	}
+	policies := app.NewPolicies(cfg)
+
+	var moderationCacheStats controller.ModerationCacheStatsProvider
+	if moderation.Cache != nil {
+		moderationCacheStats = moderation.Cache
+	}
+
+	var rewriteSuggester grumble.RewriteSuggester
+	if cfg.ModerationRewriteSuggestions {
+		rewriteSuggester = moderation.LLM
+	}
 
-	// Initialize content filter client
-	geminiClient := infrastructure.NewGeminiClient(cfg.GeminiAPIKey, cfg.GeminiModel)
+	var topicSuggester grumble.TopicSuggester
+	if cfg.GrumbleTopicSuggestions {
+		topicSuggester = moderation.LLM
+	}
+
+	// Lifetimes authors may choose when posting, with a minimum guaranteed even just before midnight
+	lifetimePolicy, err := grumble.NewLifetimePolicy(
+		cfg.GrumbleLifetimes,
+		cfg.GrumbleLifetimeDefault,
+		time.Duration(cfg.GrumbleMinLifetimeMinutes)*time.Minute,
+	)
+	if err != nil {
+		logger.Error("Invalid grumble lifetime configuration", "error", err)
+		log.Fatalf("Grumble lifetime error: %v", err)
+	}
+
+	// Life areas authors may file grumbles under
+	categories, err := grumble.NewCategoryCatalogue(cfg.GrumbleCategories)
+	if err != nil {
+		logger.Error("Invalid grumble category configuration", "error", err)
+		log.Fatalf("Grumble category error: %v", err)
+	}
 
 	// Initialize use cases
+	sanctionUC := usecase.NewSanctionUseCase(repos.Sanction, repos.Verdict, repos.Notification, repos.Audit, repos.Transactor, policies.Sanction, logger)
 	grumblePostUC := usecase.NewGrumblePostUseCase(
-		grumbleRepo,
+		repos.Grumble,
 		eventTimeService,
-		geminiClient,
+		moderation.Filter,
+		moderation.Prompts,
+		repos.Verdict,
+		rewriteSuggester,
+		topicSuggester,
+		sanctionUC,
+		repos.PostLog,
+		policies.Spam,
+		lifetimePolicy,
+		categories,
+		time.Duration(cfg.GrumbleEditWindowSeconds)*time.Second,
+		cfg.ModerationMode == config.ModerationModeAsync,
+		policies.ToxicLevel,
 		cfg.PurificationThresholdDefault,
 		cfg.PurificationThresholdMin,
 		cfg.PurificationThresholdMax,
+		policies.CrisisSupport,
+		logger,
 	)
-	timelineGetUC := usecase.NewTimelineGetUseCase(grumbleRepo)
-	eventGrumblesGetUC := usecase.NewEventGrumblesGetUseCase(grumbleRepo, eventTimeService)
-	authAnonymousUC := usecase.NewAuthAnonymousUseCase(userRepo)
-	userQueryUC := usecase.NewUserQueryUseCase(userRepo)
-	vibeAddUC := usecase.NewVibeAddUseCase(grumbleRepo, vibeRepo, userRepo, purifyService, virtueService)
-	statsUC := usecase.NewGrumbleStatsUseCase(grumbleRepo, "Asia/Tokyo", false)
+	grumbleGetUC := usecase.NewGrumbleGetUseCase(repos.Grumble, repos.Vibe)
+	grumbleDeleteUC := usecase.NewGrumbleDeleteUseCase(repos.Grumble, logger)
+	timelineGetUC := usecase.NewTimelineGetUseCase(repos.Grumble, categories)
+	eventGrumblesGetUC := usecase.NewEventGrumblesGetUseCase(repos.Grumble, eventTimeService)
+	authAnonymousUC := usecase.NewAuthAnonymousUseCase(repos.User)
+	userQueryUC := usecase.NewUserQueryUseCase(repos.User)
+	userSettingsUC := usecase.NewUserSettingsUseCase(repos.User, logger)
+	vibeAddUC := usecase.NewVibeAddUseCase(repos.Grumble, repos.Vibe, repos.User, purifyService, virtueService)
+	statsUC := usecase.NewGrumbleStatsUseCase(repos.Grumble, categories, cfg.StatsTimezone, cfg.StatsWeekStartsOnSunday)
+	moderationReviewUC := usecase.NewModerationReviewUseCase(repos.Verdict, repos.Audit, repos.Transactor, logger)
+	notificationListUC := usecase.NewNotificationListUseCase(repos.Notification)
+	grumbleReportUC := usecase.NewGrumbleReportUseCase(repos.Grumble, repos.Report, repos.Audit, repos.Transactor, cfg.ReportHideThreshold, logger)
+	moderationAppealUC := usecase.NewModerationAppealUseCase(repos.Grumble, repos.Verdict, repos.Appeal, repos.Notification, repos.Audit, repos.Transactor, eventTimeService, lifetimePolicy, logger)
+	adminModerationUC := usecase.NewAdminModerationUseCase(repos.Grumble, repos.User, repos.Audit, repos.Transactor, logger)
+
+	// Author pseudonyms must stay stable across restarts, so the secret belongs in configuration
+	pseudonymSecret := []byte(cfg.PseudonymSecret)
+	if len(pseudonymSecret) == 0 {
+		logger.Warn("PSEUDONYM_SECRET is not set; author handles will change on every restart")
+		pseudonymSecret = make([]byte, 32)
+		if _, err := rand.Read(pseudonymSecret); err != nil {
+			log.Fatalf("Failed to generate pseudonym secret: %v", err)
+		}
+	}
 
 	// Initialize presenters
-	grumblePresenter := controller.NewGrumblePresenter()
+	grumblePresenter := controller.NewGrumblePresenter(grumble.NewAuthorHandles(pseudonymSecret))
 	timelinePresenter := controller.NewTimelinePresenter(grumblePresenter)
+	moderationPresenter := controller.NewModerationPresenter()
+	adminPresenter := controller.NewAdminPresenter()
 
 	// Initialize controllers
-	grumbleController := controller.NewGrumbleController(grumblePostUC, grumblePresenter, logger)
+	grumbleController := controller.NewGrumbleController(grumblePostUC, grumbleGetUC, grumbleDeleteUC, grumblePresenter, logger)
 	timelineController := controller.NewTimelineController(timelineGetUC, timelinePresenter, logger)
 	eventGrumblesController := controller.NewEventGrumblesController(eventGrumblesGetUC, grumblePresenter, logger)
 	statsController := controller.NewGrumbleStatsController(statsUC, logger)
 	authController := controller.NewAuthController(
 		authAnonymousUC,
 		userQueryUC,
+		userSettingsUC,
 		logger,
 		cfg.BodhisattvaRankingLimitDefault,
 		cfg.BodhisattvaRankingLimitMin,
 		cfg.BodhisattvaRankingLimitMax,
 	)
 	vibeController := controller.NewVibeController(vibeAddUC, logger)
+	moderationController := controller.NewModerationController(moderationReviewUC, moderationPresenter, moderationCacheStats, logger)
+	notificationController := controller.NewNotificationController(notificationListUC, logger)
+	appealController := controller.NewAppealController(moderationAppealUC, moderationPresenter, logger)
+	reportController := controller.NewReportController(grumbleReportUC, moderationPresenter, logger)
+	adminController := controller.NewAdminController(adminModerationUC, sanctionUC, adminPresenter, logger)
 
 	// Initialize middleware
-	authMiddleware := middleware.NewAuthMiddleware(authClient, authAnonymousUC, logger)
+	authMiddleware := middleware.NewAuthMiddleware(authClient, authAnonymousUC, sanctionUC, logger)
+	adminMiddleware := middleware.NewAdminMiddleware(cfg.AdminUserIDs, cfg.ModeratorUserIDs, logger)
 
 	// Create strict server implementation that combines all controllers
-	strictServer := api.NewStrictControllerServer(grumbleController, timelineController, authController, vibeController, eventGrumblesController, statsController, logger)
+	strictServer := api.NewStrictControllerServer(grumbleController, timelineController, authController, vibeController, eventGrumblesController, statsController, moderationController, notificationController, appealController, reportController, adminController, logger)
 	serverImpl := api.NewStrictHandler(strictServer, nil)
 
 	// Setup Gin router
@@ -162,6 +242,11 @@ func main() {
 	// For MVP, we'll apply it globally for simplicity
 	router.Use(authMiddleware.Authenticate())
 
+	// Admin routes: moderators can work the queues; virtue adjustments and the audit log need an admin
+	router.Use(adminMiddleware.RequireRole("/api/v1/admin", user.RoleModerator))
+	router.Use(adminMiddleware.RequireRole("/api/v1/admin/users", user.RoleAdmin))
+	router.Use(adminMiddleware.RequireRole("/api/v1/admin/audit-log", user.RoleAdmin))
+
 	// Register OpenAPI routes with /api/v1 prefix
 	api.RegisterHandlersWithOptions(router, serverImpl, api.GinServerOptions{
 		BaseURL: "/api/v1",
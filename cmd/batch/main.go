@@ -8,8 +8,8 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/dokkiitech/grumble-back/internal/app"
 	"github.com/dokkiitech/grumble-back/internal/config"
-	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
 	"github.com/dokkiitech/grumble-back/internal/infrastructure"
 	"github.com/dokkiitech/grumble-back/internal/job"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
@@ -17,7 +17,7 @@ import (
 )
 
 func main() {
-	mode := flag.String("mode", "cron", "batch mode: cron|purge-expired")
+	mode := flag.String("mode", "cron", "batch mode: cron|purge-expired|moderate-pending")
 	flag.Parse()
 
 	cfg, err := config.LoadConfig()
@@ -41,11 +41,30 @@ func main() {
 		log.Fatalf("DB ping error: %v", err)
 	}
 
-	// Initialize domain service
-	eventTimeService := sharedservice.NewEventTimeService()
+	repos := app.NewRepositories(dbPool)
+	moderation, err := app.NewModeration(cfg, repos, logger)
+	if err != nil {
+		logger.Error("Failed to initialize moderation", "error", err)
+		log.Fatalf("Moderation error: %v", err)
+	}
+	policies := app.NewPolicies(cfg)
 
-	grumbleRepo := infrastructure.NewPostgresGrumbleRepository(dbPool, eventTimeService)
-	purgeUC := usecase.NewPurgeExpiredUseCase(grumbleRepo, logger)
+	sanctionUC := usecase.NewSanctionUseCase(repos.Sanction, repos.Verdict, repos.Notification, repos.Audit, repos.Transactor, policies.Sanction, logger)
+
+	// Expired cache entries are pruned even when persistence was turned off after they were written
+	purgeUC := usecase.NewPurgeExpiredUseCase(repos.Grumble, repos.PostLog, policies.Spam.Lookback(), repos.ModerationCache, logger)
+	moderatePendingUC := usecase.NewModeratePendingUseCase(
+		repos.Grumble,
+		moderation.Filter,
+		moderation.Prompts,
+		repos.Verdict,
+		repos.Notification,
+		sanctionUC,
+		policies.CrisisSupport,
+		policies.ToxicLevel,
+		cfg.ModerationBatchSize,
+		logger,
+	)
 
 	switch *mode {
 	case "purge-expired":
@@ -55,9 +74,23 @@ func main() {
 			os.Exit(1)
 		}
 		logger.Info("purge-expired completed")
+	case "moderate-pending":
+		// Run once
+		count, err := moderatePendingUC.ModeratePending(ctx)
+		if err != nil {
+			logger.Error("moderate-pending failed", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("moderate-pending completed", "moderated_count", count)
 	case "cron":
+		// Moderation worker only runs when posts are moderated asynchronously
+		var moderatePendingJob *job.ModeratePendingJob
+		if cfg.ModerationMode == config.ModerationModeAsync {
+			moderatePendingJob = job.NewModeratePendingJob(moderatePendingUC, logger)
+		}
+
 		// Start scheduler
-		scheduler := job.NewCronScheduler(job.NewPurgeExpiredJob(purgeUC, logger), logger)
+		scheduler := job.NewCronScheduler(job.NewPurgeExpiredJob(purgeUC, logger), moderatePendingJob, logger)
 		if err := scheduler.Start(); err != nil {
 			logger.Error("Scheduler start failed", "error", err)
 			os.Exit(1)
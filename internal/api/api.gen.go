@@ -29,12 +29,39 @@ const (
 	AnonymousUserVirtueRankN3    AnonymousUserVirtueRank = "大菩薩"
 )
 
+// Defines values for AuditAction.
+const (
+	AuditActionAppealResolve   AuditAction = "appeal_resolve"
+	AuditActionGrumbleRestore  AuditAction = "grumble_restore"
+	AuditActionGrumbleTakedown AuditAction = "grumble_takedown"
+	AuditActionReportsResolve  AuditAction = "reports_resolve"
+	AuditActionSanctionIssue   AuditAction = "sanction_issue"
+	AuditActionSanctionRevoke  AuditAction = "sanction_revoke"
+	AuditActionVerdictReview   AuditAction = "verdict_review"
+	AuditActionVirtueAdjust    AuditAction = "virtue_adjust"
+)
+
 // Defines values for EventEventType.
 const (
 	EventEventTypeDAIONRYO  EventEventType = "DAIONRYO"
 	EventEventTypeOTAKINAGE EventEventType = "OTAKINAGE"
 )
 
+// Defines values for GrumbleModerationStatus.
+const (
+	GrumbleModerationStatusHeld      GrumbleModerationStatus = "held"
+	GrumbleModerationStatusPending   GrumbleModerationStatus = "pending"
+	GrumbleModerationStatusPublished GrumbleModerationStatus = "published"
+)
+
+// Defines values for GrumbleStatus.
+const (
+	GrumbleStatusActive   GrumbleStatus = "active"
+	GrumbleStatusExpired  GrumbleStatus = "expired"
+	GrumbleStatusPending  GrumbleStatus = "pending"
+	GrumbleStatusPurified GrumbleStatus = "purified"
+)
+
 // Defines values for GrumbleVibeRank.
 const (
 	GrumbleVibeRankEmpty GrumbleVibeRank = "見習い行者"
@@ -43,11 +70,128 @@ const (
 	GrumbleVibeRankN3    GrumbleVibeRank = "大菩薩"
 )
 
+// Defines values for GrumbleTagSource.
+const (
+	GrumbleTagSourceHashtag GrumbleTagSource = "hashtag"
+	GrumbleTagSourceTopic   GrumbleTagSource = "topic"
+)
+
+// Defines values for ModerationAppealStatus.
+const (
+	ModerationAppealStatusApproved ModerationAppealStatus = "approved"
+	ModerationAppealStatusDenied   ModerationAppealStatus = "denied"
+	ModerationAppealStatusPending  ModerationAppealStatus = "pending"
+)
+
+// Defines values for ModerationCategory.
+const (
+	ModerationCategoryDiscrimination ModerationCategory = "discrimination"
+	ModerationCategoryHarassment     ModerationCategory = "harassment"
+	ModerationCategoryIllegal        ModerationCategory = "illegal"
+	ModerationCategoryPersonalInfo   ModerationCategory = "personal_info"
+	ModerationCategorySelfHarm       ModerationCategory = "self_harm"
+)
+
+// Defines values for ModerationVerdictDecision.
+const (
+	ModerationVerdictDecisionApproved ModerationVerdictDecision = "approved"
+	ModerationVerdictDecisionHeld     ModerationVerdictDecision = "held"
+	ModerationVerdictDecisionRejected ModerationVerdictDecision = "rejected"
+)
+
+// Defines values for ModerationVerdictReviewLabel.
+const (
+	ModerationVerdictReviewLabelCorrect       ModerationVerdictReviewLabel = "correct"
+	ModerationVerdictReviewLabelFalseNegative ModerationVerdictReviewLabel = "false_negative"
+	ModerationVerdictReviewLabelFalsePositive ModerationVerdictReviewLabel = "false_positive"
+)
+
+// Defines values for NotificationKind.
+const (
+	NotificationKindAppealApproved   NotificationKind = "appeal_approved"
+	NotificationKindAppealDenied     NotificationKind = "appeal_denied"
+	NotificationKindGrumbleHeld      NotificationKind = "grumble_held"
+	NotificationKindGrumbleRejected  NotificationKind = "grumble_rejected"
+	NotificationKindSanctionCooldown NotificationKind = "sanction_cooldown"
+	NotificationKindSanctionWarning  NotificationKind = "sanction_warning"
+)
+
+// Defines values for ReportReason.
+const (
+	ReportReasonDiscrimination ReportReason = "discrimination"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonIllegal        ReportReason = "illegal"
+	ReportReasonOther          ReportReason = "other"
+	ReportReasonPersonalInfo   ReportReason = "personal_info"
+	ReportReasonSelfHarm       ReportReason = "self_harm"
+	ReportReasonSpam           ReportReason = "spam"
+)
+
+// Defines values for ResolveModerationAppealRequestDecision.
+const (
+	ResolveModerationAppealRequestDecisionApproved ResolveModerationAppealRequestDecision = "approved"
+	ResolveModerationAppealRequestDecisionDenied   ResolveModerationAppealRequestDecision = "denied"
+)
+
+// Defines values for ResolveReportsRequestAction.
+const (
+	ResolveReportsRequestActionRemove  ResolveReportsRequestAction = "remove"
+	ResolveReportsRequestActionRestore ResolveReportsRequestAction = "restore"
+)
+
+// Defines values for ReviewModerationVerdictRequestLabel.
+const (
+	ReviewModerationVerdictRequestLabelCorrect       ReviewModerationVerdictRequestLabel = "correct"
+	ReviewModerationVerdictRequestLabelFalseNegative ReviewModerationVerdictRequestLabel = "false_negative"
+	ReviewModerationVerdictRequestLabelFalsePositive ReviewModerationVerdictRequestLabel = "false_positive"
+)
+
+// Defines values for SanctionSource.
+const (
+	SanctionSourceAutomatic SanctionSource = "automatic"
+	SanctionSourceManual    SanctionSource = "manual"
+)
+
+// Defines values for SanctionLevel.
+const (
+	SanctionLevelBan       SanctionLevel = "ban"
+	SanctionLevelCooldown  SanctionLevel = "cooldown"
+	SanctionLevelShadowBan SanctionLevel = "shadow_ban"
+	SanctionLevelWarning   SanctionLevel = "warning"
+)
+
 // Defines values for VibeVibeType.
 const (
 	VibeVibeTypeWAKARU VibeVibeType = "WAKARU"
 )
 
+// Defines values for GetModerationAppealsParamsStatus.
+const (
+	GetModerationAppealsParamsStatusApproved GetModerationAppealsParamsStatus = "approved"
+	GetModerationAppealsParamsStatusDenied   GetModerationAppealsParamsStatus = "denied"
+	GetModerationAppealsParamsStatusPending  GetModerationAppealsParamsStatus = "pending"
+)
+
+// Defines values for GetModerationVerdictsParamsDecision.
+const (
+	GetModerationVerdictsParamsDecisionApproved GetModerationVerdictsParamsDecision = "approved"
+	GetModerationVerdictsParamsDecisionHeld     GetModerationVerdictsParamsDecision = "held"
+	GetModerationVerdictsParamsDecisionRejected GetModerationVerdictsParamsDecision = "rejected"
+)
+
+// Defines values for GetModerationVerdictsParamsReviewLabel.
+const (
+	GetModerationVerdictsParamsReviewLabelCorrect       GetModerationVerdictsParamsReviewLabel = "correct"
+	GetModerationVerdictsParamsReviewLabelFalseNegative GetModerationVerdictsParamsReviewLabel = "false_negative"
+	GetModerationVerdictsParamsReviewLabelFalsePositive GetModerationVerdictsParamsReviewLabel = "false_positive"
+)
+
+// Defines values for GetGrumblesParamsToxicLevelSource.
+const (
+	GetGrumblesParamsToxicLevelSourceAi   GetGrumblesParamsToxicLevelSource = "ai"
+	GetGrumblesParamsToxicLevelSourceSelf GetGrumblesParamsToxicLevelSource = "self"
+)
+
 // Defines values for AddVibeJSONBodyVibeType.
 const (
 	AddVibeJSONBodyVibeTypeWAKARU AddVibeJSONBodyVibeType = "WAKARU"
@@ -60,6 +204,13 @@ const (
 	GetGrumbleStatsParamsGranularityWeek  GetGrumbleStatsParamsGranularity = "week"
 )
 
+// Defines values for GetGrumbleStatsCategoryParamsGranularity.
+const (
+	GetGrumbleStatsCategoryParamsGranularityDay   GetGrumbleStatsCategoryParamsGranularity = "day"
+	GetGrumbleStatsCategoryParamsGranularityMonth GetGrumbleStatsCategoryParamsGranularity = "month"
+	GetGrumbleStatsCategoryParamsGranularityWeek  GetGrumbleStatsCategoryParamsGranularity = "week"
+)
+
 // Defines values for GetGrumbleStatsToxicParamsGranularity.
 const (
 	GetGrumbleStatsToxicParamsGranularityDay   GetGrumbleStatsToxicParamsGranularity = "day"
@@ -67,6 +218,40 @@ const (
 	GetGrumbleStatsToxicParamsGranularityWeek  GetGrumbleStatsToxicParamsGranularity = "week"
 )
 
+// AdjustVirtuePointsRequest defines model for AdjustVirtuePointsRequest.
+type AdjustVirtuePointsRequest struct {
+	// Delta 加算（正）または減算（負）する徳ポイント。0は不可、結果が0未満になる減算も不可
+	Delta int `json:"delta"`
+
+	// Reason 監査ログに残す理由
+	Reason string `json:"reason"`
+}
+
+// AdminActionRequest defines model for AdminActionRequest.
+type AdminActionRequest struct {
+	// Reason 監査ログに残す理由
+	Reason string `json:"reason"`
+}
+
+// AdminGrumble defines model for AdminGrumble.
+type AdminGrumble struct {
+	// AiToxicLevel モデレーションで推定した毒レベル
+	AiToxicLevel *int               `json:"ai_toxic_level,omitempty"`
+	Content      string             `json:"content"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+	GrumbleID    openapi_types.UUID `json:"grumble_id"`
+	IsPurified   bool               `json:"is_purified"`
+
+	// ModerationStatus 公開状態（published / held / pending / rejected / hidden / removed / shadowed）
+	ModerationStatus string    `json:"moderation_status"`
+	PostedAt         time.Time `json:"posted_at"`
+	ToxicLevel       int       `json:"toxic_level"`
+
+	// UserID 投稿者（管理APIでのみ返す）
+	UserID    openapi_types.UUID `json:"user_id"`
+	VibeCount int                `json:"vibe_count"`
+}
+
 // AnonymousUser defines model for AnonymousUser.
 type AnonymousUser struct {
 	// CreatedAt ユーザー作成日時
@@ -75,6 +260,9 @@ type AnonymousUser struct {
 	// ProfileTitle 称号（例：「今週の菩薩」）
 	ProfileTitle nullable.Nullable[string] `json:"profile_title,omitempty"`
 
+	// Timezone 投稿の深夜0時の期限とイベント対象日の判定に使うタイムゾーン（IANA名）。未設定時は Asia/Tokyo
+	Timezone *string `json:"timezone,omitempty"`
+
 	// UserID 匿名ユーザーの一意識別子
 	UserID openapi_types.UUID `json:"user_id"`
 
@@ -88,14 +276,45 @@ type AnonymousUser struct {
 // AnonymousUserVirtueRank わかる数（徳ポイント）に応じたランク
 type AnonymousUserVirtueRank string
 
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action AuditAction `json:"action"`
+
+	// ActorID 操作した管理者
+	ActorID   openapi_types.UUID `json:"actor_id"`
+	CreatedAt time.Time          `json:"created_at"`
+
+	// Detail 操作ごとの詳細（変更前後の状態やポイントなど）
+	Detail  map[string]interface{} `json:"detail"`
+	EntryID int64                  `json:"entry_id"`
+
+	// Reason 操作理由
+	Reason *string `json:"reason,omitempty"`
+
+	// TargetID 操作対象のID
+	TargetID string `json:"target_id"`
+
+	// TargetType 操作対象の種別（grumble / user / verdict / appeal / sanction）
+	TargetType string `json:"target_type"`
+}
+
 // CreateGrumbleRequest defines model for CreateGrumbleRequest.
 type CreateGrumbleRequest struct {
-	// Content 愚痴の本文
+	// Category カテゴリのキー（オプション）。サーバー設定のカテゴリ一覧から選ぶ（既定では work, family, commute, school, relationships, money, health, other）
+	Category *string `json:"category,omitempty"`
+
+	// Content 愚痴の本文。NFKC正規化・不可視文字の除去・連続改行の圧縮・前後の空白除去をした後、書記素クラスタ単位で1〜280文字（絵文字の結合列も1文字と数える）。#今週の理不尽上司 のようなハッシュタグはタグとして登録される
 	Content string `json:"content"`
 
 	// IsEventGrumble イベント投稿か否か
 	IsEventGrumble *bool `json:"is_event_grumble,omitempty"`
 
+	// Lifetime 投稿の寿命（オプション）。サーバー設定で許可された値から選ぶ（既定では 1h, 6h, midnight, 24h）。midnight は投稿者のタイムゾーン（未設定時はJST）で次の深夜0時まで。未指定の場合は既定値。深夜直前の投稿でも最低寿命（既定1時間）は表示される
+	Lifetime *string `json:"lifetime,omitempty"`
+
 	// PurifiedThreshold 成仏するまでに必要な「わかる…」の数（オプション、未指定の場合はデフォルト値）
 	PurifiedThreshold *int `json:"purified_threshold,omitempty"`
 
@@ -103,6 +322,39 @@ type CreateGrumbleRequest struct {
 	ToxicLevel int `json:"toxic_level"`
 }
 
+// CreateReportRequest defines model for CreateReportRequest.
+type CreateReportRequest struct {
+	// Comment 補足（任意）
+	Comment *string `json:"comment,omitempty"`
+
+	// Reason 通報理由
+	Reason ReportReason `json:"reason"`
+}
+
+// CrisisSupportResponse defines model for CrisisSupportResponse.
+type CrisisSupportResponse struct {
+	// Error エラーコード
+	Error string `json:"error"`
+
+	// GrumbleID 非公開で保存された投稿のID
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+
+	// Message 投稿者に寄り添うメッセージ
+	Message string `json:"message"`
+
+	// Resources 相談窓口の一覧
+	Resources []SupportResource `json:"resources"`
+}
+
+// EditGrumbleRequest defines model for EditGrumbleRequest.
+type EditGrumbleRequest struct {
+	// Content 編集後の本文。投稿時と同じ正規化・文字数チェック・モデレーションを行う
+	Content string `json:"content"`
+
+	// ToxicLevel 編集後の毒レベル（1〜5）
+	ToxicLevel int `json:"toxic_level"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Error エラーコード
@@ -144,10 +396,22 @@ type EventEventType string
 
 // Grumble defines model for Grumble.
 type Grumble struct {
-	// Content 愚痴の本文
+	// AiToxicLevel モデレーションで推定した毒レベル（推定できなかった場合は省略）
+	AiToxicLevel *int `json:"ai_toxic_level,omitempty"`
+
+	// AuthorHandle 投稿者の仮名（投稿ごとにサーバー側の秘密鍵から生成。同じ投稿者の別の投稿とは結び付けられない）
+	AuthorHandle string `json:"author_handle"`
+
+	// Category カテゴリのキー（仕事・家庭・通勤・学校など。未選択の場合は省略）
+	Category *string `json:"category,omitempty"`
+
+	// Content 愚痴の本文（正規化済み、書記素クラスタ単位で280文字以内）
 	Content string `json:"content"`
 
-	// ExpiresAt 投稿後24時間後の時刻
+	// EditedAt 最後に編集された時刻（未編集の場合は省略）
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+
+	// ExpiresAt タイムラインから消える時刻（投稿時に選んだ寿命で決まる）
 	ExpiresAt time.Time `json:"expires_at"`
 
 	// GrumbleID 投稿の一意識別子
@@ -156,21 +420,39 @@ type Grumble struct {
 	// HasVibed ログインユーザーが「わかる…」済みか
 	HasVibed *bool `json:"has_vibed,omitempty"`
 
+	// IsEdited 投稿後に投稿者が編集したか（「編集済み」表示用）
+	IsEdited bool `json:"is_edited"`
+
 	// IsEventGrumble イベント投稿か否か
 	IsEventGrumble bool `json:"is_event_grumble"`
 
+	// IsMine ログインユーザー自身の投稿か
+	IsMine bool `json:"is_mine"`
+
 	// IsPurified 成仏フラグ
 	IsPurified bool `json:"is_purified"`
 
+	// ModerationStatus 公開状態（held と pending は投稿者本人のみ閲覧可。pending は非同期モデレーション待ち）
+	ModerationStatus *GrumbleModerationStatus `json:"moderation_status,omitempty"`
+
 	// PostedAt 投稿時刻
 	PostedAt time.Time `json:"posted_at"`
 
 	// PurifiedThreshold 成仏するまでに必要な「わかる…」の数
 	PurifiedThreshold int `json:"purified_threshold"`
 
-	// ToxicLevel 毒レベル（1〜5）
+	// Status ライフサイクル状態（pending は非同期モデレーション待ち、purified は成仏済み、expired は寿命を過ぎてアーカイブされた投稿。expired は詳細取得やイベントアーカイブで返る）
+	Status GrumbleStatus `json:"status"`
+
+	// Tags 本文のハッシュタグ（出現順、最大5件）。ハッシュタグがない場合はAIが提案したテーマ（サーバー設定で有効な場合のみ）
+	Tags []GrumbleTag `json:"tags"`
+
+	// ToxicLevel 毒レベル（1〜5、投稿者の自己申告）
 	ToxicLevel int `json:"toxic_level"`
 
+	// ToxicLevelMismatch 投稿作成時のみ。自己申告の毒レベルが推定値と大きく離れている（ai_toxic_level を案内する）
+	ToxicLevelMismatch *bool `json:"toxic_level_mismatch,omitempty"`
+
 	// VibeCount 「わかる…」の総数
 	VibeCount int `json:"vibe_count"`
 
@@ -178,9 +460,27 @@ type Grumble struct {
 	VibeRank *GrumbleVibeRank `json:"vibe_rank,omitempty"`
 }
 
+// GrumbleModerationStatus 公開状態（held と pending は投稿者本人のみ閲覧可。pending は非同期モデレーション待ち）
+type GrumbleModerationStatus string
+
+// GrumbleStatus ライフサイクル状態（pending は非同期モデレーション待ち、purified は成仏済み、expired は寿命を過ぎてアーカイブされた投稿。expired は詳細取得やイベントアーカイブで返る）
+type GrumbleStatus string
+
 // GrumbleVibeRank 「わかる…」の数に応じたランク
 type GrumbleVibeRank string
 
+// GrumbleInspection defines model for GrumbleInspection.
+type GrumbleInspection struct {
+	Author AnonymousUser `json:"author"`
+
+	// AuthorRecentGrumbles 投稿者の最近の投稿（全状態、新しい順に最大20件）
+	AuthorRecentGrumbles []AdminGrumble `json:"author_recent_grumbles"`
+
+	// AuthorStatusCounts 投稿者の公開状態ごとの投稿数（アーカイブ済みを含む）
+	AuthorStatusCounts map[string]int `json:"author_status_counts"`
+	Grumble            AdminGrumble   `json:"grumble"`
+}
+
 // GrumbleStatsBucket defines model for GrumbleStatsBucket.
 type GrumbleStatsBucket struct {
 	// Bucket 集計バケット開始時刻（UTC）
@@ -196,6 +496,24 @@ type GrumbleStatsBucket struct {
 	UnpurifiedCount int `json:"unpurified_count"`
 }
 
+// GrumbleStatsCategoryBucket defines model for GrumbleStatsCategoryBucket.
+type GrumbleStatsCategoryBucket struct {
+	// Bucket 集計バケット開始時刻（UTC）
+	Bucket time.Time `json:"bucket"`
+
+	// Category カテゴリのキー（カテゴリ未選択の投稿の集計では省略）
+	Category *string `json:"category,omitempty"`
+
+	// PurifiedCount 成仏済み件数
+	PurifiedCount int `json:"purified_count"`
+
+	// TotalVibes いいね（vibe_count）の合計
+	TotalVibes int `json:"total_vibes"`
+
+	// UnpurifiedCount 未成仏件数
+	UnpurifiedCount int `json:"unpurified_count"`
+}
+
 // GrumbleStatsToxicBucket defines model for GrumbleStatsToxicBucket.
 type GrumbleStatsToxicBucket struct {
 	// Bucket 集計バケット開始時刻（UTC）
@@ -214,6 +532,292 @@ type GrumbleStatsToxicBucket struct {
 	UnpurifiedCount int `json:"unpurified_count"`
 }
 
+// GrumbleTag defines model for GrumbleTag.
+type GrumbleTag struct {
+	// Name タグ名（# なし。NFKC正規化・小文字化済み）
+	Name string `json:"name"`
+
+	// Source hashtag は投稿者が本文に書いたタグ、topic はAIが提案したテーマ
+	Source GrumbleTagSource `json:"source"`
+}
+
+// GrumbleTagSource hashtag は投稿者が本文に書いたタグ、topic はAIが提案したテーマ
+type GrumbleTagSource string
+
+// InappropriateContentResponse defines model for InappropriateContentResponse.
+type InappropriateContentResponse struct {
+	// Error エラーコード
+	Error string `json:"error"`
+
+	// GrumbleID 非公開で保存された投稿のID。判定に納得できない場合は異議申し立てに使う
+	GrumbleID *openapi_types.UUID `json:"grumble_id,omitempty"`
+
+	// Message エラーメッセージ（不適切判定時は判定理由）
+	Message string `json:"message"`
+
+	// SuggestedRewrite 不満はそのままに不適切な部分を取り除いた書き換え案（モデレーション通過済み）。そのまま投稿できる
+	SuggestedRewrite *string `json:"suggested_rewrite,omitempty"`
+}
+
+// IssueSanctionRequest defines model for IssueSanctionRequest.
+type IssueSanctionRequest struct {
+	// DurationMinutes 有効期間（分）。省略または0は解除されるまで無期限。cooldown では必須
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+
+	// Level 制裁の段階（警告 / 投稿クールダウン / シャドウBAN / BAN）
+	Level SanctionLevel `json:"level"`
+
+	// Reason 監査ログと制裁記録に残す理由
+	Reason string `json:"reason"`
+}
+
+// ModerationAppeal defines model for ModerationAppeal.
+type ModerationAppeal struct {
+	// AppealID 異議申し立ての一意識別子
+	AppealID int64 `json:"appeal_id"`
+
+	// Content 申し立て時点の投稿本文
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// GrumbleID 拒否された投稿のID
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+
+	// RejectionReason 拒否時の判定理由
+	RejectionReason string `json:"rejection_reason"`
+
+	// ResolutionNote モデレーターのメモ
+	ResolutionNote *string             `json:"resolution_note,omitempty"`
+	ResolvedAt     *time.Time          `json:"resolved_at,omitempty"`
+	ResolvedBy     *openapi_types.UUID `json:"resolved_by,omitempty"`
+
+	// Status 審査状況
+	Status ModerationAppealStatus `json:"status"`
+
+	// UserID 投稿者のユーザーID
+	UserID openapi_types.UUID `json:"user_id"`
+
+	// VerdictID 拒否した判定ログのID
+	VerdictID *int64 `json:"verdict_id,omitempty"`
+}
+
+// ModerationAppealStatus 審査状況
+type ModerationAppealStatus string
+
+// ModerationCacheStats defines model for ModerationCacheStats.
+type ModerationCacheStats struct {
+	// Enabled キャッシュが有効か
+	Enabled bool `json:"enabled"`
+
+	// Entries プロセス内キャッシュのエントリ数
+	Entries int `json:"entries"`
+
+	// HitRate ヒット率（0〜1）
+	HitRate float64 `json:"hit_rate"`
+
+	// Hits プロセス起動以降のキャッシュヒット数
+	Hits int64 `json:"hits"`
+
+	// Misses プロセス起動以降のキャッシュミス数（LLM 呼び出し数）
+	Misses int64 `json:"misses"`
+}
+
+// ModerationCategory モデレーションで検出されたカテゴリ
+type ModerationCategory string
+
+// ModerationVerdict defines model for ModerationVerdict.
+type ModerationVerdict struct {
+	Categories []ModerationCategory `json:"categories"`
+
+	// ContentHash 投稿本文のSHA-256ハッシュ
+	ContentHash string    `json:"content_hash"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Decision 適用された判定
+	Decision ModerationVerdictDecision `json:"decision"`
+
+	// GrumbleID 保存された投稿のID（拒否された場合は無し）
+	GrumbleID *openapi_types.UUID `json:"grumble_id,omitempty"`
+
+	// LatencyMs 判定にかかった時間（ミリ秒）
+	LatencyMs int `json:"latency_ms"`
+
+	// Model 判定に使ったモデル名
+	Model string `json:"model"`
+
+	// PromptVersion 判定に使ったプロンプトのバージョン
+	PromptVersion string `json:"prompt_version"`
+
+	// Reason モデルが出力した理由
+	Reason string `json:"reason"`
+
+	// ReviewLabel モデレーターによる評価
+	ReviewLabel *ModerationVerdictReviewLabel `json:"review_label,omitempty"`
+
+	// ReviewNote モデレーターのメモ
+	ReviewNote *string             `json:"review_note,omitempty"`
+	ReviewedAt *time.Time          `json:"reviewed_at,omitempty"`
+	ReviewedBy *openapi_types.UUID `json:"reviewed_by,omitempty"`
+
+	// UserID 投稿者のユーザーID
+	UserID openapi_types.UUID `json:"user_id"`
+
+	// VerdictID 判定ログの一意識別子
+	VerdictID int64 `json:"verdict_id"`
+}
+
+// ModerationVerdictDecision 適用された判定
+type ModerationVerdictDecision string
+
+// ModerationVerdictReviewLabel モデレーターによる評価
+type ModerationVerdictReviewLabel string
+
+// Notification defines model for Notification.
+type Notification struct {
+	CreatedAt time.Time           `json:"created_at"`
+	GrumbleID *openapi_types.UUID `json:"grumble_id,omitempty"`
+
+	// Kind 通知の種類（grumble_rejected は非公開判定、grumble_held は相談窓口の案内、appeal_* は異議申し立ての結果、sanction_* は制裁の通知）
+	Kind           NotificationKind `json:"kind"`
+	Message        string           `json:"message"`
+	NotificationID int64            `json:"notification_id"`
+}
+
+// NotificationKind 通知の種類（grumble_rejected は非公開判定、grumble_held は相談窓口の案内、appeal_* は異議申し立ての結果、sanction_* は制裁の通知）
+type NotificationKind string
+
+// Report defines model for Report.
+type Report struct {
+	Comment   *string            `json:"comment,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+
+	// Reason 通報理由
+	Reason   ReportReason `json:"reason"`
+	ReportID int64        `json:"report_id"`
+}
+
+// ReportReason 通報理由
+type ReportReason string
+
+// ReportReasonCount defines model for ReportReasonCount.
+type ReportReasonCount struct {
+	Count int `json:"count"`
+
+	// Reason 通報理由
+	Reason ReportReason `json:"reason"`
+}
+
+// ReportedGrumble defines model for ReportedGrumble.
+type ReportedGrumble struct {
+	// Archived 有効期限切れでアーカイブ済みか
+	Archived bool `json:"archived"`
+
+	// Content 投稿本文
+	Content         string             `json:"content"`
+	FirstReportedAt time.Time          `json:"first_reported_at"`
+	GrumbleID       openapi_types.UUID `json:"grumble_id"`
+	LastReportedAt  time.Time          `json:"last_reported_at"`
+
+	// ModerationStatus 現在の公開状態（通報が閾値に達すると hidden）
+	ModerationStatus string `json:"moderation_status"`
+
+	// Reasons 理由ごとの未対応通報数
+	Reasons []ReportReasonCount `json:"reasons"`
+
+	// ReportCount 未対応の通報数
+	ReportCount int `json:"report_count"`
+}
+
+// ResolveModerationAppealRequest defines model for ResolveModerationAppealRequest.
+type ResolveModerationAppealRequest struct {
+	// Decision approved は投稿を公開（投稿日時はそのまま、有効期限は更新）、denied は拒否を維持
+	Decision ResolveModerationAppealRequestDecision `json:"decision"`
+	Note     *string                                `json:"note,omitempty"`
+}
+
+// ResolveModerationAppealRequestDecision approved は投稿を公開（投稿日時はそのまま、有効期限は更新）、denied は拒否を維持
+type ResolveModerationAppealRequestDecision string
+
+// ResolveReportsRequest defines model for ResolveReportsRequest.
+type ResolveReportsRequest struct {
+	// Action restore は再公開、remove は削除（どちらも未対応の通報をすべて対応済みにする）
+	Action ResolveReportsRequestAction `json:"action"`
+	Note   *string                     `json:"note,omitempty"`
+}
+
+// ResolveReportsRequestAction restore は再公開、remove は削除（どちらも未対応の通報をすべて対応済みにする）
+type ResolveReportsRequestAction string
+
+// ResolveReportsResult defines model for ResolveReportsResult.
+type ResolveReportsResult struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+
+	// ModerationStatus 対応後の公開状態
+	ModerationStatus string `json:"moderation_status"`
+
+	// ResolvedReports 対応済みにした通報数
+	ResolvedReports int `json:"resolved_reports"`
+}
+
+// ReviewModerationVerdictRequest defines model for ReviewModerationVerdictRequest.
+type ReviewModerationVerdictRequest struct {
+	// Label 判定の評価（誤検知は false_positive、見逃しは false_negative）
+	Label ReviewModerationVerdictRequestLabel `json:"label"`
+	Note  *string                             `json:"note,omitempty"`
+}
+
+// ReviewModerationVerdictRequestLabel 判定の評価（誤検知は false_positive、見逃しは false_negative）
+type ReviewModerationVerdictRequestLabel string
+
+// Sanction defines model for Sanction.
+type Sanction struct {
+	// Active 現在有効か（期限切れ・解除済みは false）
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt 有効期限（省略時は解除されるまで無期限）
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// IssuedBy 付与した管理者（自動付与の場合は省略）
+	IssuedBy *openapi_types.UUID `json:"issued_by,omitempty"`
+
+	// Level 制裁の段階（警告 / 投稿クールダウン / シャドウBAN / BAN）
+	Level      SanctionLevel       `json:"level"`
+	Reason     string              `json:"reason"`
+	RevokedAt  *time.Time          `json:"revoked_at,omitempty"`
+	RevokedBy  *openapi_types.UUID `json:"revoked_by,omitempty"`
+	SanctionID int64               `json:"sanction_id"`
+
+	// Source 拒否の繰り返しによる自動付与か、管理者による手動付与か
+	Source SanctionSource     `json:"source"`
+	UserID openapi_types.UUID `json:"user_id"`
+}
+
+// SanctionSource 拒否の繰り返しによる自動付与か、管理者による手動付与か
+type SanctionSource string
+
+// SanctionLevel 制裁の段階（警告 / 投稿クールダウン / シャドウBAN / BAN）
+type SanctionLevel string
+
+// SupportResource defines model for SupportResource.
+type SupportResource struct {
+	// Contact 電話番号などの連絡先
+	Contact string `json:"contact"`
+
+	// Name 相談窓口の名称
+	Name string `json:"name"`
+
+	// URL 窓口のWebサイト
+	URL *string `json:"url,omitempty"`
+}
+
+// UpdateMyProfileRequest defines model for UpdateMyProfileRequest.
+type UpdateMyProfileRequest struct {
+	// Timezone IANAタイムゾーン名（例：America/New_York）
+	Timezone string `json:"timezone"`
+}
+
 // Vibe defines model for Vibe.
 type Vibe struct {
 	// GrumbleID 共感対象の投稿ID
@@ -232,6 +836,59 @@ type Vibe struct {
 // VibeVibeType 共感の種類
 type VibeVibeType string
 
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	ActorID  *openapi_types.UUID `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action   *AuditAction        `form:"action,omitempty" json:"action,omitempty"`
+	TargetID *string             `form:"target_id,omitempty" json:"target_id,omitempty"`
+	Limit    *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int                `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetModerationAppealsParams defines parameters for GetModerationAppeals.
+type GetModerationAppealsParams struct {
+	// Status 審査状況で絞り込み（未審査のキューは pending）
+	Status *GetModerationAppealsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                              `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                              `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetModerationAppealsParamsStatus defines parameters for GetModerationAppeals.
+type GetModerationAppealsParamsStatus string
+
+// GetReportedGrumblesParams defines parameters for GetReportedGrumbles.
+type GetReportedGrumblesParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetModerationVerdictsParams defines parameters for GetModerationVerdicts.
+type GetModerationVerdictsParams struct {
+	Category    *ModerationCategory                     `form:"category,omitempty" json:"category,omitempty"`
+	Decision    *GetModerationVerdictsParamsDecision    `form:"decision,omitempty" json:"decision,omitempty"`
+	ReviewLabel *GetModerationVerdictsParamsReviewLabel `form:"review_label,omitempty" json:"review_label,omitempty"`
+
+	// PromptVersion プロンプトバージョンで絞り込み（A/B 比較用）
+	PromptVersion *string `form:"prompt_version,omitempty" json:"prompt_version,omitempty"`
+
+	// Unreviewed 未レビューのもののみ取得
+	Unreviewed *bool `form:"unreviewed,omitempty" json:"unreviewed,omitempty"`
+
+	// From 期間開始（含む）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 期間終了（含まない）
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetModerationVerdictsParamsDecision defines parameters for GetModerationVerdicts.
+type GetModerationVerdictsParamsDecision string
+
+// GetModerationVerdictsParamsReviewLabel defines parameters for GetModerationVerdicts.
+type GetModerationVerdictsParamsReviewLabel string
+
 // GetEventsParams defines parameters for GetEvents.
 type GetEventsParams struct {
 	ActiveOnly *bool `form:"active_only,omitempty" json:"active_only,omitempty"`
@@ -248,23 +905,52 @@ type GetEventGrumblesParams struct {
 	// Limit 取得件数
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset オフセット
+	// Offset オフセット（旧方式）。cursor とは併用できない
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor 前のページの next_cursor。指定するとその続きから取得する
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal total（総件数）を返すか
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
 }
 
 // GetGrumblesParams defines parameters for GetGrumbles.
 type GetGrumblesParams struct {
-	// UserID 取得対象のユーザーID
+	// Mine true の場合はログインユーザー自身の投稿だけを取得（要認証）
+	Mine *bool `form:"mine,omitempty" json:"mine,omitempty"`
+
+	// UserID 非推奨。mine=true を使用すること。互換性のため自分のユーザーIDを指定した場合のみ mine=true と同じ扱いになり、他人のIDは403
 	UserID        *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
 	ToxicLevelMin *int                `form:"toxic_level_min,omitempty" json:"toxic_level_min,omitempty"`
 	ToxicLevelMax *int                `form:"toxic_level_max,omitempty" json:"toxic_level_max,omitempty"`
 
 	// IsPurified 成仏済みかどうかで絞り込み
 	IsPurified *bool `form:"is_purified,omitempty" json:"is_purified,omitempty"`
-	Limit      *int  `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset     *int  `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// ToxicLevelSource toxic_level_min / toxic_level_max を自己申告（self）と推定値（ai、未推定なら自己申告）のどちらで比較するか
+	ToxicLevelSource *GetGrumblesParamsToxicLevelSource `form:"toxic_level_source,omitempty" json:"toxic_level_source,omitempty"`
+
+	// Tag このタグ（ハッシュタグまたはAIが提案したテーマ）が付いた投稿だけを取得。先頭の
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Category このカテゴリのキーが付いた投稿だけを取得
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset オフセット（旧方式）。新しい投稿が増えるとページ間で重複するため、cursor の利用を推奨。cursor とは併用できない
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor 前のページの next_cursor。指定するとその続きから取得する（投稿時刻と投稿IDによるキーセットページネーション）。同じ絞り込み条件で使うこと
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal total（総件数）を返すか。件数の集計は重いため、cursor で辿る場合は false を推奨
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
 }
 
+// GetGrumblesParamsToxicLevelSource defines parameters for GetGrumbles.
+type GetGrumblesParamsToxicLevelSource string
+
 // AddVibeJSONBody defines parameters for AddVibe.
 type AddVibeJSONBody struct {
 	VibeType *AddVibeJSONBodyVibeType `json:"vibe_type,omitempty"`
@@ -286,12 +972,36 @@ type GetGrumbleStatsParams struct {
 
 	// Tz タイムゾーン（例: Asia/Tokyo）。未指定はサーバ既定
 	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+
+	// Category カテゴリのキーを指定するとそのカテゴリの投稿のみを集計する
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
 }
 
 // GetGrumbleStatsParamsGranularity defines parameters for GetGrumbleStats.
 type GetGrumbleStatsParamsGranularity string
 
-// GetGrumbleStatsToxicParams defines parameters for GetGrumbleStatsToxic.
+// GetGrumbleStatsCategoryParams defines parameters for GetGrumbleStatsCategory.
+type GetGrumbleStatsCategoryParams struct {
+	// Granularity 集計粒度
+	Granularity GetGrumbleStatsCategoryParamsGranularity `form:"granularity" json:"granularity"`
+
+	// From 期間開始（省略時はバックエンドがデフォルト期間を設定）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 期間終了（非含む。省略時はデフォルト期間を設定）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Tz タイムゾーン（例: Asia/Tokyo）。未指定はサーバ既定
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+
+	// Category カテゴリのキーを指定するとそのカテゴリのみを返す
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+}
+
+// GetGrumbleStatsCategoryParamsGranularity defines parameters for GetGrumbleStatsCategory.
+type GetGrumbleStatsCategoryParamsGranularity string
+
+// GetGrumbleStatsToxicParams defines parameters for GetGrumbleStatsToxic.
 type GetGrumbleStatsToxicParams struct {
 	// Granularity 集計粒度
 	Granularity GetGrumbleStatsToxicParamsGranularity `form:"granularity" json:"granularity"`
@@ -307,19 +1017,105 @@ type GetGrumbleStatsToxicParams struct {
 
 	// ToxicLevel 毒度レベルを指定するとそのレベルのみを返す
 	ToxicLevel *int `form:"toxic_level,omitempty" json:"toxic_level,omitempty"`
+
+	// Category カテゴリのキーを指定するとそのカテゴリの投稿のみを集計する
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
 }
 
 // GetGrumbleStatsToxicParamsGranularity defines parameters for GetGrumbleStatsToxic.
 type GetGrumbleStatsToxicParamsGranularity string
 
+// GetMyNotificationsParams defines parameters for GetMyNotifications.
+type GetMyNotificationsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// RestoreGrumbleJSONRequestBody defines body for RestoreGrumble for application/json ContentType.
+type RestoreGrumbleJSONRequestBody = AdminActionRequest
+
+// TakeDownGrumbleJSONRequestBody defines body for TakeDownGrumble for application/json ContentType.
+type TakeDownGrumbleJSONRequestBody = AdminActionRequest
+
+// ResolveModerationAppealJSONRequestBody defines body for ResolveModerationAppeal for application/json ContentType.
+type ResolveModerationAppealJSONRequestBody = ResolveModerationAppealRequest
+
+// ResolveGrumbleReportsJSONRequestBody defines body for ResolveGrumbleReports for application/json ContentType.
+type ResolveGrumbleReportsJSONRequestBody = ResolveReportsRequest
+
+// ReviewModerationVerdictJSONRequestBody defines body for ReviewModerationVerdict for application/json ContentType.
+type ReviewModerationVerdictJSONRequestBody = ReviewModerationVerdictRequest
+
+// IssueSanctionJSONRequestBody defines body for IssueSanction for application/json ContentType.
+type IssueSanctionJSONRequestBody = IssueSanctionRequest
+
+// RevokeSanctionJSONRequestBody defines body for RevokeSanction for application/json ContentType.
+type RevokeSanctionJSONRequestBody = AdminActionRequest
+
+// AdjustVirtuePointsJSONRequestBody defines body for AdjustVirtuePoints for application/json ContentType.
+type AdjustVirtuePointsJSONRequestBody = AdjustVirtuePointsRequest
+
 // CreateGrumbleJSONRequestBody defines body for CreateGrumble for application/json ContentType.
 type CreateGrumbleJSONRequestBody = CreateGrumbleRequest
 
+// EditGrumbleJSONRequestBody defines body for EditGrumble for application/json ContentType.
+type EditGrumbleJSONRequestBody = EditGrumbleRequest
+
+// ReportGrumbleJSONRequestBody defines body for ReportGrumble for application/json ContentType.
+type ReportGrumbleJSONRequestBody = CreateReportRequest
+
 // AddVibeJSONRequestBody defines body for AddVibe for application/json ContentType.
 type AddVibeJSONRequestBody AddVibeJSONBody
 
+// UpdateMyProfileJSONRequestBody defines body for UpdateMyProfile for application/json ContentType.
+type UpdateMyProfileJSONRequestBody = UpdateMyProfileRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// 監査ログの取得（管理者）
+	// (GET /admin/audit-log)
+	GetAuditLog(c *gin.Context, params GetAuditLogParams)
+	// 投稿の詳細と投稿者の履歴（モデレーター）
+	// (GET /admin/grumbles/{grumble_id})
+	GetAdminGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 投稿の再公開（モデレーター）
+	// (PUT /admin/grumbles/{grumble_id}/restore)
+	RestoreGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 投稿の削除（モデレーター）
+	// (PUT /admin/grumbles/{grumble_id}/takedown)
+	TakeDownGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 異議申し立て一覧（管理者）
+	// (GET /admin/moderation/appeals)
+	GetModerationAppeals(c *gin.Context, params GetModerationAppealsParams)
+	// 異議申し立ての審査（管理者）
+	// (PUT /admin/moderation/appeals/{appeal_id}/resolve)
+	ResolveModerationAppeal(c *gin.Context, appealID int64)
+	// モデレーションキャッシュの統計取得（管理者）
+	// (GET /admin/moderation/cache/stats)
+	GetModerationCacheStats(c *gin.Context)
+	// 通報された投稿の一覧（管理者）
+	// (GET /admin/moderation/reports)
+	GetReportedGrumbles(c *gin.Context, params GetReportedGrumblesParams)
+	// 通報された投稿への対応（管理者）
+	// (PUT /admin/moderation/reports/{grumble_id}/resolve)
+	ResolveGrumbleReports(c *gin.Context, grumbleID openapi_types.UUID)
+	// モデレーション判定ログ一覧（管理者）
+	// (GET /admin/moderation/verdicts)
+	GetModerationVerdicts(c *gin.Context, params GetModerationVerdictsParams)
+	// モデレーション判定の評価（管理者）
+	// (PUT /admin/moderation/verdicts/{verdict_id}/review)
+	ReviewModerationVerdict(c *gin.Context, verdictID int64)
+	// 制裁履歴の取得（管理者）
+	// (GET /admin/users/{user_id}/sanctions)
+	GetUserSanctions(c *gin.Context, userID openapi_types.UUID)
+	// 制裁の付与（管理者）
+	// (POST /admin/users/{user_id}/sanctions)
+	IssueSanction(c *gin.Context, userID openapi_types.UUID)
+	// 制裁の解除（管理者）
+	// (PUT /admin/users/{user_id}/sanctions/{sanction_id}/revoke)
+	RevokeSanction(c *gin.Context, userID openapi_types.UUID, sanctionID int64)
+	// 徳ポイントの調整（管理者）
+	// (POST /admin/users/{user_id}/virtue-points)
+	AdjustVirtuePoints(c *gin.Context, userID openapi_types.UUID)
 	// イベント一覧取得
 	// (GET /events)
 	GetEvents(c *gin.Context, params GetEventsParams)
@@ -335,18 +1131,42 @@ type ServerInterface interface {
 	// 投稿作成
 	// (POST /grumbles)
 	CreateGrumble(c *gin.Context)
+	// 自分の投稿を削除
+	// (DELETE /grumbles/{grumble_id})
+	DeleteGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 投稿の詳細取得
+	// (GET /grumbles/{grumble_id})
+	GetGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 自分の投稿を編集
+	// (PATCH /grumbles/{grumble_id})
+	EditGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 拒否された投稿への異議申し立て
+	// (POST /grumbles/{grumble_id}/appeal)
+	AppealGrumble(c *gin.Context, grumbleID openapi_types.UUID)
+	// 投稿を通報
+	// (POST /grumbles/{grumble_id}/reports)
+	ReportGrumble(c *gin.Context, grumbleID openapi_types.UUID)
 	// 「わかる…」を送る
 	// (POST /grumbles/{grumble_id}/vibes)
 	AddVibe(c *gin.Context, grumbleID openapi_types.UUID)
 	// 投稿統計取得
 	// (GET /stats/grumbles)
 	GetGrumbleStats(c *gin.Context, params GetGrumbleStatsParams)
+	// 投稿統計取得（カテゴリ別）
+	// (GET /stats/grumbles/category)
+	GetGrumbleStatsCategory(c *gin.Context, params GetGrumbleStatsCategoryParams)
 	// 投稿統計取得（毒度別）
 	// (GET /stats/grumbles/toxic)
 	GetGrumbleStatsToxic(c *gin.Context, params GetGrumbleStatsToxicParams)
 	// 自分のユーザー情報取得
 	// (GET /users/me)
 	GetMyProfile(c *gin.Context)
+	// 自分のユーザー設定更新
+	// (PATCH /users/me)
+	UpdateMyProfile(c *gin.Context)
+	// 自分への通知一覧取得
+	// (GET /users/me/notifications)
+	GetMyNotifications(c *gin.Context, params GetMyNotificationsParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -358,57 +1178,37 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// GetEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
+// GetAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLog(c *gin.Context) {
 
 	var err error
 
 	c.Set(FirebaseAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEventsParams
+	var params GetAuditLogParams
 
-	// ------------- Optional query parameter "active_only" -------------
+	// ------------- Optional query parameter "actor_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "active_only", c.Request.URL.Query(), &params.ActiveOnly)
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", c.Request.URL.Query(), &params.ActorID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active_only: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetEvents(c, params)
-}
-
-// GetEventGrumbles operation middleware
-func (siw *ServerInterfaceWrapper) GetEventGrumbles(c *gin.Context) {
-
-	var err error
-
-	c.Set(FirebaseAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEventGrumblesParams
-
-	// ------------- Optional query parameter "toxic_level_min" -------------
+	// ------------- Optional query parameter "action" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "toxic_level_min", c.Request.URL.Query(), &params.ToxicLevelMin)
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_min: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "toxic_level_max" -------------
+	// ------------- Optional query parameter "target_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "toxic_level_max", c.Request.URL.Query(), &params.ToxicLevelMax)
+	err = runtime.BindQueryParameter("form", true, false, "target_id", c.Request.URL.Query(), &params.TargetID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_max: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter target_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -435,20 +1235,20 @@ func (siw *ServerInterfaceWrapper) GetEventGrumbles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEventGrumbles(c, params)
+	siw.Handler.GetAuditLog(c, params)
 }
 
-// GetEvent operation middleware
-func (siw *ServerInterfaceWrapper) GetEvent(c *gin.Context) {
+// GetAdminGrumble operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminGrumble(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "event_id" -------------
-	var eventID int
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -461,48 +1261,76 @@ func (siw *ServerInterfaceWrapper) GetEvent(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEvent(c, eventID)
+	siw.Handler.GetAdminGrumble(c, grumbleID)
 }
 
-// GetGrumbles operation middleware
-func (siw *ServerInterfaceWrapper) GetGrumbles(c *gin.Context) {
+// RestoreGrumble operation middleware
+func (siw *ServerInterfaceWrapper) RestoreGrumble(c *gin.Context) {
 
 	var err error
 
-	c.Set(FirebaseAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGrumblesParams
-
-	// ------------- Optional query parameter "user_id" -------------
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserID)
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "toxic_level_min" -------------
+	c.Set(FirebaseAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "toxic_level_min", c.Request.URL.Query(), &params.ToxicLevelMin)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_min: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "toxic_level_max" -------------
+	siw.Handler.RestoreGrumble(c, grumbleID)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "toxic_level_max", c.Request.URL.Query(), &params.ToxicLevelMax)
+// TakeDownGrumble operation middleware
+func (siw *ServerInterfaceWrapper) TakeDownGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_max: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "is_purified" -------------
+	c.Set(FirebaseAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "is_purified", c.Request.URL.Query(), &params.IsPurified)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TakeDownGrumble(c, grumbleID)
+}
+
+// GetModerationAppeals operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationAppeals(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModerationAppealsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter is_purified: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -529,11 +1357,22 @@ func (siw *ServerInterfaceWrapper) GetGrumbles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetGrumbles(c, params)
+	siw.Handler.GetModerationAppeals(c, params)
 }
 
-// CreateGrumble operation middleware
-func (siw *ServerInterfaceWrapper) CreateGrumble(c *gin.Context) {
+// ResolveModerationAppeal operation middleware
+func (siw *ServerInterfaceWrapper) ResolveModerationAppeal(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "appeal_id" -------------
+	var appealID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appeal_id", c.Param("appeal_id"), &appealID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter appeal_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(FirebaseAuthScopes, []string{})
 
@@ -544,22 +1383,11 @@ func (siw *ServerInterfaceWrapper) CreateGrumble(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateGrumble(c)
+	siw.Handler.ResolveModerationAppeal(c, appealID)
 }
 
-// AddVibe operation middleware
-func (siw *ServerInterfaceWrapper) AddVibe(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "grumble_id" -------------
-	var grumbleID openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetModerationCacheStats operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationCacheStats(c *gin.Context) {
 
 	c.Set(FirebaseAuthScopes, []string{})
 
@@ -570,58 +1398,61 @@ func (siw *ServerInterfaceWrapper) AddVibe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddVibe(c, grumbleID)
+	siw.Handler.GetModerationCacheStats(c)
 }
 
-// GetGrumbleStats operation middleware
-func (siw *ServerInterfaceWrapper) GetGrumbleStats(c *gin.Context) {
+// GetReportedGrumbles operation middleware
+func (siw *ServerInterfaceWrapper) GetReportedGrumbles(c *gin.Context) {
 
 	var err error
 
 	c.Set(FirebaseAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGrumbleStatsParams
+	var params GetReportedGrumblesParams
 
-	// ------------- Required query parameter "granularity" -------------
-
-	if paramValue := c.Query("granularity"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument granularity is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "granularity", c.Request.URL.Query(), &params.Granularity)
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "tz" -------------
+	siw.Handler.GetReportedGrumbles(c, params)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "tz", c.Request.URL.Query(), &params.Tz)
+// ResolveGrumbleReports operation middleware
+func (siw *ServerInterfaceWrapper) ResolveGrumbleReports(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tz: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(FirebaseAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -629,31 +1460,56 @@ func (siw *ServerInterfaceWrapper) GetGrumbleStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetGrumbleStats(c, params)
+	siw.Handler.ResolveGrumbleReports(c, grumbleID)
 }
 
-// GetGrumbleStatsToxic operation middleware
-func (siw *ServerInterfaceWrapper) GetGrumbleStatsToxic(c *gin.Context) {
+// GetModerationVerdicts operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationVerdicts(c *gin.Context) {
 
 	var err error
 
 	c.Set(FirebaseAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGrumbleStatsToxicParams
+	var params GetModerationVerdictsParams
 
-	// ------------- Required query parameter "granularity" -------------
+	// ------------- Optional query parameter "category" -------------
 
-	if paramValue := c.Query("granularity"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument granularity is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "decision" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decision", c.Request.URL.Query(), &params.Decision)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter decision: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "granularity", c.Request.URL.Query(), &params.Granularity)
+	// ------------- Optional query parameter "review_label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "review_label", c.Request.URL.Query(), &params.ReviewLabel)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter review_label: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "prompt_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prompt_version", c.Request.URL.Query(), &params.PromptVersion)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter prompt_version: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "unreviewed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unreviewed", c.Request.URL.Query(), &params.Unreviewed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unreviewed: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -673,19 +1529,19 @@ func (siw *ServerInterfaceWrapper) GetGrumbleStatsToxic(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "tz" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "tz", c.Request.URL.Query(), &params.Tz)
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tz: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "toxic_level" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "toxic_level", c.Request.URL.Query(), &params.ToxicLevel)
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -696,11 +1552,22 @@ func (siw *ServerInterfaceWrapper) GetGrumbleStatsToxic(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetGrumbleStatsToxic(c, params)
+	siw.Handler.GetModerationVerdicts(c, params)
 }
 
-// GetMyProfile operation middleware
-func (siw *ServerInterfaceWrapper) GetMyProfile(c *gin.Context) {
+// ReviewModerationVerdict operation middleware
+func (siw *ServerInterfaceWrapper) ReviewModerationVerdict(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "verdict_id" -------------
+	var verdictID int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "verdict_id", c.Param("verdict_id"), &verdictID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter verdict_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(FirebaseAuthScopes, []string{})
 
@@ -711,413 +1578,2985 @@ func (siw *ServerInterfaceWrapper) GetMyProfile(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMyProfile(c)
+	siw.Handler.ReviewModerationVerdict(c, verdictID)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetUserSanctions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSanctions(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "user_id" -------------
+	var userID openapi_types.UUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/events", wrapper.GetEvents)
-	router.GET(options.BaseURL+"/events/grumbles", wrapper.GetEventGrumbles)
-	router.GET(options.BaseURL+"/events/:event_id", wrapper.GetEvent)
-	router.GET(options.BaseURL+"/grumbles", wrapper.GetGrumbles)
-	router.POST(options.BaseURL+"/grumbles", wrapper.CreateGrumble)
-	router.POST(options.BaseURL+"/grumbles/:grumble_id/vibes", wrapper.AddVibe)
-	router.GET(options.BaseURL+"/stats/grumbles", wrapper.GetGrumbleStats)
-	router.GET(options.BaseURL+"/stats/grumbles/toxic", wrapper.GetGrumbleStatsToxic)
-	router.GET(options.BaseURL+"/users/me", wrapper.GetMyProfile)
-}
-
-type GetEventsRequestObject struct {
-	Params GetEventsParams
-}
+	c.Set(FirebaseAuthScopes, []string{})
 
-type GetEventsResponseObject interface {
-	VisitGetEventsResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetEvents200JSONResponse struct {
-	Events *[]Event `json:"events,omitempty"`
+	siw.Handler.GetUserSanctions(c, userID)
 }
 
-func (response GetEvents200JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// IssueSanction operation middleware
+func (siw *ServerInterfaceWrapper) IssueSanction(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetEvents401JSONResponse ErrorResponse
+	// ------------- Path parameter "user_id" -------------
+	var userID openapi_types.UUID
 
-func (response GetEvents401JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(FirebaseAuthScopes, []string{})
 
-type GetEventGrumblesRequestObject struct {
-	Params GetEventGrumblesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetEventGrumblesResponseObject interface {
-	VisitGetEventGrumblesResponse(w http.ResponseWriter) error
+	siw.Handler.IssueSanction(c, userID)
 }
 
-type GetEventGrumbles200JSONResponse struct {
-	// EventDate イベント対象日（YYYY-MM-DD）
-	EventDate openapi_types.Date `json:"event_date"`
+// RevokeSanction operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSanction(c *gin.Context) {
 
-	// Grumbles イベント投稿リスト
-	Grumbles []Grumble `json:"grumbles"`
+	var err error
 
-	// IsEventActive イベント期間中（24:00〜12:00）かどうか
-	IsEventActive bool `json:"is_event_active"`
+	// ------------- Path parameter "user_id" -------------
+	var userID openapi_types.UUID
 
-	// Total 総件数
-	Total int `json:"total"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetEventGrumbles200JSONResponse) VisitGetEventGrumblesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "sanction_id" -------------
+	var sanctionID int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "sanction_id", c.Param("sanction_id"), &sanctionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sanction_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetEventGrumbles401JSONResponse ErrorResponse
+	c.Set(FirebaseAuthScopes, []string{})
 
-func (response GetEventGrumbles401JSONResponse) VisitGetEventGrumblesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.RevokeSanction(c, userID, sanctionID)
 }
 
-type GetEventRequestObject struct {
-	EventID int `json:"event_id"`
-}
+// AdjustVirtuePoints operation middleware
+func (siw *ServerInterfaceWrapper) AdjustVirtuePoints(c *gin.Context) {
 
-type GetEventResponseObject interface {
-	VisitGetEventResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetEvent200JSONResponse Event
+	// ------------- Path parameter "user_id" -------------
+	var userID openapi_types.UUID
 
-func (response GetEvent200JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdjustVirtuePoints(c, userID)
 }
 
-type GetEvent401JSONResponse ErrorResponse
+// GetEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 
-func (response GetEvent401JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(FirebaseAuthScopes, []string{})
 
-type GetEvent404JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
 
-func (response GetEvent404JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "active_only" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "active_only", c.Request.URL.Query(), &params.ActiveOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active_only: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetGrumblesRequestObject struct {
-	Params GetGrumblesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetGrumblesResponseObject interface {
-	VisitGetGrumblesResponse(w http.ResponseWriter) error
+	siw.Handler.GetEvents(c, params)
 }
 
-type GetGrumbles200JSONResponse struct {
-	Grumbles *[]Grumble `json:"grumbles,omitempty"`
+// GetEventGrumbles operation middleware
+func (siw *ServerInterfaceWrapper) GetEventGrumbles(c *gin.Context) {
 
-	// Total 総件数
-	Total *int `json:"total,omitempty"`
-}
+	var err error
 
-func (response GetGrumbles200JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(FirebaseAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventGrumblesParams
 
-type GetGrumbles400JSONResponse ErrorResponse
+	// ------------- Optional query parameter "toxic_level_min" -------------
 
-func (response GetGrumbles400JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level_min", c.Request.URL.Query(), &params.ToxicLevelMin)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_min: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "toxic_level_max" -------------
 
-type GetGrumbles401JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level_max", c.Request.URL.Query(), &params.ToxicLevelMax)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_max: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetGrumbles401JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateGrumbleRequestObject struct {
-	Body *CreateGrumbleJSONRequestBody
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type CreateGrumbleResponseObject interface {
-	VisitCreateGrumbleResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateGrumble201JSONResponse Grumble
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response CreateGrumble201JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-type CreateGrumble400JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "include_total", c.Request.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_total: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreateGrumble400JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetEventGrumbles(c, params)
 }
 
-type CreateGrumble401JSONResponse ErrorResponse
-
+// GetEvent operation middleware
+func (siw *ServerInterfaceWrapper) GetEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEvent(c, eventID)
+}
+
+// GetGrumbles operation middleware
+func (siw *ServerInterfaceWrapper) GetGrumbles(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGrumblesParams
+
+	// ------------- Optional query parameter "mine" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mine", c.Request.URL.Query(), &params.Mine)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mine: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toxic_level_min" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level_min", c.Request.URL.Query(), &params.ToxicLevelMin)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_min: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toxic_level_max" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level_max", c.Request.URL.Query(), &params.ToxicLevelMax)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_max: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "is_purified" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_purified", c.Request.URL.Query(), &params.IsPurified)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter is_purified: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toxic_level_source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level_source", c.Request.URL.Query(), &params.ToxicLevelSource)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level_source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", c.Request.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_total: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGrumbles(c, params)
+}
+
+// CreateGrumble operation middleware
+func (siw *ServerInterfaceWrapper) CreateGrumble(c *gin.Context) {
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateGrumble(c)
+}
+
+// DeleteGrumble operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteGrumble(c, grumbleID)
+}
+
+// GetGrumble operation middleware
+func (siw *ServerInterfaceWrapper) GetGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGrumble(c, grumbleID)
+}
+
+// EditGrumble operation middleware
+func (siw *ServerInterfaceWrapper) EditGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.EditGrumble(c, grumbleID)
+}
+
+// AppealGrumble operation middleware
+func (siw *ServerInterfaceWrapper) AppealGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AppealGrumble(c, grumbleID)
+}
+
+// ReportGrumble operation middleware
+func (siw *ServerInterfaceWrapper) ReportGrumble(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReportGrumble(c, grumbleID)
+}
+
+// AddVibe operation middleware
+func (siw *ServerInterfaceWrapper) AddVibe(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "grumble_id" -------------
+	var grumbleID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "grumble_id", c.Param("grumble_id"), &grumbleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grumble_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddVibe(c, grumbleID)
+}
+
+// GetGrumbleStats operation middleware
+func (siw *ServerInterfaceWrapper) GetGrumbleStats(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGrumbleStatsParams
+
+	// ------------- Required query parameter "granularity" -------------
+
+	if paramValue := c.Query("granularity"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument granularity is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "granularity", c.Request.URL.Query(), &params.Granularity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", c.Request.URL.Query(), &params.Tz)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tz: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGrumbleStats(c, params)
+}
+
+// GetGrumbleStatsCategory operation middleware
+func (siw *ServerInterfaceWrapper) GetGrumbleStatsCategory(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGrumbleStatsCategoryParams
+
+	// ------------- Required query parameter "granularity" -------------
+
+	if paramValue := c.Query("granularity"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument granularity is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "granularity", c.Request.URL.Query(), &params.Granularity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", c.Request.URL.Query(), &params.Tz)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tz: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGrumbleStatsCategory(c, params)
+}
+
+// GetGrumbleStatsToxic operation middleware
+func (siw *ServerInterfaceWrapper) GetGrumbleStatsToxic(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGrumbleStatsToxicParams
+
+	// ------------- Required query parameter "granularity" -------------
+
+	if paramValue := c.Query("granularity"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument granularity is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "granularity", c.Request.URL.Query(), &params.Granularity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", c.Request.URL.Query(), &params.Tz)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tz: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toxic_level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toxic_level", c.Request.URL.Query(), &params.ToxicLevel)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toxic_level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGrumbleStatsToxic(c, params)
+}
+
+// GetMyProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetMyProfile(c *gin.Context) {
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMyProfile(c)
+}
+
+// UpdateMyProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyProfile(c *gin.Context) {
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateMyProfile(c)
+}
+
+// GetMyNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetMyNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(FirebaseAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyNotificationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMyNotifications(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/admin/audit-log", wrapper.GetAuditLog)
+	router.GET(options.BaseURL+"/admin/grumbles/:grumble_id", wrapper.GetAdminGrumble)
+	router.PUT(options.BaseURL+"/admin/grumbles/:grumble_id/restore", wrapper.RestoreGrumble)
+	router.PUT(options.BaseURL+"/admin/grumbles/:grumble_id/takedown", wrapper.TakeDownGrumble)
+	router.GET(options.BaseURL+"/admin/moderation/appeals", wrapper.GetModerationAppeals)
+	router.PUT(options.BaseURL+"/admin/moderation/appeals/:appeal_id/resolve", wrapper.ResolveModerationAppeal)
+	router.GET(options.BaseURL+"/admin/moderation/cache/stats", wrapper.GetModerationCacheStats)
+	router.GET(options.BaseURL+"/admin/moderation/reports", wrapper.GetReportedGrumbles)
+	router.PUT(options.BaseURL+"/admin/moderation/reports/:grumble_id/resolve", wrapper.ResolveGrumbleReports)
+	router.GET(options.BaseURL+"/admin/moderation/verdicts", wrapper.GetModerationVerdicts)
+	router.PUT(options.BaseURL+"/admin/moderation/verdicts/:verdict_id/review", wrapper.ReviewModerationVerdict)
+	router.GET(options.BaseURL+"/admin/users/:user_id/sanctions", wrapper.GetUserSanctions)
+	router.POST(options.BaseURL+"/admin/users/:user_id/sanctions", wrapper.IssueSanction)
+	router.PUT(options.BaseURL+"/admin/users/:user_id/sanctions/:sanction_id/revoke", wrapper.RevokeSanction)
+	router.POST(options.BaseURL+"/admin/users/:user_id/virtue-points", wrapper.AdjustVirtuePoints)
+	router.GET(options.BaseURL+"/events", wrapper.GetEvents)
+	router.GET(options.BaseURL+"/events/grumbles", wrapper.GetEventGrumbles)
+	router.GET(options.BaseURL+"/events/:event_id", wrapper.GetEvent)
+	router.GET(options.BaseURL+"/grumbles", wrapper.GetGrumbles)
+	router.POST(options.BaseURL+"/grumbles", wrapper.CreateGrumble)
+	router.DELETE(options.BaseURL+"/grumbles/:grumble_id", wrapper.DeleteGrumble)
+	router.GET(options.BaseURL+"/grumbles/:grumble_id", wrapper.GetGrumble)
+	router.PATCH(options.BaseURL+"/grumbles/:grumble_id", wrapper.EditGrumble)
+	router.POST(options.BaseURL+"/grumbles/:grumble_id/appeal", wrapper.AppealGrumble)
+	router.POST(options.BaseURL+"/grumbles/:grumble_id/reports", wrapper.ReportGrumble)
+	router.POST(options.BaseURL+"/grumbles/:grumble_id/vibes", wrapper.AddVibe)
+	router.GET(options.BaseURL+"/stats/grumbles", wrapper.GetGrumbleStats)
+	router.GET(options.BaseURL+"/stats/grumbles/category", wrapper.GetGrumbleStatsCategory)
+	router.GET(options.BaseURL+"/stats/grumbles/toxic", wrapper.GetGrumbleStatsToxic)
+	router.GET(options.BaseURL+"/users/me", wrapper.GetMyProfile)
+	router.PATCH(options.BaseURL+"/users/me", wrapper.UpdateMyProfile)
+	router.GET(options.BaseURL+"/users/me/notifications", wrapper.GetMyNotifications)
+}
+
+type GetAuditLogRequestObject struct {
+	Params GetAuditLogParams
+}
+
+type GetAuditLogResponseObject interface {
+	VisitGetAuditLogResponse(w http.ResponseWriter) error
+}
+
+type GetAuditLog200JSONResponse struct {
+	Entries []AuditLogEntry `json:"entries"`
+
+	// Total 総件数
+	Total int `json:"total"`
+}
+
+func (response GetAuditLog200JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog400JSONResponse ErrorResponse
+
+func (response GetAuditLog400JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog401JSONResponse ErrorResponse
+
+func (response GetAuditLog401JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog403JSONResponse ErrorResponse
+
+func (response GetAuditLog403JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+}
+
+type GetAdminGrumbleResponseObject interface {
+	VisitGetAdminGrumbleResponse(w http.ResponseWriter) error
+}
+
+type GetAdminGrumble200JSONResponse GrumbleInspection
+
+func (response GetAdminGrumble200JSONResponse) VisitGetAdminGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminGrumble401JSONResponse ErrorResponse
+
+func (response GetAdminGrumble401JSONResponse) VisitGetAdminGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminGrumble403JSONResponse ErrorResponse
+
+func (response GetAdminGrumble403JSONResponse) VisitGetAdminGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminGrumble404JSONResponse ErrorResponse
+
+func (response GetAdminGrumble404JSONResponse) VisitGetAdminGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *RestoreGrumbleJSONRequestBody
+}
+
+type RestoreGrumbleResponseObject interface {
+	VisitRestoreGrumbleResponse(w http.ResponseWriter) error
+}
+
+type RestoreGrumble200JSONResponse AdminGrumble
+
+func (response RestoreGrumble200JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumble400JSONResponse ErrorResponse
+
+func (response RestoreGrumble400JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumble401JSONResponse ErrorResponse
+
+func (response RestoreGrumble401JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumble403JSONResponse ErrorResponse
+
+func (response RestoreGrumble403JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumble404JSONResponse ErrorResponse
+
+func (response RestoreGrumble404JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreGrumble409JSONResponse ErrorResponse
+
+func (response RestoreGrumble409JSONResponse) VisitRestoreGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *TakeDownGrumbleJSONRequestBody
+}
+
+type TakeDownGrumbleResponseObject interface {
+	VisitTakeDownGrumbleResponse(w http.ResponseWriter) error
+}
+
+type TakeDownGrumble200JSONResponse AdminGrumble
+
+func (response TakeDownGrumble200JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumble400JSONResponse ErrorResponse
+
+func (response TakeDownGrumble400JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumble401JSONResponse ErrorResponse
+
+func (response TakeDownGrumble401JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumble403JSONResponse ErrorResponse
+
+func (response TakeDownGrumble403JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumble404JSONResponse ErrorResponse
+
+func (response TakeDownGrumble404JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeDownGrumble409JSONResponse ErrorResponse
+
+func (response TakeDownGrumble409JSONResponse) VisitTakeDownGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationAppealsRequestObject struct {
+	Params GetModerationAppealsParams
+}
+
+type GetModerationAppealsResponseObject interface {
+	VisitGetModerationAppealsResponse(w http.ResponseWriter) error
+}
+
+type GetModerationAppeals200JSONResponse struct {
+	Appeals []ModerationAppeal `json:"appeals"`
+
+	// Total 総件数
+	Total int `json:"total"`
+}
+
+func (response GetModerationAppeals200JSONResponse) VisitGetModerationAppealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationAppeals400JSONResponse ErrorResponse
+
+func (response GetModerationAppeals400JSONResponse) VisitGetModerationAppealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationAppeals401JSONResponse ErrorResponse
+
+func (response GetModerationAppeals401JSONResponse) VisitGetModerationAppealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationAppeals403JSONResponse ErrorResponse
+
+func (response GetModerationAppeals403JSONResponse) VisitGetModerationAppealsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppealRequestObject struct {
+	AppealID int64 `json:"appeal_id"`
+	Body     *ResolveModerationAppealJSONRequestBody
+}
+
+type ResolveModerationAppealResponseObject interface {
+	VisitResolveModerationAppealResponse(w http.ResponseWriter) error
+}
+
+type ResolveModerationAppeal200JSONResponse ModerationAppeal
+
+func (response ResolveModerationAppeal200JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppeal400JSONResponse ErrorResponse
+
+func (response ResolveModerationAppeal400JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppeal401JSONResponse ErrorResponse
+
+func (response ResolveModerationAppeal401JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppeal403JSONResponse ErrorResponse
+
+func (response ResolveModerationAppeal403JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppeal404JSONResponse ErrorResponse
+
+func (response ResolveModerationAppeal404JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveModerationAppeal409JSONResponse ErrorResponse
+
+func (response ResolveModerationAppeal409JSONResponse) VisitResolveModerationAppealResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCacheStatsRequestObject struct {
+}
+
+type GetModerationCacheStatsResponseObject interface {
+	VisitGetModerationCacheStatsResponse(w http.ResponseWriter) error
+}
+
+type GetModerationCacheStats200JSONResponse ModerationCacheStats
+
+func (response GetModerationCacheStats200JSONResponse) VisitGetModerationCacheStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCacheStats401JSONResponse ErrorResponse
+
+func (response GetModerationCacheStats401JSONResponse) VisitGetModerationCacheStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCacheStats403JSONResponse ErrorResponse
+
+func (response GetModerationCacheStats403JSONResponse) VisitGetModerationCacheStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportedGrumblesRequestObject struct {
+	Params GetReportedGrumblesParams
+}
+
+type GetReportedGrumblesResponseObject interface {
+	VisitGetReportedGrumblesResponse(w http.ResponseWriter) error
+}
+
+type GetReportedGrumbles200JSONResponse struct {
+	ReportedGrumbles []ReportedGrumble `json:"reported_grumbles"`
+
+	// Total 総件数
+	Total int `json:"total"`
+}
+
+func (response GetReportedGrumbles200JSONResponse) VisitGetReportedGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportedGrumbles400JSONResponse ErrorResponse
+
+func (response GetReportedGrumbles400JSONResponse) VisitGetReportedGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportedGrumbles401JSONResponse ErrorResponse
+
+func (response GetReportedGrumbles401JSONResponse) VisitGetReportedGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportedGrumbles403JSONResponse ErrorResponse
+
+func (response GetReportedGrumbles403JSONResponse) VisitGetReportedGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveGrumbleReportsRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *ResolveGrumbleReportsJSONRequestBody
+}
+
+type ResolveGrumbleReportsResponseObject interface {
+	VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error
+}
+
+type ResolveGrumbleReports200JSONResponse ResolveReportsResult
+
+func (response ResolveGrumbleReports200JSONResponse) VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveGrumbleReports400JSONResponse ErrorResponse
+
+func (response ResolveGrumbleReports400JSONResponse) VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveGrumbleReports401JSONResponse ErrorResponse
+
+func (response ResolveGrumbleReports401JSONResponse) VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveGrumbleReports403JSONResponse ErrorResponse
+
+func (response ResolveGrumbleReports403JSONResponse) VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveGrumbleReports404JSONResponse ErrorResponse
+
+func (response ResolveGrumbleReports404JSONResponse) VisitResolveGrumbleReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationVerdictsRequestObject struct {
+	Params GetModerationVerdictsParams
+}
+
+type GetModerationVerdictsResponseObject interface {
+	VisitGetModerationVerdictsResponse(w http.ResponseWriter) error
+}
+
+type GetModerationVerdicts200JSONResponse struct {
+	// Total 総件数
+	Total    int                 `json:"total"`
+	Verdicts []ModerationVerdict `json:"verdicts"`
+}
+
+func (response GetModerationVerdicts200JSONResponse) VisitGetModerationVerdictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationVerdicts400JSONResponse ErrorResponse
+
+func (response GetModerationVerdicts400JSONResponse) VisitGetModerationVerdictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationVerdicts401JSONResponse ErrorResponse
+
+func (response GetModerationVerdicts401JSONResponse) VisitGetModerationVerdictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationVerdicts403JSONResponse ErrorResponse
+
+func (response GetModerationVerdicts403JSONResponse) VisitGetModerationVerdictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewModerationVerdictRequestObject struct {
+	VerdictID int64 `json:"verdict_id"`
+	Body      *ReviewModerationVerdictJSONRequestBody
+}
+
+type ReviewModerationVerdictResponseObject interface {
+	VisitReviewModerationVerdictResponse(w http.ResponseWriter) error
+}
+
+type ReviewModerationVerdict200JSONResponse ModerationVerdict
+
+func (response ReviewModerationVerdict200JSONResponse) VisitReviewModerationVerdictResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewModerationVerdict400JSONResponse ErrorResponse
+
+func (response ReviewModerationVerdict400JSONResponse) VisitReviewModerationVerdictResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewModerationVerdict401JSONResponse ErrorResponse
+
+func (response ReviewModerationVerdict401JSONResponse) VisitReviewModerationVerdictResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewModerationVerdict403JSONResponse ErrorResponse
+
+func (response ReviewModerationVerdict403JSONResponse) VisitReviewModerationVerdictResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewModerationVerdict404JSONResponse ErrorResponse
+
+func (response ReviewModerationVerdict404JSONResponse) VisitReviewModerationVerdictResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSanctionsRequestObject struct {
+	UserID openapi_types.UUID `json:"user_id"`
+}
+
+type GetUserSanctionsResponseObject interface {
+	VisitGetUserSanctionsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSanctions200JSONResponse []Sanction
+
+func (response GetUserSanctions200JSONResponse) VisitGetUserSanctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSanctions401JSONResponse ErrorResponse
+
+func (response GetUserSanctions401JSONResponse) VisitGetUserSanctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSanctions403JSONResponse ErrorResponse
+
+func (response GetUserSanctions403JSONResponse) VisitGetUserSanctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueSanctionRequestObject struct {
+	UserID openapi_types.UUID `json:"user_id"`
+	Body   *IssueSanctionJSONRequestBody
+}
+
+type IssueSanctionResponseObject interface {
+	VisitIssueSanctionResponse(w http.ResponseWriter) error
+}
+
+type IssueSanction201JSONResponse Sanction
+
+func (response IssueSanction201JSONResponse) VisitIssueSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueSanction400JSONResponse ErrorResponse
+
+func (response IssueSanction400JSONResponse) VisitIssueSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueSanction401JSONResponse ErrorResponse
+
+func (response IssueSanction401JSONResponse) VisitIssueSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueSanction403JSONResponse ErrorResponse
+
+func (response IssueSanction403JSONResponse) VisitIssueSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueSanction404JSONResponse ErrorResponse
+
+func (response IssueSanction404JSONResponse) VisitIssueSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanctionRequestObject struct {
+	UserID     openapi_types.UUID `json:"user_id"`
+	SanctionID int64              `json:"sanction_id"`
+	Body       *RevokeSanctionJSONRequestBody
+}
+
+type RevokeSanctionResponseObject interface {
+	VisitRevokeSanctionResponse(w http.ResponseWriter) error
+}
+
+type RevokeSanction200JSONResponse Sanction
+
+func (response RevokeSanction200JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanction400JSONResponse ErrorResponse
+
+func (response RevokeSanction400JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanction401JSONResponse ErrorResponse
+
+func (response RevokeSanction401JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanction403JSONResponse ErrorResponse
+
+func (response RevokeSanction403JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanction404JSONResponse ErrorResponse
+
+func (response RevokeSanction404JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSanction409JSONResponse ErrorResponse
+
+func (response RevokeSanction409JSONResponse) VisitRevokeSanctionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustVirtuePointsRequestObject struct {
+	UserID openapi_types.UUID `json:"user_id"`
+	Body   *AdjustVirtuePointsJSONRequestBody
+}
+
+type AdjustVirtuePointsResponseObject interface {
+	VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error
+}
+
+type AdjustVirtuePoints200JSONResponse AnonymousUser
+
+func (response AdjustVirtuePoints200JSONResponse) VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustVirtuePoints400JSONResponse ErrorResponse
+
+func (response AdjustVirtuePoints400JSONResponse) VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustVirtuePoints401JSONResponse ErrorResponse
+
+func (response AdjustVirtuePoints401JSONResponse) VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustVirtuePoints403JSONResponse ErrorResponse
+
+func (response AdjustVirtuePoints403JSONResponse) VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustVirtuePoints404JSONResponse ErrorResponse
+
+func (response AdjustVirtuePoints404JSONResponse) VisitAdjustVirtuePointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsRequestObject struct {
+	Params GetEventsParams
+}
+
+type GetEventsResponseObject interface {
+	VisitGetEventsResponse(w http.ResponseWriter) error
+}
+
+type GetEvents200JSONResponse struct {
+	Events *[]Event `json:"events,omitempty"`
+}
+
+func (response GetEvents200JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents401JSONResponse ErrorResponse
+
+func (response GetEvents401JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventGrumblesRequestObject struct {
+	Params GetEventGrumblesParams
+}
+
+type GetEventGrumblesResponseObject interface {
+	VisitGetEventGrumblesResponse(w http.ResponseWriter) error
+}
+
+type GetEventGrumbles200JSONResponse struct {
+	// EventDate イベント対象日（YYYY-MM-DD）
+	EventDate openapi_types.Date `json:"event_date"`
+
+	// Grumbles イベント投稿リスト
+	Grumbles []Grumble `json:"grumbles"`
+
+	// HasMore 次のページがあるか
+	HasMore bool `json:"has_more"`
+
+	// IsEventActive イベント期間中（24:00〜12:00）かどうか
+	IsEventActive bool `json:"is_event_active"`
+
+	// NextCursor 次のページを取得するための cursor（has_more が false の場合は省略）
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Total 総件数（include_total=false の場合は省略）
+	Total *int `json:"total,omitempty"`
+}
+
+func (response GetEventGrumbles200JSONResponse) VisitGetEventGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventGrumbles400JSONResponse ErrorResponse
+
+func (response GetEventGrumbles400JSONResponse) VisitGetEventGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventGrumbles401JSONResponse ErrorResponse
+
+func (response GetEventGrumbles401JSONResponse) VisitGetEventGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventRequestObject struct {
+	EventID int `json:"event_id"`
+}
+
+type GetEventResponseObject interface {
+	VisitGetEventResponse(w http.ResponseWriter) error
+}
+
+type GetEvent200JSONResponse Event
+
+func (response GetEvent200JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvent401JSONResponse ErrorResponse
+
+func (response GetEvent401JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvent404JSONResponse ErrorResponse
+
+func (response GetEvent404JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumblesRequestObject struct {
+	Params GetGrumblesParams
+}
+
+type GetGrumblesResponseObject interface {
+	VisitGetGrumblesResponse(w http.ResponseWriter) error
+}
+
+type GetGrumbles200JSONResponse struct {
+	Grumbles *[]Grumble `json:"grumbles,omitempty"`
+
+	// HasMore 次のページがあるか
+	HasMore bool `json:"has_more"`
+
+	// NextCursor 次のページを取得するための cursor（has_more が false の場合は省略）
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Total 総件数（include_total=false の場合は省略）
+	Total *int `json:"total,omitempty"`
+}
+
+func (response GetGrumbles200JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbles400JSONResponse ErrorResponse
+
+func (response GetGrumbles400JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbles401JSONResponse ErrorResponse
+
+func (response GetGrumbles401JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbles403JSONResponse ErrorResponse
+
+func (response GetGrumbles403JSONResponse) VisitGetGrumblesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumbleRequestObject struct {
+	Body *CreateGrumbleJSONRequestBody
+}
+
+type CreateGrumbleResponseObject interface {
+	VisitCreateGrumbleResponse(w http.ResponseWriter) error
+}
+
+type CreateGrumble201JSONResponse Grumble
+
+func (response CreateGrumble201JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble400JSONResponse InappropriateContentResponse
+
+func (response CreateGrumble400JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble401JSONResponse ErrorResponse
+
 func (response CreateGrumble401JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble403JSONResponse ErrorResponse
+
+func (response CreateGrumble403JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble409JSONResponse ErrorResponse
+
+func (response CreateGrumble409JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble422JSONResponse CrisisSupportResponse
+
+func (response CreateGrumble422JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGrumble429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type CreateGrumble429JSONResponse struct {
+	Body    ErrorResponse
+	Headers CreateGrumble429ResponseHeaders
+}
+
+func (response CreateGrumble429JSONResponse) VisitCreateGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+}
+
+type DeleteGrumbleResponseObject interface {
+	VisitDeleteGrumbleResponse(w http.ResponseWriter) error
+}
+
+type DeleteGrumble204Response struct {
+}
+
+func (response DeleteGrumble204Response) VisitDeleteGrumbleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteGrumble401JSONResponse ErrorResponse
+
+func (response DeleteGrumble401JSONResponse) VisitDeleteGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGrumble404JSONResponse ErrorResponse
+
+func (response DeleteGrumble404JSONResponse) VisitDeleteGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+}
+
+type GetGrumbleResponseObject interface {
+	VisitGetGrumbleResponse(w http.ResponseWriter) error
+}
+
+type GetGrumble200JSONResponse Grumble
+
+func (response GetGrumble200JSONResponse) VisitGetGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumble401JSONResponse ErrorResponse
+
+func (response GetGrumble401JSONResponse) VisitGetGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumble404JSONResponse ErrorResponse
+
+func (response GetGrumble404JSONResponse) VisitGetGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *EditGrumbleJSONRequestBody
+}
+
+type EditGrumbleResponseObject interface {
+	VisitEditGrumbleResponse(w http.ResponseWriter) error
+}
+
+type EditGrumble200JSONResponse Grumble
+
+func (response EditGrumble200JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble400JSONResponse InappropriateContentResponse
+
+func (response EditGrumble400JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble401JSONResponse ErrorResponse
+
+func (response EditGrumble401JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble403JSONResponse ErrorResponse
+
+func (response EditGrumble403JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble404JSONResponse ErrorResponse
+
+func (response EditGrumble404JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble409JSONResponse ErrorResponse
+
+func (response EditGrumble409JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble422JSONResponse CrisisSupportResponse
+
+func (response EditGrumble422JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditGrumble429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type EditGrumble429JSONResponse struct {
+	Body    ErrorResponse
+	Headers EditGrumble429ResponseHeaders
+}
+
+func (response EditGrumble429JSONResponse) VisitEditGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AppealGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+}
+
+type AppealGrumbleResponseObject interface {
+	VisitAppealGrumbleResponse(w http.ResponseWriter) error
+}
+
+type AppealGrumble201JSONResponse ModerationAppeal
+
+func (response AppealGrumble201JSONResponse) VisitAppealGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppealGrumble400JSONResponse ErrorResponse
+
+func (response AppealGrumble400JSONResponse) VisitAppealGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppealGrumble401JSONResponse ErrorResponse
+
+func (response AppealGrumble401JSONResponse) VisitAppealGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppealGrumble404JSONResponse ErrorResponse
+
+func (response AppealGrumble404JSONResponse) VisitAppealGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppealGrumble409JSONResponse ErrorResponse
+
+func (response AppealGrumble409JSONResponse) VisitAppealGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportGrumbleRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *ReportGrumbleJSONRequestBody
+}
+
+type ReportGrumbleResponseObject interface {
+	VisitReportGrumbleResponse(w http.ResponseWriter) error
+}
+
+type ReportGrumble201JSONResponse Report
+
+func (response ReportGrumble201JSONResponse) VisitReportGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportGrumble400JSONResponse ErrorResponse
+
+func (response ReportGrumble400JSONResponse) VisitReportGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportGrumble401JSONResponse ErrorResponse
+
+func (response ReportGrumble401JSONResponse) VisitReportGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportGrumble404JSONResponse ErrorResponse
+
+func (response ReportGrumble404JSONResponse) VisitReportGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReportGrumble409JSONResponse ErrorResponse
+
+func (response ReportGrumble409JSONResponse) VisitReportGrumbleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddVibeRequestObject struct {
+	GrumbleID openapi_types.UUID `json:"grumble_id"`
+	Body      *AddVibeJSONRequestBody
+}
+
+type AddVibeResponseObject interface {
+	VisitAddVibeResponse(w http.ResponseWriter) error
+}
+
+type AddVibe201JSONResponse Vibe
+
+func (response AddVibe201JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddVibe400JSONResponse ErrorResponse
+
+func (response AddVibe400JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddVibe401JSONResponse ErrorResponse
+
+func (response AddVibe401JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddVibe404JSONResponse ErrorResponse
+
+func (response AddVibe404JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddVibe409JSONResponse ErrorResponse
+
+func (response AddVibe409JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsRequestObject struct {
+	Params GetGrumbleStatsParams
+}
+
+type GetGrumbleStatsResponseObject interface {
+	VisitGetGrumbleStatsResponse(w http.ResponseWriter) error
+}
+
+type GetGrumbleStats200JSONResponse []GrumbleStatsBucket
+
+func (response GetGrumbleStats200JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStats400JSONResponse ErrorResponse
+
+func (response GetGrumbleStats400JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStats401JSONResponse ErrorResponse
+
+func (response GetGrumbleStats401JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsCategoryRequestObject struct {
+	Params GetGrumbleStatsCategoryParams
+}
+
+type GetGrumbleStatsCategoryResponseObject interface {
+	VisitGetGrumbleStatsCategoryResponse(w http.ResponseWriter) error
+}
+
+type GetGrumbleStatsCategory200JSONResponse []GrumbleStatsCategoryBucket
+
+func (response GetGrumbleStatsCategory200JSONResponse) VisitGetGrumbleStatsCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsCategory400JSONResponse ErrorResponse
+
+func (response GetGrumbleStatsCategory400JSONResponse) VisitGetGrumbleStatsCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsCategory401JSONResponse ErrorResponse
+
+func (response GetGrumbleStatsCategory401JSONResponse) VisitGetGrumbleStatsCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsToxicRequestObject struct {
+	Params GetGrumbleStatsToxicParams
+}
+
+type GetGrumbleStatsToxicResponseObject interface {
+	VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error
+}
+
+type GetGrumbleStatsToxic200JSONResponse []GrumbleStatsToxicBucket
+
+func (response GetGrumbleStatsToxic200JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsToxic400JSONResponse ErrorResponse
+
+func (response GetGrumbleStatsToxic400JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGrumbleStatsToxic401JSONResponse ErrorResponse
+
+func (response GetGrumbleStatsToxic401JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyProfileRequestObject struct {
+}
+
+type GetMyProfileResponseObject interface {
+	VisitGetMyProfileResponse(w http.ResponseWriter) error
+}
+
+type GetMyProfile200JSONResponse AnonymousUser
+
+func (response GetMyProfile200JSONResponse) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyProfile401JSONResponse ErrorResponse
+
+func (response GetMyProfile401JSONResponse) VisitGetMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyProfileRequestObject struct {
+	Body *UpdateMyProfileJSONRequestBody
+}
+
+type UpdateMyProfileResponseObject interface {
+	VisitUpdateMyProfileResponse(w http.ResponseWriter) error
+}
+
+type UpdateMyProfile200JSONResponse AnonymousUser
+
+func (response UpdateMyProfile200JSONResponse) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyProfile400JSONResponse ErrorResponse
+
+func (response UpdateMyProfile400JSONResponse) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyProfile401JSONResponse ErrorResponse
+
+func (response UpdateMyProfile401JSONResponse) VisitUpdateMyProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyNotificationsRequestObject struct {
+	Params GetMyNotificationsParams
+}
+
+type GetMyNotificationsResponseObject interface {
+	VisitGetMyNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetMyNotifications200JSONResponse struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+func (response GetMyNotifications200JSONResponse) VisitGetMyNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyNotifications400JSONResponse ErrorResponse
+
+func (response GetMyNotifications400JSONResponse) VisitGetMyNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyNotifications401JSONResponse ErrorResponse
+
+func (response GetMyNotifications401JSONResponse) VisitGetMyNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// 監査ログの取得（管理者）
+	// (GET /admin/audit-log)
+	GetAuditLog(ctx context.Context, request GetAuditLogRequestObject) (GetAuditLogResponseObject, error)
+	// 投稿の詳細と投稿者の履歴（モデレーター）
+	// (GET /admin/grumbles/{grumble_id})
+	GetAdminGrumble(ctx context.Context, request GetAdminGrumbleRequestObject) (GetAdminGrumbleResponseObject, error)
+	// 投稿の再公開（モデレーター）
+	// (PUT /admin/grumbles/{grumble_id}/restore)
+	RestoreGrumble(ctx context.Context, request RestoreGrumbleRequestObject) (RestoreGrumbleResponseObject, error)
+	// 投稿の削除（モデレーター）
+	// (PUT /admin/grumbles/{grumble_id}/takedown)
+	TakeDownGrumble(ctx context.Context, request TakeDownGrumbleRequestObject) (TakeDownGrumbleResponseObject, error)
+	// 異議申し立て一覧（管理者）
+	// (GET /admin/moderation/appeals)
+	GetModerationAppeals(ctx context.Context, request GetModerationAppealsRequestObject) (GetModerationAppealsResponseObject, error)
+	// 異議申し立ての審査（管理者）
+	// (PUT /admin/moderation/appeals/{appeal_id}/resolve)
+	ResolveModerationAppeal(ctx context.Context, request ResolveModerationAppealRequestObject) (ResolveModerationAppealResponseObject, error)
+	// モデレーションキャッシュの統計取得（管理者）
+	// (GET /admin/moderation/cache/stats)
+	GetModerationCacheStats(ctx context.Context, request GetModerationCacheStatsRequestObject) (GetModerationCacheStatsResponseObject, error)
+	// 通報された投稿の一覧（管理者）
+	// (GET /admin/moderation/reports)
+	GetReportedGrumbles(ctx context.Context, request GetReportedGrumblesRequestObject) (GetReportedGrumblesResponseObject, error)
+	// 通報された投稿への対応（管理者）
+	// (PUT /admin/moderation/reports/{grumble_id}/resolve)
+	ResolveGrumbleReports(ctx context.Context, request ResolveGrumbleReportsRequestObject) (ResolveGrumbleReportsResponseObject, error)
+	// モデレーション判定ログ一覧（管理者）
+	// (GET /admin/moderation/verdicts)
+	GetModerationVerdicts(ctx context.Context, request GetModerationVerdictsRequestObject) (GetModerationVerdictsResponseObject, error)
+	// モデレーション判定の評価（管理者）
+	// (PUT /admin/moderation/verdicts/{verdict_id}/review)
+	ReviewModerationVerdict(ctx context.Context, request ReviewModerationVerdictRequestObject) (ReviewModerationVerdictResponseObject, error)
+	// 制裁履歴の取得（管理者）
+	// (GET /admin/users/{user_id}/sanctions)
+	GetUserSanctions(ctx context.Context, request GetUserSanctionsRequestObject) (GetUserSanctionsResponseObject, error)
+	// 制裁の付与（管理者）
+	// (POST /admin/users/{user_id}/sanctions)
+	IssueSanction(ctx context.Context, request IssueSanctionRequestObject) (IssueSanctionResponseObject, error)
+	// 制裁の解除（管理者）
+	// (PUT /admin/users/{user_id}/sanctions/{sanction_id}/revoke)
+	RevokeSanction(ctx context.Context, request RevokeSanctionRequestObject) (RevokeSanctionResponseObject, error)
+	// 徳ポイントの調整（管理者）
+	// (POST /admin/users/{user_id}/virtue-points)
+	AdjustVirtuePoints(ctx context.Context, request AdjustVirtuePointsRequestObject) (AdjustVirtuePointsResponseObject, error)
+	// イベント一覧取得
+	// (GET /events)
+	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
+	// イベント投稿取得
+	// (GET /events/grumbles)
+	GetEventGrumbles(ctx context.Context, request GetEventGrumblesRequestObject) (GetEventGrumblesResponseObject, error)
+	// イベント詳細取得
+	// (GET /events/{event_id})
+	GetEvent(ctx context.Context, request GetEventRequestObject) (GetEventResponseObject, error)
+	// タイムライン取得
+	// (GET /grumbles)
+	GetGrumbles(ctx context.Context, request GetGrumblesRequestObject) (GetGrumblesResponseObject, error)
+	// 投稿作成
+	// (POST /grumbles)
+	CreateGrumble(ctx context.Context, request CreateGrumbleRequestObject) (CreateGrumbleResponseObject, error)
+	// 自分の投稿を削除
+	// (DELETE /grumbles/{grumble_id})
+	DeleteGrumble(ctx context.Context, request DeleteGrumbleRequestObject) (DeleteGrumbleResponseObject, error)
+	// 投稿の詳細取得
+	// (GET /grumbles/{grumble_id})
+	GetGrumble(ctx context.Context, request GetGrumbleRequestObject) (GetGrumbleResponseObject, error)
+	// 自分の投稿を編集
+	// (PATCH /grumbles/{grumble_id})
+	EditGrumble(ctx context.Context, request EditGrumbleRequestObject) (EditGrumbleResponseObject, error)
+	// 拒否された投稿への異議申し立て
+	// (POST /grumbles/{grumble_id}/appeal)
+	AppealGrumble(ctx context.Context, request AppealGrumbleRequestObject) (AppealGrumbleResponseObject, error)
+	// 投稿を通報
+	// (POST /grumbles/{grumble_id}/reports)
+	ReportGrumble(ctx context.Context, request ReportGrumbleRequestObject) (ReportGrumbleResponseObject, error)
+	// 「わかる…」を送る
+	// (POST /grumbles/{grumble_id}/vibes)
+	AddVibe(ctx context.Context, request AddVibeRequestObject) (AddVibeResponseObject, error)
+	// 投稿統計取得
+	// (GET /stats/grumbles)
+	GetGrumbleStats(ctx context.Context, request GetGrumbleStatsRequestObject) (GetGrumbleStatsResponseObject, error)
+	// 投稿統計取得（カテゴリ別）
+	// (GET /stats/grumbles/category)
+	GetGrumbleStatsCategory(ctx context.Context, request GetGrumbleStatsCategoryRequestObject) (GetGrumbleStatsCategoryResponseObject, error)
+	// 投稿統計取得（毒度別）
+	// (GET /stats/grumbles/toxic)
+	GetGrumbleStatsToxic(ctx context.Context, request GetGrumbleStatsToxicRequestObject) (GetGrumbleStatsToxicResponseObject, error)
+	// 自分のユーザー情報取得
+	// (GET /users/me)
+	GetMyProfile(ctx context.Context, request GetMyProfileRequestObject) (GetMyProfileResponseObject, error)
+	// 自分のユーザー設定更新
+	// (PATCH /users/me)
+	UpdateMyProfile(ctx context.Context, request UpdateMyProfileRequestObject) (UpdateMyProfileResponseObject, error)
+	// 自分への通知一覧取得
+	// (GET /users/me/notifications)
+	GetMyNotifications(ctx context.Context, request GetMyNotificationsRequestObject) (GetMyNotificationsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAuditLog operation middleware
+func (sh *strictHandler) GetAuditLog(ctx *gin.Context, params GetAuditLogParams) {
+	var request GetAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditLog(ctx, request.(GetAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditLog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuditLogResponseObject); ok {
+		if err := validResponse.VisitGetAuditLogResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminGrumble operation middleware
+func (sh *strictHandler) GetAdminGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request GetAdminGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminGrumble(ctx, request.(GetAdminGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminGrumbleResponseObject); ok {
+		if err := validResponse.VisitGetAdminGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreGrumble operation middleware
+func (sh *strictHandler) RestoreGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request RestoreGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	var body RestoreGrumbleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreGrumble(ctx, request.(RestoreGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RestoreGrumbleResponseObject); ok {
+		if err := validResponse.VisitRestoreGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TakeDownGrumble operation middleware
+func (sh *strictHandler) TakeDownGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request TakeDownGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	var body TakeDownGrumbleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TakeDownGrumble(ctx, request.(TakeDownGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TakeDownGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(TakeDownGrumbleResponseObject); ok {
+		if err := validResponse.VisitTakeDownGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddVibeRequestObject struct {
-	GrumbleID openapi_types.UUID `json:"grumble_id"`
-	Body      *AddVibeJSONRequestBody
-}
+// GetModerationAppeals operation middleware
+func (sh *strictHandler) GetModerationAppeals(ctx *gin.Context, params GetModerationAppealsParams) {
+	var request GetModerationAppealsRequestObject
 
-type AddVibeResponseObject interface {
-	VisitAddVibeResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type AddVibe201JSONResponse Vibe
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModerationAppeals(ctx, request.(GetModerationAppealsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModerationAppeals")
+	}
 
-func (response AddVibe201JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetModerationAppealsResponseObject); ok {
+		if err := validResponse.VisitGetModerationAppealsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddVibe400JSONResponse ErrorResponse
+// ResolveModerationAppeal operation middleware
+func (sh *strictHandler) ResolveModerationAppeal(ctx *gin.Context, appealID int64) {
+	var request ResolveModerationAppealRequestObject
 
-func (response AddVibe400JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.AppealID = appealID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ResolveModerationAppealJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type AddVibe401JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveModerationAppeal(ctx, request.(ResolveModerationAppealRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveModerationAppeal")
+	}
 
-func (response AddVibe401JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ResolveModerationAppealResponseObject); ok {
+		if err := validResponse.VisitResolveModerationAppealResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddVibe404JSONResponse ErrorResponse
+// GetModerationCacheStats operation middleware
+func (sh *strictHandler) GetModerationCacheStats(ctx *gin.Context) {
+	var request GetModerationCacheStatsRequestObject
 
-func (response AddVibe404JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModerationCacheStats(ctx, request.(GetModerationCacheStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModerationCacheStats")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetModerationCacheStatsResponseObject); ok {
+		if err := validResponse.VisitGetModerationCacheStatsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddVibe409JSONResponse ErrorResponse
+// GetReportedGrumbles operation middleware
+func (sh *strictHandler) GetReportedGrumbles(ctx *gin.Context, params GetReportedGrumblesParams) {
+	var request GetReportedGrumblesRequestObject
 
-func (response AddVibe409JSONResponse) VisitAddVibeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportedGrumbles(ctx, request.(GetReportedGrumblesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportedGrumbles")
+	}
 
-type GetGrumbleStatsRequestObject struct {
-	Params GetGrumbleStatsParams
-}
+	response, err := handler(ctx, request)
 
-type GetGrumbleStatsResponseObject interface {
-	VisitGetGrumbleStatsResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetReportedGrumblesResponseObject); ok {
+		if err := validResponse.VisitGetReportedGrumblesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetGrumbleStats200JSONResponse []GrumbleStatsBucket
+// ResolveGrumbleReports operation middleware
+func (sh *strictHandler) ResolveGrumbleReports(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request ResolveGrumbleReportsRequestObject
 
-func (response GetGrumbleStats200JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.GrumbleID = grumbleID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ResolveGrumbleReportsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type GetGrumbleStats400JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveGrumbleReports(ctx, request.(ResolveGrumbleReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveGrumbleReports")
+	}
 
-func (response GetGrumbleStats400JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ResolveGrumbleReportsResponseObject); ok {
+		if err := validResponse.VisitResolveGrumbleReportsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetGrumbleStats401JSONResponse ErrorResponse
+// GetModerationVerdicts operation middleware
+func (sh *strictHandler) GetModerationVerdicts(ctx *gin.Context, params GetModerationVerdictsParams) {
+	var request GetModerationVerdictsRequestObject
 
-func (response GetGrumbleStats401JSONResponse) VisitGetGrumbleStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModerationVerdicts(ctx, request.(GetModerationVerdictsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModerationVerdicts")
+	}
 
-type GetGrumbleStatsToxicRequestObject struct {
-	Params GetGrumbleStatsToxicParams
-}
+	response, err := handler(ctx, request)
 
-type GetGrumbleStatsToxicResponseObject interface {
-	VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetModerationVerdictsResponseObject); ok {
+		if err := validResponse.VisitGetModerationVerdictsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetGrumbleStatsToxic200JSONResponse []GrumbleStatsToxicBucket
+// ReviewModerationVerdict operation middleware
+func (sh *strictHandler) ReviewModerationVerdict(ctx *gin.Context, verdictID int64) {
+	var request ReviewModerationVerdictRequestObject
 
-func (response GetGrumbleStatsToxic200JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.VerdictID = verdictID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ReviewModerationVerdictJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type GetGrumbleStatsToxic400JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReviewModerationVerdict(ctx, request.(ReviewModerationVerdictRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReviewModerationVerdict")
+	}
 
-func (response GetGrumbleStatsToxic400JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReviewModerationVerdictResponseObject); ok {
+		if err := validResponse.VisitReviewModerationVerdictResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetGrumbleStatsToxic401JSONResponse ErrorResponse
+// GetUserSanctions operation middleware
+func (sh *strictHandler) GetUserSanctions(ctx *gin.Context, userID openapi_types.UUID) {
+	var request GetUserSanctionsRequestObject
 
-func (response GetGrumbleStatsToxic401JSONResponse) VisitGetGrumbleStatsToxicResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.UserID = userID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserSanctions(ctx, request.(GetUserSanctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserSanctions")
+	}
 
-type GetMyProfileRequestObject struct {
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserSanctionsResponseObject); ok {
+		if err := validResponse.VisitGetUserSanctionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMyProfileResponseObject interface {
-	VisitGetMyProfileResponse(w http.ResponseWriter) error
+// IssueSanction operation middleware
+func (sh *strictHandler) IssueSanction(ctx *gin.Context, userID openapi_types.UUID) {
+	var request IssueSanctionRequestObject
+
+	request.UserID = userID
+
+	var body IssueSanctionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueSanction(ctx, request.(IssueSanctionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueSanction")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(IssueSanctionResponseObject); ok {
+		if err := validResponse.VisitIssueSanctionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMyProfile200JSONResponse AnonymousUser
+// RevokeSanction operation middleware
+func (sh *strictHandler) RevokeSanction(ctx *gin.Context, userID openapi_types.UUID, sanctionID int64) {
+	var request RevokeSanctionRequestObject
+
+	request.UserID = userID
+	request.SanctionID = sanctionID
+
+	var body RevokeSanctionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeSanction(ctx, request.(RevokeSanctionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeSanction")
+	}
 
-func (response GetMyProfile200JSONResponse) VisitGetMyProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RevokeSanctionResponseObject); ok {
+		if err := validResponse.VisitRevokeSanctionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetMyProfile401JSONResponse ErrorResponse
-
-func (response GetMyProfile401JSONResponse) VisitGetMyProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// AdjustVirtuePoints operation middleware
+func (sh *strictHandler) AdjustVirtuePoints(ctx *gin.Context, userID openapi_types.UUID) {
+	var request AdjustVirtuePointsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UserID = userID
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// イベント一覧取得
-	// (GET /events)
-	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
-	// イベント投稿取得
-	// (GET /events/grumbles)
-	GetEventGrumbles(ctx context.Context, request GetEventGrumblesRequestObject) (GetEventGrumblesResponseObject, error)
-	// イベント詳細取得
-	// (GET /events/{event_id})
-	GetEvent(ctx context.Context, request GetEventRequestObject) (GetEventResponseObject, error)
-	// タイムライン取得
-	// (GET /grumbles)
-	GetGrumbles(ctx context.Context, request GetGrumblesRequestObject) (GetGrumblesResponseObject, error)
-	// 投稿作成
-	// (POST /grumbles)
-	CreateGrumble(ctx context.Context, request CreateGrumbleRequestObject) (CreateGrumbleResponseObject, error)
-	// 「わかる…」を送る
-	// (POST /grumbles/{grumble_id}/vibes)
-	AddVibe(ctx context.Context, request AddVibeRequestObject) (AddVibeResponseObject, error)
-	// 投稿統計取得
-	// (GET /stats/grumbles)
-	GetGrumbleStats(ctx context.Context, request GetGrumbleStatsRequestObject) (GetGrumbleStatsResponseObject, error)
-	// 投稿統計取得（毒度別）
-	// (GET /stats/grumbles/toxic)
-	GetGrumbleStatsToxic(ctx context.Context, request GetGrumbleStatsToxicRequestObject) (GetGrumbleStatsToxicResponseObject, error)
-	// 自分のユーザー情報取得
-	// (GET /users/me)
-	GetMyProfile(ctx context.Context, request GetMyProfileRequestObject) (GetMyProfileResponseObject, error)
-}
+	var body AdjustVirtuePointsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdjustVirtuePoints(ctx, request.(AdjustVirtuePointsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdjustVirtuePoints")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AdjustVirtuePointsResponseObject); ok {
+		if err := validResponse.VisitAdjustVirtuePointsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // GetEvents operation middleware
@@ -1261,6 +4700,157 @@ func (sh *strictHandler) CreateGrumble(ctx *gin.Context) {
 	}
 }
 
+// DeleteGrumble operation middleware
+func (sh *strictHandler) DeleteGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request DeleteGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGrumble(ctx, request.(DeleteGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteGrumbleResponseObject); ok {
+		if err := validResponse.VisitDeleteGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGrumble operation middleware
+func (sh *strictHandler) GetGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request GetGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGrumble(ctx, request.(GetGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetGrumbleResponseObject); ok {
+		if err := validResponse.VisitGetGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EditGrumble operation middleware
+func (sh *strictHandler) EditGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request EditGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	var body EditGrumbleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EditGrumble(ctx, request.(EditGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(EditGrumbleResponseObject); ok {
+		if err := validResponse.VisitEditGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AppealGrumble operation middleware
+func (sh *strictHandler) AppealGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request AppealGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AppealGrumble(ctx, request.(AppealGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AppealGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AppealGrumbleResponseObject); ok {
+		if err := validResponse.VisitAppealGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReportGrumble operation middleware
+func (sh *strictHandler) ReportGrumble(ctx *gin.Context, grumbleID openapi_types.UUID) {
+	var request ReportGrumbleRequestObject
+
+	request.GrumbleID = grumbleID
+
+	var body ReportGrumbleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReportGrumble(ctx, request.(ReportGrumbleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReportGrumble")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReportGrumbleResponseObject); ok {
+		if err := validResponse.VisitReportGrumbleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // AddVibe operation middleware
 func (sh *strictHandler) AddVibe(ctx *gin.Context, grumbleID openapi_types.UUID) {
 	var request AddVibeRequestObject
@@ -1323,6 +4913,33 @@ func (sh *strictHandler) GetGrumbleStats(ctx *gin.Context, params GetGrumbleStat
 	}
 }
 
+// GetGrumbleStatsCategory operation middleware
+func (sh *strictHandler) GetGrumbleStatsCategory(ctx *gin.Context, params GetGrumbleStatsCategoryParams) {
+	var request GetGrumbleStatsCategoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGrumbleStatsCategory(ctx, request.(GetGrumbleStatsCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGrumbleStatsCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetGrumbleStatsCategoryResponseObject); ok {
+		if err := validResponse.VisitGetGrumbleStatsCategoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetGrumbleStatsToxic operation middleware
 func (sh *strictHandler) GetGrumbleStatsToxic(ctx *gin.Context, params GetGrumbleStatsToxicParams) {
 	var request GetGrumbleStatsToxicRequestObject
@@ -1374,3 +4991,63 @@ func (sh *strictHandler) GetMyProfile(ctx *gin.Context) {
 		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// UpdateMyProfile operation middleware
+func (sh *strictHandler) UpdateMyProfile(ctx *gin.Context) {
+	var request UpdateMyProfileRequestObject
+
+	var body UpdateMyProfileJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMyProfile(ctx, request.(UpdateMyProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMyProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateMyProfileResponseObject); ok {
+		if err := validResponse.VisitUpdateMyProfileResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyNotifications operation middleware
+func (sh *strictHandler) GetMyNotifications(ctx *gin.Context, params GetMyNotificationsParams) {
+	var request GetMyNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyNotifications(ctx, request.(GetMyNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMyNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetMyNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/dokkiitech/grumble-back/internal/controller"
 	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
 	"github.com/dokkiitech/grumble-back/internal/logging"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/oapi-codegen/nullable"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -24,6 +26,11 @@ type StrictControllerServer struct {
 	vibeController          *controller.VibeController
 	eventGrumblesController *controller.EventGrumblesController
 	statsController         *controller.GrumbleStatsController
+	moderationController    *controller.ModerationController
+	notificationController  *controller.NotificationController
+	appealController        *controller.AppealController
+	reportController        *controller.ReportController
+	adminController         *controller.AdminController
 	logger                  logging.Logger
 }
 
@@ -35,6 +42,11 @@ func NewStrictControllerServer(
 	vibeCtrl *controller.VibeController,
 	eventGrumblesCtrl *controller.EventGrumblesController,
 	statsCtrl *controller.GrumbleStatsController,
+	moderationCtrl *controller.ModerationController,
+	notificationCtrl *controller.NotificationController,
+	appealCtrl *controller.AppealController,
+	reportCtrl *controller.ReportController,
+	adminCtrl *controller.AdminController,
 	logger logging.Logger,
 ) *StrictControllerServer {
 	return &StrictControllerServer{
@@ -44,6 +56,11 @@ func NewStrictControllerServer(
 		vibeController:          vibeCtrl,
 		eventGrumblesController: eventGrumblesCtrl,
 		statsController:         statsCtrl,
+		moderationController:    moderationCtrl,
+		notificationController:  notificationCtrl,
+		appealController:        appealCtrl,
+		reportController:        reportCtrl,
+		adminController:         adminCtrl,
 		logger:                  logger,
 	}
 }
@@ -72,6 +89,7 @@ func (s *StrictControllerServer) GetGrumbleStats(ctx context.Context, request Ge
 		TZ:          tz,
 		From:        params.From,
 		To:          params.To,
+		Category:    params.Category,
 	}
 
 	result, err := s.statsController.GetStats(ctx, input)
@@ -111,6 +129,7 @@ func (s *StrictControllerServer) GetGrumbleStatsToxic(ctx context.Context, reque
 			TZ:          tz,
 			From:        params.From,
 			To:          params.To,
+			Category:    params.Category,
 		},
 		ToxicLevel: params.ToxicLevel,
 	}
@@ -142,6 +161,51 @@ func (s *StrictControllerServer) GetGrumbleStatsToxic(ctx context.Context, reque
 	return GetGrumbleStatsToxic200JSONResponse(response), nil
 }
 
+// GetGrumbleStatsCategory handles aggregated stats with category breakdown.
+func (s *StrictControllerServer) GetGrumbleStatsCategory(ctx context.Context, request GetGrumbleStatsCategoryRequestObject) (GetGrumbleStatsCategoryResponseObject, error) {
+	params := request.Params
+
+	tz := ""
+	if params.Tz != nil {
+		tz = *params.Tz
+	}
+
+	input := controller.StatsInput{
+		Granularity: grumble.Granularity(params.Granularity),
+		TZ:          tz,
+		From:        params.From,
+		To:          params.To,
+		Category:    params.Category,
+	}
+
+	result, err := s.statsController.GetStatsByCategory(ctx, input)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "GetGrumbleStatsCategory failed", "error", err)
+		if isValidationError(err) {
+			return GetGrumbleStatsCategory400JSONResponse(errorResponse("INVALID_QUERY_PARAM", err.Error())), nil
+		}
+		return GetGrumbleStatsCategory401JSONResponse(errorResponse("INTERNAL_ERROR", "Failed to retrieve stats")), nil
+	}
+
+	response := make([]GrumbleStatsCategoryBucket, len(result))
+	for i, r := range result {
+		var category *string
+		if r.Category != nil {
+			key := string(*r.Category)
+			category = &key
+		}
+		response[i] = GrumbleStatsCategoryBucket{
+			Bucket:          r.Bucket,
+			Category:        category,
+			PurifiedCount:   r.PurifiedCount,
+			UnpurifiedCount: r.UnpurifiedCount,
+			TotalVibes:      r.TotalVibes,
+		}
+	}
+
+	return GetGrumbleStatsCategory200JSONResponse(response), nil
+}
+
 // GetEventGrumbles handles retrieving event grumbles from archive
 func (s *StrictControllerServer) GetEventGrumbles(ctx context.Context, request GetEventGrumblesRequestObject) (GetEventGrumblesResponseObject, error) {
 	params := request.Params
@@ -161,10 +225,19 @@ func (s *StrictControllerServer) GetEventGrumbles(ctx context.Context, request G
 		ToxicLevelMax: params.ToxicLevelMax,
 		Limit:         limit,
 		Offset:        offset,
+		Cursor:        params.Cursor,
+		IncludeTotal:  params.IncludeTotal == nil || *params.IncludeTotal,
+		Timezone:      s.userTimezoneFromContext(ctx),
+	}
+	if viewer, ok := s.userIDFromContext(ctx); ok {
+		query.ViewerUserID = &viewer
 	}
 
 	response, err := s.eventGrumblesController.GetEventGrumbles(ctx, query)
 	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetEventGrumbles400JSONResponse(classification.Payload), nil
+		}
 		s.logger.ErrorContext(ctx, "GetEventGrumbles failed", "error", err)
 		return GetEventGrumbles401JSONResponse(errorResponse("INTERNAL_ERROR", "Failed to retrieve event grumbles")), nil
 	}
@@ -186,6 +259,8 @@ func (s *StrictControllerServer) GetEventGrumbles(ctx context.Context, request G
 	return GetEventGrumbles200JSONResponse{
 		Grumbles:      apiGrumbles,
 		Total:         response.Total,
+		NextCursor:    optionalString(response.NextCursor),
+		HasMore:       response.HasMore,
 		IsEventActive: response.IsEventActive,
 		EventDate:     eventDate,
 	}, nil
@@ -217,10 +292,26 @@ func (s *StrictControllerServer) GetGrumbles(ctx context.Context, request GetGru
 		return GetGrumbles400JSONResponse(errorResponse("INVALID_QUERY_PARAM", "toxic_level_min cannot be greater than toxic_level_max")), nil
 	}
 
+	var viewerUserID *shared.UserID
+	if id, ok := s.userIDFromContext(ctx); ok {
+		viewer := id
+		viewerUserID = &viewer
+	}
+
+	// Grumbles are only listed per author for the author themselves, so user IDs cannot be used to link grumbles.
+	// The deprecated user_id parameter keeps working for clients that pass their own ID.
 	var userID *shared.UserID
 	if params.UserID != nil {
-		uid := shared.UserID(params.UserID.String())
-		userID = &uid
+		if viewerUserID == nil || shared.UserID(params.UserID.String()) != *viewerUserID {
+			return GetGrumbles403JSONResponse(errorResponse("FORBIDDEN", "user_id may only be your own; use mine=true")), nil
+		}
+		userID = viewerUserID
+	}
+	if params.Mine != nil && *params.Mine {
+		if viewerUserID == nil {
+			return GetGrumbles401JSONResponse(errorResponse("UNAUTHORIZED", "mine=true requires authentication")), nil
+		}
+		userID = viewerUserID
 	}
 
 	limit := 0
@@ -232,12 +323,6 @@ func (s *StrictControllerServer) GetGrumbles(ctx context.Context, request GetGru
 		offset = *params.Offset
 	}
 
-	var viewerUserID *shared.UserID
-	if id, ok := s.userIDFromContext(ctx); ok {
-		viewer := id
-		viewerUserID = &viewer
-	}
-
 	query := controller.TimelineQuery{
 		UserID:        userID,
 		ViewerUserID:  viewerUserID,
@@ -246,7 +331,24 @@ func (s *StrictControllerServer) GetGrumbles(ctx context.Context, request GetGru
 		IsPurified:    params.IsPurified,
 		Limit:         limit,
 		Offset:        offset,
+		Cursor:        params.Cursor,
+		IncludeTotal:  params.IncludeTotal == nil || *params.IncludeTotal,
+	}
+	if params.ToxicLevelSource != nil {
+		source := grumble.ToxicLevelSource(*params.ToxicLevelSource)
+		if source != grumble.ToxicLevelSourceSelf && source != grumble.ToxicLevelSourceAI {
+			return GetGrumbles400JSONResponse(errorResponse("INVALID_QUERY_PARAM", "toxic_level_source must be self or ai")), nil
+		}
+		query.ToxicLevelSource = source
+	}
+	if params.Tag != nil {
+		tag, err := grumble.NormalizeTag(*params.Tag)
+		if err != nil {
+			return GetGrumbles400JSONResponse(errorResponse("INVALID_QUERY_PARAM", err.Error())), nil
+		}
+		query.Tag = &tag
 	}
+	query.Category = params.Category
 
 	result, err := s.timelineController.GetGrumbles(ctx, query)
 	if err != nil {
@@ -260,15 +362,18 @@ func (s *StrictControllerServer) GetGrumbles(ctx context.Context, request GetGru
 	for i, g := range result.Grumbles {
 		grumbles[i] = toAPIGrumble(g)
 	}
-	total := result.Total
-
-	return GetGrumbles200JSONResponse{Grumbles: &grumbles, Total: &total}, nil
+	return GetGrumbles200JSONResponse{
+		Grumbles:   &grumbles,
+		Total:      result.Total,
+		NextCursor: optionalString(result.NextCursor),
+		HasMore:    result.HasMore,
+	}, nil
 }
 
 // CreateGrumble handles POST /grumbles.
 func (s *StrictControllerServer) CreateGrumble(ctx context.Context, request CreateGrumbleRequestObject) (CreateGrumbleResponseObject, error) {
 	if request.Body == nil {
-		return CreateGrumble400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+		return createGrumble400(errorResponse("INVALID_REQUEST", "request body is required")), nil
 	}
 
 	userID, ok := s.userIDFromContext(ctx)
@@ -278,7 +383,7 @@ func (s *StrictControllerServer) CreateGrumble(ctx context.Context, request Crea
 
 	toxicLevel := shared.ToxicLevel(request.Body.ToxicLevel)
 	if err := toxicLevel.Validate(); err != nil {
-		return CreateGrumble400JSONResponse(errorResponse("VALIDATION_ERROR", err.Error())), nil
+		return createGrumble400(errorResponse("VALIDATION_ERROR", err.Error())), nil
 	}
 
 	input := controller.CreateGrumbleInput{
@@ -286,6 +391,9 @@ func (s *StrictControllerServer) CreateGrumble(ctx context.Context, request Crea
 		Content:           request.Body.Content,
 		ToxicLevel:        toxicLevel,
 		PurifiedThreshold: request.Body.PurifiedThreshold,
+		Lifetime:          request.Body.Lifetime,
+		Category:          request.Body.Category,
+		Timezone:          s.userTimezoneFromContext(ctx),
 		IsEventGrumble:    request.Body.IsEventGrumble != nil && *request.Body.IsEventGrumble,
 	}
 
@@ -354,6 +462,199 @@ func (s *StrictControllerServer) GetMyProfile(ctx context.Context, _ GetMyProfil
 	return GetMyProfile200JSONResponse(apiProfile), nil
 }
 
+// UpdateMyProfile handles PATCH /users/me.
+func (s *StrictControllerServer) UpdateMyProfile(ctx context.Context, request UpdateMyProfileRequestObject) (UpdateMyProfileResponseObject, error) {
+	if request.Body == nil {
+		return UpdateMyProfile400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return UpdateMyProfile401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	profile, err := s.authController.UpdateMyTimezone(ctx, userID, request.Body.Timezone)
+	if err != nil {
+		if resp, ok := s.updateProfileErrorResponse(ctx, err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return UpdateMyProfile200JSONResponse(toAPIAnonymousUser(profile)), nil
+}
+
+// GetMyNotifications handles GET /users/me/notifications.
+func (s *StrictControllerServer) GetMyNotifications(ctx context.Context, request GetMyNotificationsRequestObject) (GetMyNotificationsResponseObject, error) {
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return GetMyNotifications401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	notifications, err := s.notificationController.GetMyNotifications(ctx, userID, limit)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetMyNotifications400JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	apiNotifications := make([]Notification, len(notifications))
+	for i, n := range notifications {
+		apiNotifications[i] = Notification{
+			NotificationID: n.NotificationID,
+			Kind:           NotificationKind(n.Kind),
+			GrumbleID:      n.GrumbleID,
+			Message:        n.Message,
+			CreatedAt:      n.CreatedAt,
+		}
+	}
+
+	return GetMyNotifications200JSONResponse{Notifications: apiNotifications}, nil
+}
+
+// GetGrumble handles GET /grumbles/{grumble_id}.
+func (s *StrictControllerServer) GetGrumble(ctx context.Context, request GetGrumbleRequestObject) (GetGrumbleResponseObject, error) {
+	var viewer *shared.UserID
+	if id, ok := s.userIDFromContext(ctx); ok {
+		viewer = &id
+	}
+
+	grumble, err := s.grumbleController.GetGrumble(ctx, shared.GrumbleID(request.GrumbleID.String()), viewer)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusUnauthorized:
+				return GetGrumble401JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return GetGrumble404JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return GetGrumble200JSONResponse(toAPIGrumble(grumble)), nil
+}
+
+// EditGrumble handles PATCH /grumbles/{grumble_id}.
+func (s *StrictControllerServer) EditGrumble(ctx context.Context, request EditGrumbleRequestObject) (EditGrumbleResponseObject, error) {
+	if request.Body == nil {
+		return editGrumble400(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return EditGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	toxicLevel := shared.ToxicLevel(request.Body.ToxicLevel)
+	if err := toxicLevel.Validate(); err != nil {
+		return editGrumble400(errorResponse("VALIDATION_ERROR", err.Error())), nil
+	}
+
+	grumble, err := s.grumbleController.EditGrumble(ctx, controller.EditGrumbleInput{
+		GrumbleID:  shared.GrumbleID(request.GrumbleID.String()),
+		UserID:     userID,
+		Content:    request.Body.Content,
+		ToxicLevel: toxicLevel,
+	})
+	if err != nil {
+		if resp, ok := s.editGrumbleErrorResponse(ctx, err); ok {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return EditGrumble200JSONResponse(toAPIGrumble(grumble)), nil
+}
+
+// DeleteGrumble handles DELETE /grumbles/{grumble_id}.
+func (s *StrictControllerServer) DeleteGrumble(ctx context.Context, request DeleteGrumbleRequestObject) (DeleteGrumbleResponseObject, error) {
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return DeleteGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	if err := s.grumbleController.DeleteGrumble(ctx, userID, shared.GrumbleID(request.GrumbleID.String())); err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusNotFound {
+			return DeleteGrumble404JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	return DeleteGrumble204Response{}, nil
+}
+
+// AppealGrumble handles POST /grumbles/{grumble_id}/appeal.
+func (s *StrictControllerServer) AppealGrumble(ctx context.Context, request AppealGrumbleRequestObject) (AppealGrumbleResponseObject, error) {
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return AppealGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	appeal, err := s.appealController.SubmitAppeal(ctx, userID, request.GrumbleID.String())
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return AppealGrumble400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return AppealGrumble404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return AppealGrumble409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return AppealGrumble201JSONResponse(toAPIModerationAppeal(appeal)), nil
+}
+
+// ReportGrumble handles POST /grumbles/{grumble_id}/reports.
+func (s *StrictControllerServer) ReportGrumble(ctx context.Context, request ReportGrumbleRequestObject) (ReportGrumbleResponseObject, error) {
+	if request.Body == nil {
+		return ReportGrumble400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	userID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return ReportGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	report, err := s.reportController.ReportGrumble(ctx, controller.ReportGrumbleInput{
+		GrumbleID:  shared.GrumbleID(request.GrumbleID.String()),
+		ReporterID: userID,
+		Reason:     string(request.Body.Reason),
+		Comment:    request.Body.Comment,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return ReportGrumble400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return ReportGrumble404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return ReportGrumble409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return ReportGrumble201JSONResponse{
+		ReportID:  report.ReportID,
+		GrumbleID: openapi_types.UUID(report.GrumbleID),
+		Reason:    ReportReason(report.Reason),
+		Comment:   report.Comment,
+		CreatedAt: report.CreatedAt,
+	}, nil
+}
+
 func (s *StrictControllerServer) timelineErrorResponse(ctx context.Context, err error) (GetGrumblesResponseObject, bool) {
 	if classification, ok := s.classifyError(ctx, err); ok {
 		switch classification.Status {
@@ -367,17 +668,100 @@ func (s *StrictControllerServer) timelineErrorResponse(ctx context.Context, err
 }
 
 func (s *StrictControllerServer) createGrumbleErrorResponse(ctx context.Context, err error) (CreateGrumbleResponseObject, bool) {
+	var selfHarmErr *shared.SelfHarmContentError
+	if errors.As(err, &selfHarmErr) {
+		return CreateGrumble422JSONResponse(toAPICrisisSupport(selfHarmErr)), true
+	}
+	var inappropriateErr *shared.InappropriateContentError
+	if errors.As(err, &inappropriateErr) {
+		resp := CreateGrumble400JSONResponse{
+			Error:            "INAPPROPRIATE_CONTENT",
+			Message:          inappropriateErr.Error(),
+			SuggestedRewrite: inappropriateErr.SuggestedRewrite,
+		}
+		if inappropriateErr.GrumbleID != nil {
+			if grumbleUUID, err := uuid.Parse(string(*inappropriateErr.GrumbleID)); err == nil {
+				resp.GrumbleID = &grumbleUUID
+			}
+		}
+		return resp, true
+	}
+	var cooldownErr *shared.CooldownError
+	if errors.As(err, &cooldownErr) {
+		return CreateGrumble429JSONResponse{
+			Body:    errorResponse("COOLDOWN", cooldownErr.Error()),
+			Headers: CreateGrumble429ResponseHeaders{RetryAfter: retryAfterSeconds(cooldownErr.RetryAfter)},
+		}, true
+	}
+	var quotaErr *shared.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		return CreateGrumble429JSONResponse{
+			Body:    errorResponse("POST_QUOTA_EXCEEDED", quotaErr.Error()),
+			Headers: CreateGrumble429ResponseHeaders{RetryAfter: retryAfterSeconds(quotaErr.RetryAfter)},
+		}, true
+	}
 	if classification, ok := s.classifyError(ctx, err); ok {
 		switch classification.Status {
 		case http.StatusBadRequest:
-			return CreateGrumble400JSONResponse(classification.Payload), true
+			return createGrumble400(classification.Payload), true
 		case http.StatusUnauthorized:
 			return CreateGrumble401JSONResponse(classification.Payload), true
+		case http.StatusForbidden:
+			return CreateGrumble403JSONResponse(classification.Payload), true
+		case http.StatusConflict:
+			return CreateGrumble409JSONResponse(classification.Payload), true
+		}
+	}
+	return nil, false
+}
+
+// createGrumble400 adapts a plain error payload to the createGrumble 400 schema.
+func createGrumble400(payload ErrorResponse) CreateGrumble400JSONResponse {
+	return CreateGrumble400JSONResponse{Error: payload.Error, Message: payload.Message}
+}
+
+func (s *StrictControllerServer) editGrumbleErrorResponse(ctx context.Context, err error) (EditGrumbleResponseObject, bool) {
+	var selfHarmErr *shared.SelfHarmContentError
+	if errors.As(err, &selfHarmErr) {
+		return EditGrumble422JSONResponse(toAPICrisisSupport(selfHarmErr)), true
+	}
+	var inappropriateErr *shared.InappropriateContentError
+	if errors.As(err, &inappropriateErr) {
+		return EditGrumble400JSONResponse{
+			Error:            "INAPPROPRIATE_CONTENT",
+			Message:          inappropriateErr.Error(),
+			SuggestedRewrite: inappropriateErr.SuggestedRewrite,
+		}, true
+	}
+	var cooldownErr *shared.CooldownError
+	if errors.As(err, &cooldownErr) {
+		return EditGrumble429JSONResponse{
+			Body:    errorResponse("COOLDOWN", cooldownErr.Error()),
+			Headers: EditGrumble429ResponseHeaders{RetryAfter: retryAfterSeconds(cooldownErr.RetryAfter)},
+		}, true
+	}
+	if classification, ok := s.classifyError(ctx, err); ok {
+		switch classification.Status {
+		case http.StatusBadRequest:
+			return editGrumble400(classification.Payload), true
+		case http.StatusUnauthorized:
+			return EditGrumble401JSONResponse(classification.Payload), true
+		case http.StatusForbidden:
+			return EditGrumble403JSONResponse(classification.Payload), true
+		case http.StatusNotFound:
+			return EditGrumble404JSONResponse(classification.Payload), true
+		case http.StatusConflict:
+			return EditGrumble409JSONResponse(classification.Payload), true
 		}
 	}
 	return nil, false
 }
 
+// editGrumble400 adapts a plain error payload to the editGrumble 400 schema.
+func editGrumble400(payload ErrorResponse) EditGrumble400JSONResponse {
+	return EditGrumble400JSONResponse{Error: payload.Error, Message: payload.Message}
+}
+
 func (s *StrictControllerServer) addVibeErrorResponse(ctx context.Context, err error) (AddVibeResponseObject, bool) {
 	if classification, ok := s.classifyError(ctx, err); ok {
 		switch classification.Status {
@@ -404,6 +788,18 @@ func (s *StrictControllerServer) profileErrorResponse(ctx context.Context, err e
 	return nil, false
 }
 
+func (s *StrictControllerServer) updateProfileErrorResponse(ctx context.Context, err error) (UpdateMyProfileResponseObject, bool) {
+	if classification, ok := s.classifyError(ctx, err); ok {
+		switch classification.Status {
+		case http.StatusBadRequest:
+			return UpdateMyProfile400JSONResponse(classification.Payload), true
+		case http.StatusUnauthorized:
+			return UpdateMyProfile401JSONResponse(classification.Payload), true
+		}
+	}
+	return nil, false
+}
+
 type errorClassification struct {
 	Status  int
 	Payload ErrorResponse
@@ -414,7 +810,12 @@ func (s *StrictControllerServer) classifyError(ctx context.Context, err error) (
 		validationErr           *shared.ValidationError
 		notFoundErr             *shared.NotFoundError
 		duplicateErr            *shared.DuplicateVibeError
+		conflictErr             *shared.ConflictError
 		unauthorizedErr         *shared.UnauthorizedError
+		forbiddenErr            *shared.ForbiddenError
+		cooldownErr             *shared.CooldownError
+		duplicateGrumbleErr     *shared.DuplicateGrumbleError
+		quotaExceededErr        *shared.QuotaExceededError
 		inappropriateContentErr *shared.InappropriateContentError
 		internalErr             *shared.InternalError
 	)
@@ -428,8 +829,18 @@ func (s *StrictControllerServer) classifyError(ctx context.Context, err error) (
 		return errorClassification{Status: http.StatusNotFound, Payload: errorResponse("NOT_FOUND", notFoundErr.Error())}, true
 	case errors.As(err, &duplicateErr):
 		return errorClassification{Status: http.StatusConflict, Payload: errorResponse("DUPLICATE_VIBE", duplicateErr.Error())}, true
+	case errors.As(err, &duplicateGrumbleErr):
+		return errorClassification{Status: http.StatusConflict, Payload: errorResponse("DUPLICATE_GRUMBLE", duplicateGrumbleErr.Error())}, true
+	case errors.As(err, &conflictErr):
+		return errorClassification{Status: http.StatusConflict, Payload: errorResponse("CONFLICT", conflictErr.Error())}, true
 	case errors.As(err, &unauthorizedErr):
 		return errorClassification{Status: http.StatusUnauthorized, Payload: errorResponse("UNAUTHORIZED", unauthorizedErr.Error())}, true
+	case errors.As(err, &forbiddenErr):
+		return errorClassification{Status: http.StatusForbidden, Payload: errorResponse("FORBIDDEN", forbiddenErr.Error())}, true
+	case errors.As(err, &cooldownErr):
+		return errorClassification{Status: http.StatusTooManyRequests, Payload: errorResponse("COOLDOWN", cooldownErr.Error())}, true
+	case errors.As(err, &quotaExceededErr):
+		return errorClassification{Status: http.StatusTooManyRequests, Payload: errorResponse("POST_QUOTA_EXCEEDED", quotaExceededErr.Error())}, true
 	case errors.As(err, &internalErr):
 		s.logger.ErrorContext(ctx, "Internal error", "error", internalErr)
 		return errorClassification{}, false
@@ -450,6 +861,18 @@ func (s *StrictControllerServer) userIDFromContext(ctx context.Context) (shared.
 	return "", false
 }
 
+// userTimezoneFromContext returns the authenticated user's timezone preference, or "" for the default zone
+func (s *StrictControllerServer) userTimezoneFromContext(ctx context.Context) string {
+	if ginCtx, ok := ginContext(ctx); ok {
+		if value, exists := ginCtx.Get("user"); exists {
+			if u, ok := value.(*user.AnonymousUser); ok && u != nil && u.Timezone != nil {
+				return *u.Timezone
+			}
+		}
+	}
+	return ""
+}
+
 func ginContext(ctx context.Context) (*gin.Context, bool) {
 	if g, ok := ctx.(*gin.Context); ok {
 		return g, true
@@ -464,8 +887,10 @@ func toAPIGrumble(resp *controller.GrumbleResponse) Grumble {
 		hasVibed = &value
 	}
 
-	return Grumble{
+	g := Grumble{
 		GrumbleID:         openapi_types.UUID(resp.GrumbleID),
+		AuthorHandle:      resp.AuthorHandle,
+		IsMine:            resp.IsMine,
 		Content:           resp.Content,
 		ToxicLevel:        resp.ToxicLevel,
 		VibeCount:         resp.VibeCount,
@@ -475,6 +900,49 @@ func toAPIGrumble(resp *controller.GrumbleResponse) Grumble {
 		ExpiresAt:         resp.ExpiresAt,
 		IsEventGrumble:    resp.IsEventGrumble,
 		HasVibed:          hasVibed,
+		IsEdited:          resp.IsEdited,
+		EditedAt:          resp.EditedAt,
+		Status:            GrumbleStatus(resp.Status),
+		Tags:              make([]GrumbleTag, len(resp.Tags)),
+		Category:          resp.Category,
+	}
+	for i, t := range resp.Tags {
+		g.Tags[i] = GrumbleTag{Name: t.Name, Source: GrumbleTagSource(t.Source)}
+	}
+	if resp.ModerationStatus != "" {
+		status := GrumbleModerationStatus(resp.ModerationStatus)
+		g.ModerationStatus = &status
+	}
+	if resp.AIToxicLevel != nil {
+		level := *resp.AIToxicLevel
+		g.AiToxicLevel = &level
+	}
+	if resp.ToxicLevelMismatch {
+		mismatch := true
+		g.ToxicLevelMismatch = &mismatch
+	}
+	return g
+}
+
+func toAPICrisisSupport(err *shared.SelfHarmContentError) CrisisSupportResponse {
+	resources := make([]SupportResource, len(err.Support.Resources))
+	for i, r := range err.Support.Resources {
+		resources[i] = SupportResource{
+			Name:    r.Name,
+			Contact: r.Contact,
+		}
+		if r.URL != "" {
+			url := r.URL
+			resources[i].URL = &url
+		}
+	}
+
+	grumbleID, _ := uuid.Parse(string(err.GrumbleID))
+	return CrisisSupportResponse{
+		Error:     "CRISIS_SUPPORT",
+		Message:   err.Support.Message,
+		GrumbleID: openapi_types.UUID(grumbleID),
+		Resources: resources,
 	}
 }
 
@@ -492,6 +960,7 @@ func toAPIAnonymousUser(resp *controller.MyProfileResponse) AnonymousUser {
 		UserID:       openapi_types.UUID(resp.UserID),
 		VirtuePoints: resp.VirtuePoints,
 		CreatedAt:    resp.CreatedAt,
+		Timezone:     resp.Timezone,
 	}
 	if resp.ProfileTitle != nil {
 		anon.ProfileTitle = nullable.NewNullableWithValue(*resp.ProfileTitle)
@@ -514,3 +983,20 @@ func isValidationError(err error) bool {
 func errorResponse(code, message string) ErrorResponse {
 	return ErrorResponse{Error: code, Message: message}
 }
+
+// retryAfterSeconds rounds a wait up to whole seconds for the Retry-After header.
+func retryAfterSeconds(d time.Duration) int {
+	seconds := int((d + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// optionalString maps an empty string to an omitted field.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,579 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dokkiitech/grumble-back/internal/controller"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetModerationVerdicts handles GET /admin/moderation/verdicts.
+func (s *StrictControllerServer) GetModerationVerdicts(ctx context.Context, request GetModerationVerdictsRequestObject) (GetModerationVerdictsResponseObject, error) {
+	params := request.Params
+
+	query := controller.VerdictQuery{
+		Unreviewed:    params.Unreviewed != nil && *params.Unreviewed,
+		PromptVersion: params.PromptVersion,
+		From:          params.From,
+		To:            params.To,
+	}
+	if params.Category != nil {
+		category := string(*params.Category)
+		query.Category = &category
+	}
+	if params.Decision != nil {
+		decision := string(*params.Decision)
+		query.Decision = &decision
+	}
+	if params.ReviewLabel != nil {
+		label := string(*params.ReviewLabel)
+		query.ReviewLabel = &label
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		query.Offset = *params.Offset
+	}
+
+	result, err := s.moderationController.ListVerdicts(ctx, query)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetModerationVerdicts400JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	verdicts := make([]ModerationVerdict, len(result.Verdicts))
+	for i, v := range result.Verdicts {
+		verdicts[i] = toAPIModerationVerdict(v)
+	}
+
+	return GetModerationVerdicts200JSONResponse{Verdicts: verdicts, Total: result.Total}, nil
+}
+
+// ReviewModerationVerdict handles PUT /admin/moderation/verdicts/{verdict_id}/review.
+func (s *StrictControllerServer) ReviewModerationVerdict(ctx context.Context, request ReviewModerationVerdictRequestObject) (ReviewModerationVerdictResponseObject, error) {
+	if request.Body == nil {
+		return ReviewModerationVerdict400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	reviewerID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return ReviewModerationVerdict401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	input := controller.ReviewVerdictInput{
+		VerdictID:  request.VerdictID,
+		Label:      string(request.Body.Label),
+		Note:       request.Body.Note,
+		ReviewerID: reviewerID,
+	}
+
+	verdict, err := s.moderationController.ReviewVerdict(ctx, input)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return ReviewModerationVerdict400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return ReviewModerationVerdict404JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return ReviewModerationVerdict200JSONResponse(toAPIModerationVerdict(verdict)), nil
+}
+
+// GetModerationAppeals handles GET /admin/moderation/appeals.
+func (s *StrictControllerServer) GetModerationAppeals(ctx context.Context, request GetModerationAppealsRequestObject) (GetModerationAppealsResponseObject, error) {
+	params := request.Params
+
+	query := controller.AppealQuery{}
+	if params.Status != nil {
+		status := string(*params.Status)
+		query.Status = &status
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		query.Offset = *params.Offset
+	}
+
+	result, err := s.appealController.ListAppeals(ctx, query)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetModerationAppeals400JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	appeals := make([]ModerationAppeal, len(result.Appeals))
+	for i, a := range result.Appeals {
+		appeals[i] = toAPIModerationAppeal(a)
+	}
+
+	return GetModerationAppeals200JSONResponse{Appeals: appeals, Total: result.Total}, nil
+}
+
+// ResolveModerationAppeal handles PUT /admin/moderation/appeals/{appeal_id}/resolve.
+func (s *StrictControllerServer) ResolveModerationAppeal(ctx context.Context, request ResolveModerationAppealRequestObject) (ResolveModerationAppealResponseObject, error) {
+	if request.Body == nil {
+		return ResolveModerationAppeal400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	moderatorID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return ResolveModerationAppeal401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	appeal, err := s.appealController.ResolveAppeal(ctx, controller.ResolveAppealInput{
+		AppealID:    request.AppealID,
+		Decision:    string(request.Body.Decision),
+		Note:        request.Body.Note,
+		ModeratorID: moderatorID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return ResolveModerationAppeal400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return ResolveModerationAppeal404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return ResolveModerationAppeal409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return ResolveModerationAppeal200JSONResponse(toAPIModerationAppeal(appeal)), nil
+}
+
+// GetReportedGrumbles handles GET /admin/moderation/reports.
+func (s *StrictControllerServer) GetReportedGrumbles(ctx context.Context, request GetReportedGrumblesRequestObject) (GetReportedGrumblesResponseObject, error) {
+	limit, offset := 0, 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := s.reportController.ListQueue(ctx, limit, offset)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetReportedGrumbles400JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	items := make([]ReportedGrumble, len(result.Items))
+	for i, item := range result.Items {
+		reasons := make([]ReportReasonCount, len(item.Reasons))
+		for j, r := range item.Reasons {
+			reasons[j] = ReportReasonCount{Reason: ReportReason(r.Reason), Count: r.Count}
+		}
+		items[i] = ReportedGrumble{
+			GrumbleID:        openapi_types.UUID(item.GrumbleID),
+			Content:          item.Content,
+			ModerationStatus: item.ModerationStatus,
+			Archived:         item.Archived,
+			ReportCount:      item.ReportCount,
+			Reasons:          reasons,
+			FirstReportedAt:  item.FirstReportedAt,
+			LastReportedAt:   item.LastReportedAt,
+		}
+	}
+
+	return GetReportedGrumbles200JSONResponse{ReportedGrumbles: items, Total: result.Total}, nil
+}
+
+// ResolveGrumbleReports handles PUT /admin/moderation/reports/{grumble_id}/resolve.
+func (s *StrictControllerServer) ResolveGrumbleReports(ctx context.Context, request ResolveGrumbleReportsRequestObject) (ResolveGrumbleReportsResponseObject, error) {
+	if request.Body == nil {
+		return ResolveGrumbleReports400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	moderatorID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return ResolveGrumbleReports401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	result, err := s.reportController.ResolveReports(ctx, controller.ResolveReportsInput{
+		GrumbleID:   shared.GrumbleID(request.GrumbleID.String()),
+		Action:      string(request.Body.Action),
+		Note:        request.Body.Note,
+		ModeratorID: moderatorID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return ResolveGrumbleReports400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return ResolveGrumbleReports404JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return ResolveGrumbleReports200JSONResponse{
+		GrumbleID:        request.GrumbleID,
+		ModerationStatus: result.ModerationStatus,
+		ResolvedReports:  result.ResolvedReports,
+	}, nil
+}
+
+// GetModerationCacheStats handles GET /admin/moderation/cache/stats.
+func (s *StrictControllerServer) GetModerationCacheStats(_ context.Context, _ GetModerationCacheStatsRequestObject) (GetModerationCacheStatsResponseObject, error) {
+	stats := s.moderationController.GetCacheStats()
+	return GetModerationCacheStats200JSONResponse{
+		Enabled: stats.Enabled,
+		Hits:    stats.Hits,
+		Misses:  stats.Misses,
+		HitRate: stats.HitRate,
+		Entries: stats.Entries,
+	}, nil
+}
+
+func toAPIModerationVerdict(resp *controller.ModerationVerdictResponse) ModerationVerdict {
+	categories := make([]ModerationCategory, len(resp.Categories))
+	for i, c := range resp.Categories {
+		categories[i] = ModerationCategory(c)
+	}
+
+	v := ModerationVerdict{
+		VerdictID:     resp.VerdictID,
+		ContentHash:   resp.ContentHash,
+		UserID:        openapi_types.UUID(resp.UserID),
+		Decision:      ModerationVerdictDecision(resp.Decision),
+		Categories:    categories,
+		Reason:        resp.Reason,
+		Model:         resp.Model,
+		PromptVersion: resp.PromptVersion,
+		LatencyMs:     resp.LatencyMs,
+		CreatedAt:     resp.CreatedAt,
+		ReviewNote:    resp.ReviewNote,
+		ReviewedAt:    resp.ReviewedAt,
+	}
+	if resp.GrumbleID != nil {
+		grumbleID := openapi_types.UUID(*resp.GrumbleID)
+		v.GrumbleID = &grumbleID
+	}
+	if resp.ReviewLabel != nil {
+		label := ModerationVerdictReviewLabel(*resp.ReviewLabel)
+		v.ReviewLabel = &label
+	}
+	if resp.ReviewedBy != nil {
+		reviewedBy := openapi_types.UUID(*resp.ReviewedBy)
+		v.ReviewedBy = &reviewedBy
+	}
+	return v
+}
+
+func toAPIModerationAppeal(resp *controller.ModerationAppealResponse) ModerationAppeal {
+	a := ModerationAppeal{
+		AppealID:        resp.AppealID,
+		GrumbleID:       openapi_types.UUID(resp.GrumbleID),
+		UserID:          openapi_types.UUID(resp.UserID),
+		VerdictID:       resp.VerdictID,
+		Status:          ModerationAppealStatus(resp.Status),
+		Content:         resp.Content,
+		RejectionReason: resp.RejectionReason,
+		CreatedAt:       resp.CreatedAt,
+		ResolvedAt:      resp.ResolvedAt,
+		ResolutionNote:  resp.ResolutionNote,
+	}
+	if resp.ResolvedBy != nil {
+		resolvedBy := openapi_types.UUID(*resp.ResolvedBy)
+		a.ResolvedBy = &resolvedBy
+	}
+	return a
+}
+
+// GetAdminGrumble handles GET /admin/grumbles/{grumble_id}.
+func (s *StrictControllerServer) GetAdminGrumble(ctx context.Context, request GetAdminGrumbleRequestObject) (GetAdminGrumbleResponseObject, error) {
+	inspection, err := s.adminController.InspectGrumble(ctx, shared.GrumbleID(request.GrumbleID.String()))
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusNotFound {
+			return GetAdminGrumble404JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	recent := make([]AdminGrumble, len(inspection.RecentGrumbles))
+	for i, g := range inspection.RecentGrumbles {
+		recent[i] = toAPIAdminGrumble(g)
+	}
+
+	return GetAdminGrumble200JSONResponse{
+		Grumble:              toAPIAdminGrumble(inspection.Grumble),
+		Author:               toAPIAnonymousUser(inspection.Author),
+		AuthorStatusCounts:   inspection.StatusCounts,
+		AuthorRecentGrumbles: recent,
+	}, nil
+}
+
+// TakeDownGrumble handles PUT /admin/grumbles/{grumble_id}/takedown.
+func (s *StrictControllerServer) TakeDownGrumble(ctx context.Context, request TakeDownGrumbleRequestObject) (TakeDownGrumbleResponseObject, error) {
+	if request.Body == nil {
+		return TakeDownGrumble400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	adminID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return TakeDownGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	g, err := s.adminController.TakeDownGrumble(ctx, controller.GrumbleActionInput{
+		GrumbleID: shared.GrumbleID(request.GrumbleID.String()),
+		Reason:    request.Body.Reason,
+		AdminID:   adminID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return TakeDownGrumble400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return TakeDownGrumble404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return TakeDownGrumble409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return TakeDownGrumble200JSONResponse(toAPIAdminGrumble(g)), nil
+}
+
+// RestoreGrumble handles PUT /admin/grumbles/{grumble_id}/restore.
+func (s *StrictControllerServer) RestoreGrumble(ctx context.Context, request RestoreGrumbleRequestObject) (RestoreGrumbleResponseObject, error) {
+	if request.Body == nil {
+		return RestoreGrumble400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	adminID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return RestoreGrumble401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	g, err := s.adminController.RestoreGrumble(ctx, controller.GrumbleActionInput{
+		GrumbleID: shared.GrumbleID(request.GrumbleID.String()),
+		Reason:    request.Body.Reason,
+		AdminID:   adminID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return RestoreGrumble400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return RestoreGrumble404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return RestoreGrumble409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return RestoreGrumble200JSONResponse(toAPIAdminGrumble(g)), nil
+}
+
+// AdjustVirtuePoints handles POST /admin/users/{user_id}/virtue-points.
+func (s *StrictControllerServer) AdjustVirtuePoints(ctx context.Context, request AdjustVirtuePointsRequestObject) (AdjustVirtuePointsResponseObject, error) {
+	if request.Body == nil {
+		return AdjustVirtuePoints400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	adminID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return AdjustVirtuePoints401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	profile, err := s.adminController.AdjustVirtuePoints(ctx, controller.AdjustVirtueInput{
+		UserID:  shared.UserID(request.UserID.String()),
+		Delta:   request.Body.Delta,
+		Reason:  request.Body.Reason,
+		AdminID: adminID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return AdjustVirtuePoints400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return AdjustVirtuePoints404JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return AdjustVirtuePoints200JSONResponse(toAPIAnonymousUser(profile)), nil
+}
+
+// GetUserSanctions handles GET /admin/users/{user_id}/sanctions.
+func (s *StrictControllerServer) GetUserSanctions(ctx context.Context, request GetUserSanctionsRequestObject) (GetUserSanctionsResponseObject, error) {
+	sanctions, err := s.adminController.ListSanctions(ctx, shared.UserID(request.UserID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(GetUserSanctions200JSONResponse, len(sanctions))
+	for i, item := range sanctions {
+		result[i] = toAPISanction(item)
+	}
+	return result, nil
+}
+
+// IssueSanction handles POST /admin/users/{user_id}/sanctions.
+func (s *StrictControllerServer) IssueSanction(ctx context.Context, request IssueSanctionRequestObject) (IssueSanctionResponseObject, error) {
+	if request.Body == nil {
+		return IssueSanction400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	adminID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return IssueSanction401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	input := controller.IssueSanctionInput{
+		UserID:  shared.UserID(request.UserID.String()),
+		Level:   string(request.Body.Level),
+		Reason:  request.Body.Reason,
+		AdminID: adminID,
+	}
+	if request.Body.DurationMinutes != nil {
+		input.DurationMinutes = *request.Body.DurationMinutes
+	}
+
+	issued, err := s.adminController.IssueSanction(ctx, input)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return IssueSanction400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return IssueSanction404JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return IssueSanction201JSONResponse(toAPISanction(issued)), nil
+}
+
+// RevokeSanction handles PUT /admin/users/{user_id}/sanctions/{sanction_id}/revoke.
+func (s *StrictControllerServer) RevokeSanction(ctx context.Context, request RevokeSanctionRequestObject) (RevokeSanctionResponseObject, error) {
+	if request.Body == nil {
+		return RevokeSanction400JSONResponse(errorResponse("INVALID_REQUEST", "request body is required")), nil
+	}
+
+	adminID, ok := s.userIDFromContext(ctx)
+	if !ok {
+		return RevokeSanction401JSONResponse(errorResponse("UNAUTHORIZED", "User not authenticated")), nil
+	}
+
+	revoked, err := s.adminController.RevokeSanction(ctx, controller.RevokeSanctionInput{
+		UserID:     shared.UserID(request.UserID.String()),
+		SanctionID: request.SanctionID,
+		Reason:     request.Body.Reason,
+		AdminID:    adminID,
+	})
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok {
+			switch classification.Status {
+			case http.StatusBadRequest:
+				return RevokeSanction400JSONResponse(classification.Payload), nil
+			case http.StatusNotFound:
+				return RevokeSanction404JSONResponse(classification.Payload), nil
+			case http.StatusConflict:
+				return RevokeSanction409JSONResponse(classification.Payload), nil
+			}
+		}
+		return nil, err
+	}
+
+	return RevokeSanction200JSONResponse(toAPISanction(revoked)), nil
+}
+
+// GetAuditLog handles GET /admin/audit-log.
+func (s *StrictControllerServer) GetAuditLog(ctx context.Context, request GetAuditLogRequestObject) (GetAuditLogResponseObject, error) {
+	params := request.Params
+
+	query := controller.AuditLogQuery{TargetID: params.TargetID}
+	if params.ActorID != nil {
+		actorID := shared.UserID(params.ActorID.String())
+		query.ActorID = &actorID
+	}
+	if params.Action != nil {
+		action := string(*params.Action)
+		query.Action = &action
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		query.Offset = *params.Offset
+	}
+
+	result, err := s.adminController.ListAuditLog(ctx, query)
+	if err != nil {
+		if classification, ok := s.classifyError(ctx, err); ok && classification.Status == http.StatusBadRequest {
+			return GetAuditLog400JSONResponse(classification.Payload), nil
+		}
+		return nil, err
+	}
+
+	entries := make([]AuditLogEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		entries[i] = AuditLogEntry{
+			EntryID:    e.EntryID,
+			ActorID:    openapi_types.UUID(e.ActorID),
+			Action:     AuditAction(e.Action),
+			TargetType: e.TargetType,
+			TargetID:   e.TargetID,
+			Reason:     e.Reason,
+			Detail:     e.Detail,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+
+	return GetAuditLog200JSONResponse{Entries: entries, Total: result.Total}, nil
+}
+
+func toAPIAdminGrumble(g *controller.AdminGrumbleResponse) AdminGrumble {
+	return AdminGrumble{
+		GrumbleID:        openapi_types.UUID(g.GrumbleID),
+		UserID:           openapi_types.UUID(g.UserID),
+		Content:          g.Content,
+		ToxicLevel:       g.ToxicLevel,
+		AiToxicLevel:     g.AIToxicLevel,
+		ModerationStatus: g.ModerationStatus,
+		VibeCount:        g.VibeCount,
+		IsPurified:       g.IsPurified,
+		PostedAt:         g.PostedAt,
+		ExpiresAt:        g.ExpiresAt,
+	}
+}
+
+func toAPISanction(item *controller.SanctionResponse) Sanction {
+	return Sanction{
+		SanctionID: item.SanctionID,
+		UserID:     openapi_types.UUID(item.UserID),
+		Level:      SanctionLevel(item.Level),
+		Source:     SanctionSource(item.Source),
+		Reason:     item.Reason,
+		IssuedBy:   item.IssuedBy,
+		Active:     item.Active,
+		CreatedAt:  item.CreatedAt,
+		ExpiresAt:  item.ExpiresAt,
+		RevokedBy:  item.RevokedBy,
+		RevokedAt:  item.RevokedAt,
+	}
+}
@@ -0,0 +1,145 @@
+// Package app builds the dependencies the API server and the batch worker share,
+// so both binaries moderate and sanction with the same wiring.
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/config"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/infrastructure"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Repositories holds the Postgres repositories and the transactor over one connection pool
+type Repositories struct {
+	Grumble         *infrastructure.PostgresGrumbleRepository
+	User            *infrastructure.PostgresUserRepository
+	Vibe            *infrastructure.PostgresVibeRepository
+	Verdict         *infrastructure.PostgresModerationVerdictRepository
+	Notification    *infrastructure.PostgresNotificationRepository
+	Appeal          *infrastructure.PostgresModerationAppealRepository
+	Report          *infrastructure.PostgresGrumbleReportRepository
+	Audit           *infrastructure.PostgresAuditLogRepository
+	Sanction        *infrastructure.PostgresSanctionRepository
+	PostLog         *infrastructure.PostgresPostLogRepository
+	ModerationCache *infrastructure.PostgresModerationCacheStore
+	Transactor      *infrastructure.PostgresTransactor
+}
+
+// NewRepositories creates every repository on the given pool
+func NewRepositories(db *pgxpool.Pool) *Repositories {
+	return &Repositories{
+		Grumble:         infrastructure.NewPostgresGrumbleRepository(db),
+		User:            infrastructure.NewPostgresUserRepository(db),
+		Vibe:            infrastructure.NewPostgresVibeRepository(db),
+		Verdict:         infrastructure.NewPostgresModerationVerdictRepository(db),
+		Notification:    infrastructure.NewPostgresNotificationRepository(db),
+		Appeal:          infrastructure.NewPostgresModerationAppealRepository(db),
+		Report:          infrastructure.NewPostgresGrumbleReportRepository(db),
+		Audit:           infrastructure.NewPostgresAuditLogRepository(db),
+		Sanction:        infrastructure.NewPostgresSanctionRepository(db),
+		PostLog:         infrastructure.NewPostgresPostLogRepository(db),
+		ModerationCache: infrastructure.NewPostgresModerationCacheStore(db),
+		Transactor:      infrastructure.NewPostgresTransactor(db),
+	}
+}
+
+// Moderation holds what grumbles are moderated with
+type Moderation struct {
+	Prompts *grumble.PromptSelector
+	Filter  grumble.ContentFilterClient          // The LLM filter, behind the result cache when the cache is enabled
+	LLM     *infrastructure.LLMContentFilter     // Also suggests rewrites and topics
+	Cache   *infrastructure.CachingContentFilter // nil when the cache is disabled
+}
+
+// NewModeration loads the versioned moderation prompts and builds the configured LLM content filter,
+// optionally behind the moderation result cache
+func NewModeration(cfg *config.Config, repos *Repositories, logger logging.Logger) (*Moderation, error) {
+	// Load versioned moderation prompts and pick the active (and optional A/B candidate) version
+	prompts, err := infrastructure.LoadModerationPrompts(cfg.ModerationPromptDir)
+	if err != nil {
+		return nil, fmt.Errorf("load moderation prompts: %w", err)
+	}
+	selector, err := grumble.NewPromptSelector(
+		prompts,
+		cfg.ModerationPromptVersion,
+		cfg.ModerationPromptCandidateVersion,
+		cfg.ModerationPromptCandidatePercent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid moderation prompt configuration: %w", err)
+	}
+
+	var provider infrastructure.LLMProvider = infrastructure.NewGeminiClient(
+		cfg.GeminiAPIKey,
+		cfg.GeminiModel,
+		cfg.GeminiBaseURL,
+		time.Duration(cfg.GeminiTimeoutSeconds)*time.Second,
+	)
+	if cfg.LLMProvider == config.LLMProviderOpenAI {
+		provider = infrastructure.NewOpenAICompatibleClient(cfg.OpenAIBaseURL, cfg.OpenAIModel, cfg.OpenAIAPIKey)
+	}
+
+	m := &Moderation{Prompts: selector, LLM: infrastructure.NewLLMContentFilter(provider)}
+	m.Filter = m.LLM
+	if cfg.ModerationCacheSize > 0 {
+		var store grumble.ModerationCacheStore
+		if cfg.ModerationCachePersist {
+			store = repos.ModerationCache
+		}
+		m.Cache = infrastructure.NewCachingContentFilter(
+			m.LLM,
+			cfg.LLMProvider+"/"+provider.Model(),
+			store,
+			cfg.ModerationCacheSize,
+			time.Duration(cfg.ModerationCacheTTLMinutes)*time.Minute,
+			logger,
+		)
+		m.Filter = m.Cache
+	}
+	return m, nil
+}
+
+// Policies holds the moderation, sanction and spam rules read from configuration
+type Policies struct {
+	ToxicLevel    grumble.ToxicLevelPolicy
+	CrisisSupport shared.CrisisSupport // Offered when self-harm content is detected
+	Sanction      sanction.Policy      // Escalating sanctions for users whose grumbles keep getting rejected
+	Spam          grumble.SpamPolicy   // Per-user duplicate and quota limits, checked against fingerprints in the post log
+}
+
+// NewPolicies builds the policies from configuration
+func NewPolicies(cfg *config.Config) Policies {
+	crisisSupport := shared.CrisisSupport{Message: cfg.CrisisSupportMessage}
+	for _, h := range cfg.CrisisHotlines {
+		crisisSupport.Resources = append(crisisSupport.Resources, shared.SupportResource{
+			Name:    h.Name,
+			Contact: h.Contact,
+			URL:     h.URL,
+		})
+	}
+
+	return Policies{
+		ToxicLevel: grumble.ToxicLevelPolicy{
+			Mode:   grumble.ToxicLevelPolicyMode(cfg.ToxicLevelPolicy),
+			MaxGap: cfg.ToxicLevelMaxGap,
+		},
+		CrisisSupport: crisisSupport,
+		Sanction: sanction.Policy{
+			RejectionThreshold: cfg.SanctionRejectionThreshold,
+			Window:             time.Duration(cfg.SanctionRejectionWindowHours) * time.Hour,
+			EscalationLookback: time.Duration(cfg.SanctionEscalationDays) * 24 * time.Hour,
+			CooldownDuration:   time.Duration(cfg.SanctionCooldownMinutes) * time.Minute,
+			ShadowBanDuration:  time.Duration(cfg.SanctionShadowBanHours) * time.Hour,
+		},
+		Spam: grumble.SpamPolicy{
+			DuplicateWindow: time.Duration(cfg.SpamDuplicateWindowMinutes) * time.Minute,
+			DailyQuota:      cfg.DailyPostQuota,
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -22,6 +23,11 @@ type Config struct {
 	// Authentication
 	FirebaseProjectID       string
 	FirebaseCredentialsFile string
+	AdminUserIDs            []string
+	ModeratorUserIDs        []string
+
+	// Key for the per-grumble author pseudonyms; rotating it changes every handle
+	PseudonymSecret string
 
 	// Business Rules
 	PurificationThresholdDefault   int
@@ -40,29 +46,175 @@ type Config struct {
 	DBMinConns int
 
 	// Content Moderation
-	GeminiAPIKey string
-	GeminiModel  string
+	LLMProvider          string // "gemini" or "openai" (any OpenAI-compatible endpoint)
+	GeminiAPIKey         string
+	GeminiModel          string
+	GeminiBaseURL        string // empty uses the public endpoint
+	GeminiTimeoutSeconds int
+	OpenAIBaseURL        string
+	OpenAIModel          string
+	OpenAIAPIKey         string
+	ModerationMode       string // "sync" blocks posting on moderation; "async" defers it to the batch worker
+	ModerationBatchSize  int
+
+	// Moderation prompts: <version>.txt templates in ModerationPromptDir.
+	// ModerationPromptCandidatePercent of users (by hashed user ID) get the candidate version.
+	ModerationPromptDir              string
+	ModerationPromptVersion          string
+	ModerationPromptCandidateVersion string
+	ModerationPromptCandidatePercent int
+
+	// Moderation result cache (size 0 disables it)
+	ModerationCacheSize       int
+	ModerationCacheTTLMinutes int
+	ModerationCachePersist    bool
+
+	// Offer a moderated, softened rewrite when a post is rejected
+	ModerationRewriteSuggestions bool
+
+	// Tag grumbles without hashtags with a topic suggested by the LLM
+	GrumbleTopicSuggestions bool
+
+	// How to react when the self-reported toxic level diverges from the AI estimate
+	ToxicLevelPolicy string // "off", "nudge" or "clamp"
+	ToxicLevelMaxGap int
+
+	// Spam detection: near-duplicates of the user's posts within the window are refused,
+	// as are posts beyond the quota per rolling 24 hours. 0 disables either check.
+	SpamDuplicateWindowMinutes int
+	DailyPostQuota             int
+
+	// Lifetimes authors may choose when posting ("midnight" or a duration up to 24h such as "6h"),
+	// the default when they choose none, and the shortest time any grumble stays up
+	GrumbleLifetimes          []string
+	GrumbleLifetimeDefault    string
+	GrumbleMinLifetimeMinutes int
+
+	// Categories authors may file grumbles under, in the "key|label" format and display order
+	GrumbleCategories []string
+
+	// Stats periods: the timezone used when a request names none, and whether weekly buckets start on Sunday instead of Monday
+	StatsTimezone           string
+	StatsWeekStartsOnSunday bool
+
+	// Seconds after posting during which the author may edit a grumble that has no vibes yet; 0 disables editing
+	GrumbleEditWindowSeconds int
+
+	// Automatic sanctions: SanctionRejectionThreshold rejections within the window escalate
+	// warning -> cooldown -> shadow-ban, counting earlier sanctions within the lookback.
+	// Bans are only issued by admins. A threshold of 0 disables automatic sanctions.
+	SanctionRejectionThreshold   int
+	SanctionRejectionWindowHours int
+	SanctionEscalationDays       int
+	SanctionCooldownMinutes      int
+	SanctionShadowBanHours       int
+
+	// Unresolved user reports needed to hide a grumble until a moderator reviews it
+	ReportHideThreshold int
+
+	// Crisis Support (shown when self-harm content is detected)
+	CrisisSupportMessage string
+	CrisisHotlines       []CrisisHotline
+}
+
+// Moderation modes accepted by MODERATION_MODE.
+const (
+	ModerationModeSync  = "sync"
+	ModerationModeAsync = "async"
+)
+
+// LLM providers accepted by LLM_PROVIDER.
+const (
+	LLMProviderGemini = "gemini"
+	LLMProviderOpenAI = "openai"
+)
+
+// CrisisHotline is a support contact offered to users in crisis.
+type CrisisHotline struct {
+	Name    string
+	Contact string
+	URL     string
+}
+
+const defaultCrisisSupportMessage = "つらい気持ちを書いてくれてありがとう。この投稿はあなただけが見られる状態で保存しました。ひとりで抱えこまず、よければ話を聞いてくれる窓口も頼ってみてください。"
+
+// defaultCrisisHotlines uses the "name|contact|url" format accepted by CRISIS_HOTLINES.
+var defaultCrisisHotlines = []string{
+	"いのちの電話|0570-783-556|https://www.inochinodenwa.org/",
+	"よりそいホットライン|0120-279-338|https://www.since2011.net/yorisoi/",
+	"こころの健康相談統一ダイヤル|0570-064-556|https://www.mhlw.go.jp/mamorouyokokoro/",
+}
+
+// defaultGrumbleCategories uses the "key|label" format accepted by GRUMBLE_CATEGORIES.
+var defaultGrumbleCategories = []string{
+	"work|仕事",
+	"family|家庭",
+	"commute|通勤",
+	"school|学校",
+	"relationships|人間関係",
+	"money|お金",
+	"health|健康",
+	"other|その他",
 }
 
 // LoadConfig loads configuration from environment variables.
 func LoadConfig() (*Config, error) {
 	cfg := &Config{
-		HTTPAddr:                       getEnv("GRUMBLE_HTTP_ADDR", ":8080"),
-		DatabaseURL:                    os.Getenv("DATABASE_URL"),
-		FirebaseProjectID:              os.Getenv("FIREBASE_PROJECT_ID"),
-		FirebaseCredentialsFile:        os.Getenv("FIREBASE_CREDENTIALS_FILE"),
-		CORSAllowedOrigins:             getEnvStringSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:8081", "http://localhost:19006"}),
-		GinMode:                        getEnv("GIN_MODE", gin.ReleaseMode),
-		PurificationThresholdDefault:   getEnvInt("PURIFICATION_THRESHOLD_DEFAULT", 10),
-		PurificationThresholdMin:       getEnvInt("PURIFICATION_THRESHOLD_MIN", 1),
-		PurificationThresholdMax:       getEnvInt("PURIFICATION_THRESHOLD_MAX", 1000),
-		BodhisattvaRankingLimitDefault: getEnvInt("BODHISATTVA_RANKING_LIMIT_DEFAULT", 10),
-		BodhisattvaRankingLimitMin:     getEnvInt("BODHISATTVA_RANKING_LIMIT_MIN", 1),
-		BodhisattvaRankingLimitMax:     getEnvInt("BODHISATTVA_RANKING_LIMIT_MAX", 100),
-		DBMaxConns:                     getEnvInt("DB_MAX_CONNS", 25),
-		DBMinConns:                     getEnvInt("DB_MIN_CONNS", 5),
-		GeminiAPIKey:                   os.Getenv("GEMINI_API_KEY"),
-		GeminiModel:                    getEnv("GEMINI_MODEL", "gemini-2.5-flash-lite"),
+		HTTPAddr:                         getEnv("GRUMBLE_HTTP_ADDR", ":8080"),
+		DatabaseURL:                      os.Getenv("DATABASE_URL"),
+		FirebaseProjectID:                os.Getenv("FIREBASE_PROJECT_ID"),
+		FirebaseCredentialsFile:          os.Getenv("FIREBASE_CREDENTIALS_FILE"),
+		AdminUserIDs:                     getEnvStringSlice("ADMIN_USER_IDS", nil),
+		ModeratorUserIDs:                 getEnvStringSlice("MODERATOR_USER_IDS", nil),
+		CORSAllowedOrigins:               getEnvStringSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:8081", "http://localhost:19006"}),
+		GinMode:                          getEnv("GIN_MODE", gin.ReleaseMode),
+		PurificationThresholdDefault:     getEnvInt("PURIFICATION_THRESHOLD_DEFAULT", 10),
+		PurificationThresholdMin:         getEnvInt("PURIFICATION_THRESHOLD_MIN", 1),
+		PurificationThresholdMax:         getEnvInt("PURIFICATION_THRESHOLD_MAX", 1000),
+		BodhisattvaRankingLimitDefault:   getEnvInt("BODHISATTVA_RANKING_LIMIT_DEFAULT", 10),
+		BodhisattvaRankingLimitMin:       getEnvInt("BODHISATTVA_RANKING_LIMIT_MIN", 1),
+		BodhisattvaRankingLimitMax:       getEnvInt("BODHISATTVA_RANKING_LIMIT_MAX", 100),
+		DBMaxConns:                       getEnvInt("DB_MAX_CONNS", 25),
+		DBMinConns:                       getEnvInt("DB_MIN_CONNS", 5),
+		LLMProvider:                      getEnv("LLM_PROVIDER", LLMProviderGemini),
+		PseudonymSecret:                  os.Getenv("PSEUDONYM_SECRET"),
+		GeminiAPIKey:                     os.Getenv("GEMINI_API_KEY"),
+		GeminiModel:                      getEnv("GEMINI_MODEL", "gemini-2.5-flash-lite"),
+		GeminiBaseURL:                    os.Getenv("GEMINI_BASE_URL"),
+		GeminiTimeoutSeconds:             getEnvInt("GEMINI_TIMEOUT_SECONDS", 30),
+		OpenAIBaseURL:                    getEnv("OPENAI_BASE_URL", "http://localhost:11434/v1"),
+		OpenAIModel:                      os.Getenv("OPENAI_MODEL"),
+		OpenAIAPIKey:                     os.Getenv("OPENAI_API_KEY"),
+		ModerationMode:                   getEnv("MODERATION_MODE", ModerationModeSync),
+		ModerationBatchSize:              getEnvInt("MODERATION_BATCH_SIZE", 20),
+		ModerationPromptDir:              getEnv("MODERATION_PROMPT_DIR", "prompts/moderation"),
+		ModerationPromptVersion:          getEnv("MODERATION_PROMPT_VERSION", "v2"),
+		ModerationPromptCandidateVersion: os.Getenv("MODERATION_PROMPT_CANDIDATE_VERSION"),
+		ModerationPromptCandidatePercent: getEnvInt("MODERATION_PROMPT_CANDIDATE_PERCENT", 0),
+		ModerationCacheSize:              getEnvInt("MODERATION_CACHE_SIZE", 1000),
+		ModerationCacheTTLMinutes:        getEnvInt("MODERATION_CACHE_TTL_MINUTES", 1440),
+		ModerationCachePersist:           getEnvBool("MODERATION_CACHE_PERSIST", false),
+		ModerationRewriteSuggestions:     getEnvBool("MODERATION_REWRITE_SUGGESTIONS", false),
+		GrumbleTopicSuggestions:          getEnvBool("GRUMBLE_TOPIC_SUGGESTIONS", false),
+		ToxicLevelPolicy:                 getEnv("TOXIC_LEVEL_POLICY", "nudge"),
+		ToxicLevelMaxGap:                 getEnvInt("TOXIC_LEVEL_MAX_GAP", 2),
+		SpamDuplicateWindowMinutes:       getEnvInt("SPAM_DUPLICATE_WINDOW_MINUTES", 10),
+		DailyPostQuota:                   getEnvInt("DAILY_POST_QUOTA", 50),
+		GrumbleLifetimes:                 getEnvStringSlice("GRUMBLE_LIFETIMES", []string{"1h", "6h", "midnight", "24h"}),
+		GrumbleLifetimeDefault:           getEnv("GRUMBLE_LIFETIME_DEFAULT", "midnight"),
+		GrumbleMinLifetimeMinutes:        getEnvInt("GRUMBLE_MIN_LIFETIME_MINUTES", 60),
+		GrumbleCategories:                getEnvStringSlice("GRUMBLE_CATEGORIES", defaultGrumbleCategories),
+		GrumbleEditWindowSeconds:         getEnvInt("GRUMBLE_EDIT_WINDOW_SECONDS", 60),
+		StatsTimezone:                    getEnv("STATS_TIMEZONE", "Asia/Tokyo"),
+		StatsWeekStartsOnSunday:          getEnvBool("STATS_WEEK_STARTS_ON_SUNDAY", false),
+		SanctionRejectionThreshold:       getEnvInt("SANCTION_REJECTION_THRESHOLD", 3),
+		SanctionRejectionWindowHours:     getEnvInt("SANCTION_REJECTION_WINDOW_HOURS", 24),
+		SanctionEscalationDays:           getEnvInt("SANCTION_ESCALATION_DAYS", 30),
+		SanctionCooldownMinutes:          getEnvInt("SANCTION_COOLDOWN_MINUTES", 60),
+		SanctionShadowBanHours:           getEnvInt("SANCTION_SHADOW_BAN_HOURS", 168),
+		ReportHideThreshold:              getEnvInt("REPORT_HIDE_THRESHOLD", 3),
+		CrisisSupportMessage:             getEnv("CRISIS_SUPPORT_MESSAGE", defaultCrisisSupportMessage),
+		CrisisHotlines:                   parseCrisisHotlines(getEnvStringSlice("CRISIS_HOTLINES", defaultCrisisHotlines)),
 	}
 
 	if cfg.FirebaseCredentialsFile == "" {
@@ -86,6 +238,56 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("DATABASE_URL is required")
 	}
 
+	if cfg.ModerationMode != ModerationModeSync && cfg.ModerationMode != ModerationModeAsync {
+		return nil, fmt.Errorf("MODERATION_MODE must be %q or %q", ModerationModeSync, ModerationModeAsync)
+	}
+
+	switch cfg.LLMProvider {
+	case LLMProviderGemini:
+	case LLMProviderOpenAI:
+		if cfg.OpenAIModel == "" {
+			return nil, fmt.Errorf("OPENAI_MODEL is required when LLM_PROVIDER is %q", LLMProviderOpenAI)
+		}
+	default:
+		return nil, fmt.Errorf("LLM_PROVIDER must be %q or %q", LLMProviderGemini, LLMProviderOpenAI)
+	}
+
+	if cfg.SpamDuplicateWindowMinutes < 0 || cfg.DailyPostQuota < 0 {
+		return nil, fmt.Errorf("SPAM_DUPLICATE_WINDOW_MINUTES and DAILY_POST_QUOTA must not be negative")
+	}
+
+	if cfg.GrumbleMinLifetimeMinutes < 0 || cfg.GrumbleMinLifetimeMinutes > 24*60 {
+		return nil, fmt.Errorf("GRUMBLE_MIN_LIFETIME_MINUTES must be between 0 and 1440")
+	}
+
+	if cfg.GrumbleEditWindowSeconds < 0 {
+		return nil, fmt.Errorf("GRUMBLE_EDIT_WINDOW_SECONDS must not be negative")
+	}
+
+	if _, err := time.LoadLocation(cfg.StatsTimezone); err != nil {
+		return nil, fmt.Errorf("STATS_TIMEZONE must be an IANA timezone name: %w", err)
+	}
+
+	if cfg.SanctionRejectionThreshold < 0 {
+		return nil, fmt.Errorf("SANCTION_REJECTION_THRESHOLD must not be negative")
+	}
+	if cfg.SanctionRejectionWindowHours < 1 || cfg.SanctionCooldownMinutes < 1 {
+		return nil, fmt.Errorf("SANCTION_REJECTION_WINDOW_HOURS and SANCTION_COOLDOWN_MINUTES must be at least 1")
+	}
+	if cfg.SanctionEscalationDays < 1 || cfg.SanctionShadowBanHours < 1 {
+		return nil, fmt.Errorf("SANCTION_ESCALATION_DAYS and SANCTION_SHADOW_BAN_HOURS must be at least 1")
+	}
+
+	if cfg.ReportHideThreshold < 1 {
+		return nil, fmt.Errorf("REPORT_HIDE_THRESHOLD must be at least 1")
+	}
+
+	switch cfg.ToxicLevelPolicy {
+	case "off", "nudge", "clamp":
+	default:
+		return nil, fmt.Errorf("TOXIC_LEVEL_POLICY must be off, nudge or clamp")
+	}
+
 	return cfg, nil
 }
 
@@ -103,6 +305,13 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if boolVal, err := strconv.ParseBool(getEnv(key, "")); err == nil {
+		return boolVal
+	}
+	return defaultValue
+}
+
 func getEnvStringSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
 		parts := strings.Split(value, ",")
@@ -119,6 +328,26 @@ func getEnvStringSlice(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+// parseCrisisHotlines parses entries in the "name|contact|url" format; url is optional.
+func parseCrisisHotlines(entries []string) []CrisisHotline {
+	var hotlines []CrisisHotline
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "|", 3)
+		if len(parts) < 2 {
+			continue
+		}
+		hotline := CrisisHotline{
+			Name:    strings.TrimSpace(parts[0]),
+			Contact: strings.TrimSpace(parts[1]),
+		}
+		if len(parts) == 3 {
+			hotline.URL = strings.TrimSpace(parts[2])
+		}
+		hotlines = append(hotlines, hotline)
+	}
+	return hotlines
+}
+
 func extractProjectID(credentialsPath string) string {
 	data, err := os.ReadFile(credentialsPath)
 	if err != nil {
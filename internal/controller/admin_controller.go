@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+)
+
+// AdminController handles admin actions on grumbles and users, sanctions and the audit log.
+type AdminController struct {
+	adminUC    *usecase.AdminModerationUseCase
+	sanctionUC *usecase.SanctionUseCase
+	presenter  *AdminPresenter
+	logger     logging.Logger
+}
+
+// NewAdminController creates a new AdminController.
+func NewAdminController(
+	adminUC *usecase.AdminModerationUseCase,
+	sanctionUC *usecase.SanctionUseCase,
+	presenter *AdminPresenter,
+	logger logging.Logger,
+) *AdminController {
+	return &AdminController{
+		adminUC:    adminUC,
+		sanctionUC: sanctionUC,
+		presenter:  presenter,
+		logger:     logger,
+	}
+}
+
+// GrumbleActionInput represents a take-down or restore supplied by the HTTP layer.
+type GrumbleActionInput struct {
+	GrumbleID shared.GrumbleID
+	Reason    string
+	AdminID   shared.UserID
+}
+
+// AdjustVirtueInput represents a virtue point adjustment supplied by the HTTP layer.
+type AdjustVirtueInput struct {
+	UserID  shared.UserID
+	Delta   int
+	Reason  string
+	AdminID shared.UserID
+}
+
+// IssueSanctionInput represents a manual sanction supplied by the HTTP layer.
+type IssueSanctionInput struct {
+	UserID          shared.UserID
+	Level           string
+	Reason          string
+	DurationMinutes int
+	AdminID         shared.UserID
+}
+
+// RevokeSanctionInput represents lifting a sanction supplied by the HTTP layer.
+type RevokeSanctionInput struct {
+	UserID     shared.UserID
+	SanctionID int64
+	Reason     string
+	AdminID    shared.UserID
+}
+
+// GrumbleInspectionResponse represents a grumble with its author's history.
+type GrumbleInspectionResponse struct {
+	Grumble        *AdminGrumbleResponse
+	Author         *MyProfileResponse
+	StatusCounts   map[string]int
+	RecentGrumbles []*AdminGrumbleResponse
+}
+
+// AuditLogQuery holds audit log filters supplied by the HTTP layer.
+type AuditLogQuery struct {
+	ActorID  *shared.UserID
+	Action   *string
+	TargetID *string
+	Limit    int
+	Offset   int
+}
+
+// AuditLogResponse represents a page of the audit log.
+type AuditLogResponse struct {
+	Entries []*AuditEntryResponse
+	Total   int
+}
+
+// TakeDownGrumble removes a grumble from every view.
+func (ctrl *AdminController) TakeDownGrumble(ctx context.Context, input GrumbleActionInput) (*AdminGrumbleResponse, error) {
+	g, err := ctrl.adminUC.TakeDown(ctx, usecase.AdminGrumbleActionRequest(input))
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Grumble taken down", "grumble_id", g.GrumbleID, "admin_id", input.AdminID)
+	return ctrl.presenter.ToAPIGrumble(g)
+}
+
+// RestoreGrumble publishes a hidden or taken down grumble.
+func (ctrl *AdminController) RestoreGrumble(ctx context.Context, input GrumbleActionInput) (*AdminGrumbleResponse, error) {
+	g, err := ctrl.adminUC.Restore(ctx, usecase.AdminGrumbleActionRequest(input))
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Grumble restored", "grumble_id", g.GrumbleID, "admin_id", input.AdminID)
+	return ctrl.presenter.ToAPIGrumble(g)
+}
+
+// InspectGrumble returns a grumble with its author's history.
+func (ctrl *AdminController) InspectGrumble(ctx context.Context, grumbleID shared.GrumbleID) (*GrumbleInspectionResponse, error) {
+	inspection, err := ctrl.adminUC.Inspect(ctx, grumbleID)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := ctrl.presenter.ToAPIGrumble(inspection.Grumble)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert grumble to API response", "error", err)
+		return nil, err
+	}
+	author, err := ctrl.presenter.ToAPIUser(inspection.Author)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert author to API response", "error", err)
+		return nil, err
+	}
+	recent, err := ctrl.presenter.ToAPIGrumbles(inspection.RecentGrumbles)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert author history to API response", "error", err)
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(inspection.StatusCounts))
+	for status, count := range inspection.StatusCounts {
+		counts[string(status)] = count
+	}
+
+	return &GrumbleInspectionResponse{
+		Grumble:        g,
+		Author:         author,
+		StatusCounts:   counts,
+		RecentGrumbles: recent,
+	}, nil
+}
+
+// AdjustVirtuePoints adds or removes a user's virtue points.
+func (ctrl *AdminController) AdjustVirtuePoints(ctx context.Context, input AdjustVirtueInput) (*MyProfileResponse, error) {
+	u, err := ctrl.adminUC.AdjustVirtuePoints(ctx, usecase.AdjustVirtueRequest(input))
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Virtue points adjusted", "user_id", u.UserID, "delta", input.Delta, "admin_id", input.AdminID)
+	return ctrl.presenter.ToAPIUser(u)
+}
+
+// ListSanctions returns every sanction issued to a user, newest first.
+func (ctrl *AdminController) ListSanctions(ctx context.Context, userID shared.UserID) ([]*SanctionResponse, error) {
+	sanctions, err := ctrl.sanctionUC.ListByUser(ctx, userID)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to list sanctions", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return ctrl.presenter.ToAPISanctions(sanctions)
+}
+
+// IssueSanction applies a manual sanction to a user.
+func (ctrl *AdminController) IssueSanction(ctx context.Context, input IssueSanctionInput) (*SanctionResponse, error) {
+	s, err := ctrl.sanctionUC.Issue(ctx, usecase.IssueSanctionRequest{
+		UserID:   input.UserID,
+		Level:    sanction.Level(input.Level),
+		Reason:   input.Reason,
+		Duration: time.Duration(input.DurationMinutes) * time.Minute,
+		AdminID:  input.AdminID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Sanction issued", "user_id", s.UserID, "sanction_id", s.SanctionID, "level", s.Level, "admin_id", input.AdminID)
+	return ctrl.presenter.ToAPISanction(s)
+}
+
+// RevokeSanction lifts a user's sanction.
+func (ctrl *AdminController) RevokeSanction(ctx context.Context, input RevokeSanctionInput) (*SanctionResponse, error) {
+	s, err := ctrl.sanctionUC.Revoke(ctx, usecase.RevokeSanctionRequest{
+		UserID:     input.UserID,
+		SanctionID: sanction.SanctionID(input.SanctionID),
+		Reason:     input.Reason,
+		AdminID:    input.AdminID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Sanction revoked", "user_id", s.UserID, "sanction_id", s.SanctionID, "admin_id", input.AdminID)
+	return ctrl.presenter.ToAPISanction(s)
+}
+
+// ListAuditLog returns audit log entries, newest first.
+func (ctrl *AdminController) ListAuditLog(ctx context.Context, query AuditLogQuery) (*AuditLogResponse, error) {
+	filter := audit.Filter{
+		ActorID:  query.ActorID,
+		TargetID: query.TargetID,
+		Limit:    query.Limit,
+		Offset:   query.Offset,
+	}
+	if query.Action != nil {
+		action := audit.Action(*query.Action)
+		filter.Action = &action
+	}
+
+	resp, err := ctrl.adminUC.ListAuditLog(ctx, filter)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to list audit log", "error", err)
+		return nil, err
+	}
+
+	entries, err := ctrl.presenter.ToAPIAuditEntries(resp.Entries)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert audit log to API response", "error", err)
+		return nil, err
+	}
+
+	return &AuditLogResponse{Entries: entries, Total: resp.Total}, nil
+}
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// AdminGrumbleResponse represents a grumble in admin API responses, including its author
+type AdminGrumbleResponse struct {
+	GrumbleID        uuid.UUID
+	UserID           uuid.UUID
+	Content          string
+	ToxicLevel       int
+	AIToxicLevel     *int
+	ModerationStatus string
+	VibeCount        int
+	IsPurified       bool
+	PostedAt         time.Time
+	ExpiresAt        time.Time
+}
+
+// AuditEntryResponse represents an audit log entry in API responses
+type AuditEntryResponse struct {
+	EntryID    int64
+	ActorID    uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Reason     *string
+	Detail     map[string]any
+	CreatedAt  time.Time
+}
+
+// SanctionResponse represents a user sanction in API responses
+type SanctionResponse struct {
+	SanctionID int64
+	UserID     uuid.UUID
+	Level      string
+	Source     string
+	Reason     string
+	IssuedBy   *uuid.UUID
+	Active     bool
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	RevokedBy  *uuid.UUID
+	RevokedAt  *time.Time
+}
+
+// AdminPresenter converts admin domain objects to API responses
+type AdminPresenter struct{}
+
+// NewAdminPresenter creates a new AdminPresenter
+func NewAdminPresenter() *AdminPresenter {
+	return &AdminPresenter{}
+}
+
+// ToAPIGrumble converts a domain Grumble to an admin API response
+func (p *AdminPresenter) ToAPIGrumble(g *grumble.Grumble) (*AdminGrumbleResponse, error) {
+	grumbleUUID, err := uuid.Parse(string(g.GrumbleID))
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(string(g.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &AdminGrumbleResponse{
+		GrumbleID:        grumbleUUID,
+		UserID:           userUUID,
+		Content:          g.Content,
+		ToxicLevel:       int(g.ToxicLevel),
+		ModerationStatus: string(g.ModerationStatus),
+		VibeCount:        g.VibeCount,
+		IsPurified:       g.IsPurified(),
+		PostedAt:         g.PostedAt,
+		ExpiresAt:        g.ExpiresAt,
+	}
+	if g.AIToxicLevel != nil {
+		level := int(*g.AIToxicLevel)
+		resp.AIToxicLevel = &level
+	}
+	return resp, nil
+}
+
+// ToAPIGrumbles converts domain Grumbles to admin API responses
+func (p *AdminPresenter) ToAPIGrumbles(grumbles []*grumble.Grumble) ([]*AdminGrumbleResponse, error) {
+	result := make([]*AdminGrumbleResponse, len(grumbles))
+	for i, g := range grumbles {
+		resp, err := p.ToAPIGrumble(g)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = resp
+	}
+	return result, nil
+}
+
+// ToAPIUser converts a domain user to the profile response
+func (p *AdminPresenter) ToAPIUser(u *user.AnonymousUser) (*MyProfileResponse, error) {
+	userUUID, err := uuid.Parse(string(u.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MyProfileResponse{
+		UserID:       userUUID,
+		VirtuePoints: u.VirtuePoints,
+		VirtueRank:   string(u.Rank()),
+		CreatedAt:    u.CreatedAt,
+		ProfileTitle: u.ProfileTitle,
+	}, nil
+}
+
+// ToAPIAuditEntries converts audit log entries to API responses
+func (p *AdminPresenter) ToAPIAuditEntries(entries []*audit.Entry) ([]*AuditEntryResponse, error) {
+	result := make([]*AuditEntryResponse, len(entries))
+	for i, e := range entries {
+		actorUUID, err := uuid.Parse(string(e.ActorID))
+		if err != nil {
+			return nil, err
+		}
+
+		detail := e.Detail
+		if detail == nil {
+			detail = map[string]any{}
+		}
+
+		result[i] = &AuditEntryResponse{
+			EntryID:    int64(e.EntryID),
+			ActorID:    actorUUID,
+			Action:     string(e.Action),
+			TargetType: string(e.TargetType),
+			TargetID:   e.TargetID,
+			Reason:     e.Reason,
+			Detail:     detail,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// ToAPISanction converts a sanction to an API response, reporting whether it is active now
+func (p *AdminPresenter) ToAPISanction(s *sanction.Sanction) (*SanctionResponse, error) {
+	userUUID, err := uuid.Parse(string(s.UserID))
+	if err != nil {
+		return nil, err
+	}
+	issuedBy, err := parseOptionalUserID(s.IssuedBy)
+	if err != nil {
+		return nil, err
+	}
+	revokedBy, err := parseOptionalUserID(s.RevokedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SanctionResponse{
+		SanctionID: int64(s.SanctionID),
+		UserID:     userUUID,
+		Level:      string(s.Level),
+		Source:     string(s.Source),
+		Reason:     s.Reason,
+		IssuedBy:   issuedBy,
+		Active:     s.IsActive(time.Now()),
+		CreatedAt:  s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedBy:  revokedBy,
+		RevokedAt:  s.RevokedAt,
+	}, nil
+}
+
+// ToAPISanctions converts sanctions to API responses
+func (p *AdminPresenter) ToAPISanctions(sanctions []*sanction.Sanction) ([]*SanctionResponse, error) {
+	result := make([]*SanctionResponse, len(sanctions))
+	for i, s := range sanctions {
+		resp, err := p.ToAPISanction(s)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = resp
+	}
+	return result, nil
+}
+
+func parseOptionalUserID(id *shared.UserID) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(string(*id))
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
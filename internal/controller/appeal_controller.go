@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+)
+
+// AppealController handles moderation appeals from authors and moderators.
+type AppealController struct {
+	appealUC  *usecase.ModerationAppealUseCase
+	presenter *ModerationPresenter
+	logger    logging.Logger
+}
+
+// NewAppealController creates a new AppealController.
+func NewAppealController(
+	appealUC *usecase.ModerationAppealUseCase,
+	presenter *ModerationPresenter,
+	logger logging.Logger,
+) *AppealController {
+	return &AppealController{
+		appealUC:  appealUC,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// AppealQuery represents appeal queue filters supplied by the HTTP layer.
+type AppealQuery struct {
+	Status *string
+	Limit  int
+	Offset int
+}
+
+// AppealListResponse represents a page of the appeal queue.
+type AppealListResponse struct {
+	Appeals []*ModerationAppealResponse
+	Total   int
+}
+
+// ResolveAppealInput represents a moderator's decision on an appeal.
+type ResolveAppealInput struct {
+	AppealID    int64
+	Decision    string
+	Note        *string
+	ModeratorID shared.UserID
+}
+
+// SubmitAppeal files an appeal for the author's rejected grumble.
+func (ctrl *AppealController) SubmitAppeal(ctx context.Context, userID shared.UserID, grumbleID string) (*ModerationAppealResponse, error) {
+	appeal, err := ctrl.appealUC.Submit(ctx, usecase.SubmitAppealRequest{
+		UserID:    userID,
+		GrumbleID: shared.GrumbleID(grumbleID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Moderation appeal submitted",
+		"appeal_id", appeal.AppealID,
+		"grumble_id", appeal.GrumbleID,
+	)
+
+	return ctrl.presenter.ToAPIAppeal(appeal)
+}
+
+// ListAppeals returns the appeal queue filtered by the query.
+func (ctrl *AppealController) ListAppeals(ctx context.Context, query AppealQuery) (*AppealListResponse, error) {
+	filter := moderation.AppealFilter{
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+	if query.Status != nil {
+		status := moderation.AppealStatus(*query.Status)
+		filter.Status = &status
+	}
+
+	resp, err := ctrl.appealUC.List(ctx, filter)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to list moderation appeals", "error", err)
+		return nil, err
+	}
+
+	appeals, err := ctrl.presenter.ToAPIAppeals(resp.Appeals)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert appeals to API response", "error", err)
+		return nil, err
+	}
+
+	return &AppealListResponse{Appeals: appeals, Total: resp.Total}, nil
+}
+
+// ResolveAppeal approves or denies an appeal and returns the updated appeal.
+func (ctrl *AppealController) ResolveAppeal(ctx context.Context, input ResolveAppealInput) (*ModerationAppealResponse, error) {
+	appeal, err := ctrl.appealUC.Resolve(ctx, usecase.ResolveAppealRequest{
+		AppealID:    moderation.AppealID(input.AppealID),
+		Decision:    moderation.AppealStatus(input.Decision),
+		Note:        input.Note,
+		ModeratorID: input.ModeratorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Moderation appeal resolved",
+		"appeal_id", appeal.AppealID,
+		"decision", appeal.Status,
+		"moderator_id", input.ModeratorID,
+	)
+
+	return ctrl.presenter.ToAPIAppeal(appeal)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
 	"github.com/dokkiitech/grumble-back/internal/logging"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
 	"github.com/google/uuid"
@@ -14,6 +15,7 @@ import (
 type AuthController struct {
 	authAnonymousUC     *usecase.AuthAnonymousUseCase
 	userQueryUC         *usecase.UserQueryUseCase
+	userSettingsUC      *usecase.UserSettingsUseCase
 	logger              logging.Logger
 	rankingLimitDefault int
 	rankingLimitMin     int
@@ -24,6 +26,7 @@ type AuthController struct {
 func NewAuthController(
 	authAnonymousUC *usecase.AuthAnonymousUseCase,
 	userQueryUC *usecase.UserQueryUseCase,
+	userSettingsUC *usecase.UserSettingsUseCase,
 	logger logging.Logger,
 	rankingLimitDefault int,
 	rankingLimitMin int,
@@ -38,6 +41,7 @@ func NewAuthController(
 	return &AuthController{
 		authAnonymousUC:     authAnonymousUC,
 		userQueryUC:         userQueryUC,
+		userSettingsUC:      userSettingsUC,
 		logger:              logger,
 		rankingLimitDefault: rankingLimitDefault,
 		rankingLimitMin:     rankingLimitMin,
@@ -52,6 +56,7 @@ type MyProfileResponse struct {
 	VirtueRank   string
 	CreatedAt    time.Time
 	ProfileTitle *string
+	Timezone     *string
 }
 
 // GetMyProfile fetches the authenticated user's profile.
@@ -61,7 +66,21 @@ func (ctrl *AuthController) GetMyProfile(ctx context.Context, userID shared.User
 		return nil, err
 	}
 
-	userUUID, err := uuid.Parse(string(user.UserID))
+	return ctrl.toMyProfileResponse(ctx, user)
+}
+
+// UpdateMyTimezone changes the authenticated user's timezone preference.
+func (ctrl *AuthController) UpdateMyTimezone(ctx context.Context, userID shared.UserID, timezone string) (*MyProfileResponse, error) {
+	user, err := ctrl.userSettingsUC.UpdateTimezone(ctx, userID, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctrl.toMyProfileResponse(ctx, user)
+}
+
+func (ctrl *AuthController) toMyProfileResponse(ctx context.Context, u *user.AnonymousUser) (*MyProfileResponse, error) {
+	userUUID, err := uuid.Parse(string(u.UserID))
 	if err != nil {
 		ctrl.logger.ErrorContext(ctx, "Failed to parse user UUID", "error", err)
 		return nil, err
@@ -69,10 +88,11 @@ func (ctrl *AuthController) GetMyProfile(ctx context.Context, userID shared.User
 
 	return &MyProfileResponse{
 		UserID:       userUUID,
-		VirtuePoints: user.VirtuePoints,
-		VirtueRank:   string(user.Rank()),
-		CreatedAt:    user.CreatedAt,
-		ProfileTitle: user.ProfileTitle,
+		VirtuePoints: u.VirtuePoints,
+		VirtueRank:   string(u.Rank()),
+		CreatedAt:    u.CreatedAt,
+		ProfileTitle: u.ProfileTitle,
+		Timezone:     u.Timezone,
 	}, nil
 }
 
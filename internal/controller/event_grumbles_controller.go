@@ -30,16 +30,22 @@ func NewEventGrumblesController(
 
 // EventGrumblesQuery represents query parameters
 type EventGrumblesQuery struct {
+	ViewerUserID  *shared.UserID
 	ToxicLevelMin *int
 	ToxicLevelMax *int
 	Limit         int
 	Offset        int
+	Cursor        *string // next_cursor of the previous page
+	IncludeTotal  bool
+	Timezone      string
 }
 
 // EventGrumblesResponse represents the response
 type EventGrumblesResponse struct {
 	Grumbles      []*GrumbleResponse `json:"grumbles"`
-	Total         int                `json:"total"`
+	Total         *int               `json:"total,omitempty"`
+	NextCursor    string             `json:"next_cursor,omitempty"`
+	HasMore       bool               `json:"has_more"`
 	IsEventActive bool               `json:"is_event_active"`
 	EventDate     string             `json:"event_date"` // YYYY-MM-DD形式
 }
@@ -68,6 +74,9 @@ func (ctrl *EventGrumblesController) GetEventGrumbles(
 		ExcludePurified: false, // イベントでは全て表示
 		Limit:           query.Limit,
 		Offset:          query.Offset,
+		Cursor:          query.Cursor,
+		IncludeTotal:    query.IncludeTotal,
+		Timezone:        query.Timezone,
 	}
 
 	result, err := ctrl.eventGrumblesGetUC.Get(ctx, req)
@@ -76,7 +85,7 @@ func (ctrl *EventGrumblesController) GetEventGrumbles(
 		return nil, err
 	}
 
-	apiGrumbles, err := ctrl.grumblePresenter.ToAPIGrumbles(result.Grumbles)
+	apiGrumbles, err := ctrl.grumblePresenter.ToAPIGrumbles(result.Grumbles, query.ViewerUserID)
 	if err != nil {
 		ctrl.logger.ErrorContext(ctx, "Failed to convert grumbles", "error", err)
 		return nil, err
@@ -90,6 +99,8 @@ func (ctrl *EventGrumblesController) GetEventGrumbles(
 	return &EventGrumblesResponse{
 		Grumbles:      apiGrumbles,
 		Total:         result.Total,
+		NextCursor:    result.NextCursor,
+		HasMore:       result.HasMore,
 		IsEventActive: result.IsEventActive,
 		EventDate:     eventDateStr,
 	}, nil
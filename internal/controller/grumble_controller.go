@@ -10,21 +10,27 @@ import (
 
 // GrumbleController handles grumble-related application logic.
 type GrumbleController struct {
-	postGrumbleUC *usecase.GrumblePostUseCase
-	presenter     *GrumblePresenter
-	logger        logging.Logger
+	postGrumbleUC   *usecase.GrumblePostUseCase
+	getGrumbleUC    *usecase.GrumbleGetUseCase
+	deleteGrumbleUC *usecase.GrumbleDeleteUseCase
+	presenter       *GrumblePresenter
+	logger          logging.Logger
 }
 
 // NewGrumbleController creates a new GrumbleController.
 func NewGrumbleController(
 	postGrumbleUC *usecase.GrumblePostUseCase,
+	getGrumbleUC *usecase.GrumbleGetUseCase,
+	deleteGrumbleUC *usecase.GrumbleDeleteUseCase,
 	presenter *GrumblePresenter,
 	logger logging.Logger,
 ) *GrumbleController {
 	return &GrumbleController{
-		postGrumbleUC: postGrumbleUC,
-		presenter:     presenter,
-		logger:        logger,
+		postGrumbleUC:   postGrumbleUC,
+		getGrumbleUC:    getGrumbleUC,
+		deleteGrumbleUC: deleteGrumbleUC,
+		presenter:       presenter,
+		logger:          logger,
 	}
 }
 
@@ -34,6 +40,9 @@ type CreateGrumbleInput struct {
 	Content           string
 	ToxicLevel        shared.ToxicLevel
 	PurifiedThreshold *int
+	Lifetime          *string
+	Category          *string
+	Timezone          string
 	IsEventGrumble    bool
 }
 
@@ -44,6 +53,9 @@ func (ctrl *GrumbleController) CreateGrumble(ctx context.Context, input CreateGr
 		Content:           input.Content,
 		ToxicLevel:        input.ToxicLevel,
 		PurifiedThreshold: input.PurifiedThreshold,
+		Lifetime:          input.Lifetime,
+		Category:          input.Category,
+		Timezone:          input.Timezone,
 		IsEventGrumble:    input.IsEventGrumble,
 	}
 
@@ -52,7 +64,7 @@ func (ctrl *GrumbleController) CreateGrumble(ctx context.Context, input CreateGr
 		return nil, err
 	}
 
-	response, err := ctrl.presenter.ToAPIGrumble(grumble)
+	response, err := ctrl.presenter.ToAPIGrumble(grumble, &input.UserID)
 	if err != nil {
 		ctrl.logger.ErrorContext(ctx, "Failed to convert grumble to API response", "error", err)
 		return nil, err
@@ -60,3 +72,59 @@ func (ctrl *GrumbleController) CreateGrumble(ctx context.Context, input CreateGr
 
 	return response, nil
 }
+
+// GetGrumble retrieves a single grumble as seen by the viewer (nil when anonymous).
+func (ctrl *GrumbleController) GetGrumble(ctx context.Context, grumbleID shared.GrumbleID, viewerID *shared.UserID) (*GrumbleResponse, error) {
+	grumble, err := ctrl.getGrumbleUC.Get(ctx, usecase.GetGrumbleRequest{
+		GrumbleID:    grumbleID,
+		ViewerUserID: viewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ctrl.presenter.ToAPIGrumble(grumble, viewerID)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert grumble to API response", "error", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// EditGrumbleInput is the application-level request for editing a grumble.
+type EditGrumbleInput struct {
+	GrumbleID  shared.GrumbleID
+	UserID     shared.UserID
+	Content    string
+	ToxicLevel shared.ToxicLevel
+}
+
+// EditGrumble executes the edit use case and returns the API-facing response model.
+func (ctrl *GrumbleController) EditGrumble(ctx context.Context, input EditGrumbleInput) (*GrumbleResponse, error) {
+	grumble, err := ctrl.postGrumbleUC.Edit(ctx, usecase.EditGrumbleRequest{
+		GrumbleID:  input.GrumbleID,
+		UserID:     input.UserID,
+		Content:    input.Content,
+		ToxicLevel: input.ToxicLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ctrl.presenter.ToAPIGrumble(grumble, &input.UserID)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert grumble to API response", "error", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// DeleteGrumble removes the author's own grumble from every listing and moves it to the archive.
+func (ctrl *GrumbleController) DeleteGrumble(ctx context.Context, userID shared.UserID, grumbleID shared.GrumbleID) error {
+	return ctrl.deleteGrumbleUC.Delete(ctx, usecase.DeleteGrumbleRequest{
+		GrumbleID: grumbleID,
+		UserID:    userID,
+	})
+}
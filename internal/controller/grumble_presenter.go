@@ -4,65 +4,108 @@ import (
 	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 	"github.com/google/uuid"
 )
 
-// GrumbleResponse represents a grumble in API responses
+// GrumbleResponse represents a grumble in API responses.
+// The author's user ID is never exposed; AuthorHandle is a per-grumble pseudonym.
 type GrumbleResponse struct {
-	GrumbleID         uuid.UUID `json:"grumble_id"`
-	UserID            uuid.UUID `json:"user_id"`
-	Content           string    `json:"content"`
-	ToxicLevel        int       `json:"toxic_level"`
-	VibeCount         int       `json:"vibe_count"`
-	VibeRank          string    `json:"vibe_rank,omitempty"`
-	PurifiedThreshold int       `json:"purified_threshold"`
-	IsPurified        bool      `json:"is_purified"`
-	PostedAt          time.Time `json:"posted_at"`
-	ExpiresAt         time.Time `json:"expires_at"`
-	IsEventGrumble    bool      `json:"is_event_grumble"`
-	HasVibed          *bool     `json:"has_vibed,omitempty"`
+	GrumbleID         uuid.UUID     `json:"grumble_id"`
+	AuthorHandle      string        `json:"author_handle"`
+	IsMine            bool          `json:"is_mine"`
+	Content           string        `json:"content"`
+	ToxicLevel        int           `json:"toxic_level"`
+	AIToxicLevel      *int          `json:"ai_toxic_level,omitempty"`
+	VibeCount         int           `json:"vibe_count"`
+	VibeRank          string        `json:"vibe_rank,omitempty"`
+	PurifiedThreshold int           `json:"purified_threshold"`
+	IsPurified        bool          `json:"is_purified"`
+	PostedAt          time.Time     `json:"posted_at"`
+	ExpiresAt         time.Time     `json:"expires_at"`
+	IsEventGrumble    bool          `json:"is_event_grumble"`
+	HasVibed          *bool         `json:"has_vibed,omitempty"`
+	ModerationStatus  string        `json:"moderation_status"`
+	IsEdited          bool          `json:"is_edited"`
+	EditedAt          *time.Time    `json:"edited_at,omitempty"`
+	Status            string        `json:"status"`
+	Tags              []TagResponse `json:"tags"`
+	Category          *string       `json:"category,omitempty"`
+
+	ToxicLevelMismatch bool `json:"toxic_level_mismatch,omitempty"`
+}
+
+// TagResponse represents a grumble's tag in API responses
+type TagResponse struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
 }
 
 // GrumblePresenter converts domain grumbles to API responses
-type GrumblePresenter struct{}
+type GrumblePresenter struct {
+	handles *grumble.AuthorHandles
+}
 
 // NewGrumblePresenter creates a new GrumblePresenter
-func NewGrumblePresenter() *GrumblePresenter {
-	return &GrumblePresenter{}
+func NewGrumblePresenter(handles *grumble.AuthorHandles) *GrumblePresenter {
+	return &GrumblePresenter{handles: handles}
 }
 
-// ToAPIGrumble converts a domain Grumble to API Grumble response
-func (p *GrumblePresenter) ToAPIGrumble(g *grumble.Grumble) (*GrumbleResponse, error) {
+// ToAPIGrumble converts a domain Grumble to API Grumble response for the given viewer (nil when anonymous)
+func (p *GrumblePresenter) ToAPIGrumble(g *grumble.Grumble, viewerID *shared.UserID) (*GrumbleResponse, error) {
 	grumbleUUID, err := uuid.Parse(string(g.GrumbleID))
 	if err != nil {
 		return nil, err
 	}
-	userUUID, err := uuid.Parse(string(g.UserID))
-	if err != nil {
-		return nil, err
+
+	var aiToxicLevel *int
+	if g.AIToxicLevel != nil {
+		level := int(*g.AIToxicLevel)
+		aiToxicLevel = &level
+	}
+
+	tags := make([]TagResponse, len(g.Tags))
+	for i, t := range g.Tags {
+		tags[i] = TagResponse{Name: t.Name, Source: string(t.Source)}
+	}
+
+	var category *string
+	if g.Category != nil {
+		key := string(*g.Category)
+		category = &key
 	}
 
 	return &GrumbleResponse{
 		GrumbleID:         grumbleUUID,
-		UserID:            userUUID,
+		AuthorHandle:      p.handles.Handle(g),
+		IsMine:            viewerID != nil && *viewerID == g.UserID,
 		Content:           g.Content,
 		ToxicLevel:        int(g.ToxicLevel),
+		AIToxicLevel:      aiToxicLevel,
 		VibeCount:         g.VibeCount,
 		VibeRank:          string(grumble.RankFromVibeCount(g.VibeCount)),
 		PurifiedThreshold: g.PurifiedThreshold,
-		IsPurified:        g.IsPurified,
+		IsPurified:        g.IsPurified(),
 		PostedAt:          g.PostedAt,
 		ExpiresAt:         g.ExpiresAt,
 		IsEventGrumble:    g.IsEventGrumble,
 		HasVibed:          g.HasVibed,
+		ModerationStatus:  visibleStatus(g),
+		IsEdited:          g.IsEdited(),
+		EditedAt:          g.EditedAt,
+		Status:            displayStatus(g),
+		Tags:              tags,
+		Category:          category,
+
+		ToxicLevelMismatch: g.ToxicLevelMismatch,
 	}, nil
 }
 
 // ToAPIGrumbles converts multiple domain Grumbles to API response array
-func (p *GrumblePresenter) ToAPIGrumbles(grumbles []*grumble.Grumble) ([]*GrumbleResponse, error) {
+func (p *GrumblePresenter) ToAPIGrumbles(grumbles []*grumble.Grumble, viewerID *shared.UserID) ([]*GrumbleResponse, error) {
 	result := make([]*GrumbleResponse, len(grumbles))
 	for i, g := range grumbles {
-		apiGrumble, err := p.ToAPIGrumble(g)
+		apiGrumble, err := p.ToAPIGrumble(g, viewerID)
 		if err != nil {
 			return nil, err
 		}
@@ -70,3 +113,20 @@ func (p *GrumblePresenter) ToAPIGrumbles(grumbles []*grumble.Grumble) ([]*Grumbl
 	}
 	return result, nil
 }
+
+// visibleStatus is the audience the author sees: pending while awaiting moderation,
+// and published for shadowed grumbles so the shadow-ban stays hidden from them
+func visibleStatus(g *grumble.Grumble) string {
+	if g.IsPendingModeration() {
+		return string(grumble.StatusPending)
+	}
+	if g.ModerationStatus == grumble.ModerationStatusShadowed {
+		return string(grumble.ModerationStatusPublished)
+	}
+	return string(g.ModerationStatus)
+}
+
+// displayStatus is the grumble's lifecycle status
+func displayStatus(g *grumble.Grumble) string {
+	return string(g.Status)
+}
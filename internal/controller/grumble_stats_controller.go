@@ -26,6 +26,7 @@ type StatsInput struct {
 	From        *time.Time
 	To          *time.Time
 	TZ          string
+	Category    *string
 }
 
 // StatsByToxicInput represents params for toxic-level stats.
@@ -41,6 +42,7 @@ func (c *GrumbleStatsController) GetStats(ctx context.Context, in StatsInput) ([
 		From:        in.From,
 		To:          in.To,
 		TZ:          in.TZ,
+		Category:    in.Category,
 	}
 	return c.uc.Get(ctx, req)
 }
@@ -53,8 +55,21 @@ func (c *GrumbleStatsController) GetStatsByToxic(ctx context.Context, in StatsBy
 			From:        in.From,
 			To:          in.To,
 			TZ:          in.TZ,
+			Category:    in.Category,
 		},
 		ToxicLevel: in.ToxicLevel,
 	}
 	return c.uc.GetByToxic(ctx, req)
 }
+
+// GetStatsByCategory returns aggregated stats grouped by category.
+func (c *GrumbleStatsController) GetStatsByCategory(ctx context.Context, in StatsInput) ([]grumble.StatsRow, error) {
+	req := usecase.StatsRequest{
+		Granularity: in.Granularity,
+		From:        in.From,
+		To:          in.To,
+		TZ:          in.TZ,
+		Category:    in.Category,
+	}
+	return c.uc.GetByCategory(ctx, req)
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware restricts admin routes by role.
+// A user's role is the higher of their Firebase "role" custom claim and the configured allowlists.
+type AdminMiddleware struct {
+	roles  map[shared.UserID]user.Role
+	logger logging.Logger
+}
+
+// NewAdminMiddleware creates an AdminMiddleware from the configured admin and moderator user IDs.
+func NewAdminMiddleware(adminUserIDs, moderatorUserIDs []string, logger logging.Logger) *AdminMiddleware {
+	roles := make(map[shared.UserID]user.Role, len(adminUserIDs)+len(moderatorUserIDs))
+	for _, id := range moderatorUserIDs {
+		roles[shared.UserID(strings.ToLower(id))] = user.RoleModerator
+	}
+	for _, id := range adminUserIDs {
+		roles[shared.UserID(strings.ToLower(id))] = user.RoleAdmin
+	}
+	return &AdminMiddleware{
+		roles:  roles,
+		logger: logger,
+	}
+}
+
+// RequireRole rejects requests under pathPrefix unless the authenticated user has at least the required role.
+// Must run after AuthMiddleware.Authenticate so that user_id and role are present in the context.
+func (m *AdminMiddleware) RequireRole(pathPrefix string, required user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, pathPrefix) {
+			c.Next()
+			return
+		}
+
+		value, exists := c.Get("user_id")
+		userID, ok := value.(shared.UserID)
+		if !exists || !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "UNAUTHORIZED", "message": "User not authenticated"})
+			c.Abort()
+			return
+		}
+
+		role := m.roleOf(c, userID)
+		if !role.Satisfies(required) {
+			m.logger.WarnContext(c.Request.Context(), "User without required role attempted admin access",
+				"user_id", userID,
+				"role", role,
+				"required_role", required,
+				"path", c.Request.URL.Path,
+			)
+			c.JSON(http.StatusForbidden, gin.H{"error": "FORBIDDEN", "message": "Admin access required"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// roleOf returns the higher of the claimed role and the allowlisted role
+func (m *AdminMiddleware) roleOf(c *gin.Context, userID shared.UserID) user.Role {
+	claimed, _ := c.Get("role")
+	role, _ := claimed.(user.Role)
+	if listed, ok := m.roles[userID]; ok && listed.Satisfies(role) {
+		return listed
+	}
+	return role
+}
@@ -9,12 +9,16 @@ import (
 	firebaseauth "firebase.google.com/go/v4/auth"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
 	"github.com/dokkiitech/grumble-back/internal/logging"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
+// roleClaim is the Firebase custom claim that carries a user's admin role.
+const roleClaim = "role"
+
 // FirebaseTokenVerifier defines the subset of the Firebase auth client we rely on.
 type FirebaseTokenVerifier interface {
 	VerifyIDToken(ctx context.Context, idToken string) (*firebaseauth.Token, error)
@@ -24,6 +28,7 @@ type FirebaseTokenVerifier interface {
 type AuthMiddleware struct {
 	authClient      FirebaseTokenVerifier
 	authAnonymousUC *usecase.AuthAnonymousUseCase
+	sanctionUC      *usecase.SanctionUseCase
 	logger          logging.Logger
 }
 
@@ -31,11 +36,13 @@ type AuthMiddleware struct {
 func NewAuthMiddleware(
 	authClient FirebaseTokenVerifier,
 	authAnonymousUC *usecase.AuthAnonymousUseCase,
+	sanctionUC *usecase.SanctionUseCase,
 	logger logging.Logger,
 ) *AuthMiddleware {
 	return &AuthMiddleware{
 		authClient:      authClient,
 		authAnonymousUC: authAnonymousUC,
+		sanctionUC:      sanctionUC,
 		logger:          logger,
 	}
 }
@@ -77,8 +84,23 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		standing, err := m.sanctionUC.Standing(c.Request.Context(), userID)
+		if err != nil {
+			m.logger.ErrorContext(c.Request.Context(), "Failed to load sanctions", "user_id", userID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "AUTH_FAILED", "message": "Authentication failed"})
+			c.Abort()
+			return
+		}
+		if standing.Banned {
+			m.logger.WarnContext(c.Request.Context(), "Banned user attempted access", "user_id", userID, "path", c.Request.URL.Path)
+			c.JSON(http.StatusForbidden, gin.H{"error": "BANNED", "message": "This account has been banned"})
+			c.Abort()
+			return
+		}
+
 		c.Set("user_id", userID)
 		c.Set("firebase_uid", decodedToken.UID)
+		c.Set("role", roleFromClaims(decodedToken.Claims))
 		c.Set("user", resp.User)
 		c.Set("is_new_user", resp.IsNewUser)
 
@@ -124,6 +146,7 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 
 		c.Set("user_id", userID)
 		c.Set("firebase_uid", decodedToken.UID)
+		c.Set("role", roleFromClaims(decodedToken.Claims))
 		c.Set("user", resp.User)
 		c.Set("is_new_user", resp.IsNewUser)
 
@@ -131,6 +154,12 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 	}
 }
 
+// roleFromClaims reads the admin role from the "role" custom claim, if any.
+func roleFromClaims(claims map[string]interface{}) user.Role {
+	value, _ := claims[roleClaim].(string)
+	return user.ParseRole(value)
+}
+
 func extractBearerToken(header string) (string, bool) {
 	if header == "" {
 		return "", false
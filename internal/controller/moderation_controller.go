@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+)
+
+// ModerationCacheStatsProvider exposes moderation cache metrics.
+type ModerationCacheStatsProvider interface {
+	Stats() grumble.ModerationCacheStats
+}
+
+// ModerationController handles moderator-facing moderation logic.
+type ModerationController struct {
+	reviewUC   *usecase.ModerationReviewUseCase
+	presenter  *ModerationPresenter
+	cacheStats ModerationCacheStatsProvider // nil when the moderation cache is disabled
+	logger     logging.Logger
+}
+
+// NewModerationController creates a new ModerationController.
+func NewModerationController(
+	reviewUC *usecase.ModerationReviewUseCase,
+	presenter *ModerationPresenter,
+	cacheStats ModerationCacheStatsProvider,
+	logger logging.Logger,
+) *ModerationController {
+	return &ModerationController{
+		reviewUC:   reviewUC,
+		presenter:  presenter,
+		cacheStats: cacheStats,
+		logger:     logger,
+	}
+}
+
+// VerdictQuery represents verdict log filters supplied by the HTTP layer.
+type VerdictQuery struct {
+	Category      *string
+	Decision      *string
+	ReviewLabel   *string
+	PromptVersion *string
+	Unreviewed    bool
+	From          *time.Time
+	To            *time.Time
+	Limit         int
+	Offset        int
+}
+
+// VerdictListResponse represents a page of the verdict log.
+type VerdictListResponse struct {
+	Verdicts []*ModerationVerdictResponse
+	Total    int
+}
+
+// ReviewVerdictInput represents a moderator's label for a verdict.
+type ReviewVerdictInput struct {
+	VerdictID  int64
+	Label      string
+	Note       *string
+	ReviewerID shared.UserID
+}
+
+// ListVerdicts returns the verdict log filtered by the query.
+func (ctrl *ModerationController) ListVerdicts(ctx context.Context, query VerdictQuery) (*VerdictListResponse, error) {
+	filter := moderation.VerdictFilter{
+		Unreviewed:    query.Unreviewed,
+		PromptVersion: query.PromptVersion,
+		From:          query.From,
+		To:            query.To,
+		Limit:         query.Limit,
+		Offset:        query.Offset,
+	}
+	if query.Category != nil {
+		category := grumble.ModerationCategory(*query.Category)
+		filter.Category = &category
+	}
+	if query.Decision != nil {
+		decision := moderation.Decision(*query.Decision)
+		filter.Decision = &decision
+	}
+	if query.ReviewLabel != nil {
+		label := moderation.ReviewLabel(*query.ReviewLabel)
+		filter.ReviewLabel = &label
+	}
+
+	resp, err := ctrl.reviewUC.List(ctx, filter)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to list moderation verdicts", "error", err)
+		return nil, err
+	}
+
+	verdicts, err := ctrl.presenter.ToAPIVerdicts(resp.Verdicts)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert verdicts to API response", "error", err)
+		return nil, err
+	}
+
+	return &VerdictListResponse{Verdicts: verdicts, Total: resp.Total}, nil
+}
+
+// ReviewVerdict labels a verdict and returns the updated verdict.
+func (ctrl *ModerationController) ReviewVerdict(ctx context.Context, input ReviewVerdictInput) (*ModerationVerdictResponse, error) {
+	verdict, err := ctrl.reviewUC.Review(ctx, usecase.ReviewVerdictRequest{
+		VerdictID:  moderation.VerdictID(input.VerdictID),
+		Label:      moderation.ReviewLabel(input.Label),
+		Note:       input.Note,
+		ReviewerID: input.ReviewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Moderation verdict reviewed",
+		"verdict_id", verdict.VerdictID,
+		"label", input.Label,
+		"reviewer_id", input.ReviewerID,
+	)
+
+	return ctrl.presenter.ToAPIVerdict(verdict)
+}
+
+// ModerationCacheStatsResponse represents moderation cache metrics.
+type ModerationCacheStatsResponse struct {
+	Enabled bool
+	Hits    int64
+	Misses  int64
+	HitRate float64
+	Entries int
+}
+
+// GetCacheStats returns moderation cache metrics for this process.
+func (ctrl *ModerationController) GetCacheStats() *ModerationCacheStatsResponse {
+	if ctrl.cacheStats == nil {
+		return &ModerationCacheStatsResponse{}
+	}
+
+	stats := ctrl.cacheStats.Stats()
+	return &ModerationCacheStatsResponse{
+		Enabled: true,
+		Hits:    stats.Hits,
+		Misses:  stats.Misses,
+		HitRate: stats.HitRate(),
+		Entries: stats.Entries,
+	}
+}
@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/google/uuid"
+)
+
+// ModerationVerdictResponse represents a moderation verdict in API responses
+type ModerationVerdictResponse struct {
+	VerdictID     int64
+	ContentHash   string
+	UserID        uuid.UUID
+	GrumbleID     *uuid.UUID
+	Decision      string
+	Categories    []string
+	Reason        string
+	Model         string
+	PromptVersion string
+	LatencyMs     int
+	CreatedAt     time.Time
+	ReviewLabel   *string
+	ReviewNote    *string
+	ReviewedBy    *uuid.UUID
+	ReviewedAt    *time.Time
+}
+
+// ModerationPresenter converts moderation domain objects to API responses
+type ModerationPresenter struct{}
+
+// NewModerationPresenter creates a new ModerationPresenter
+func NewModerationPresenter() *ModerationPresenter {
+	return &ModerationPresenter{}
+}
+
+// ToAPIVerdict converts a domain Verdict to an API response
+func (p *ModerationPresenter) ToAPIVerdict(v *moderation.Verdict) (*ModerationVerdictResponse, error) {
+	userUUID, err := uuid.Parse(string(v.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ModerationVerdictResponse{
+		VerdictID:     int64(v.VerdictID),
+		ContentHash:   v.ContentHash,
+		UserID:        userUUID,
+		Decision:      string(v.Decision),
+		Categories:    make([]string, len(v.Categories)),
+		Reason:        v.Reason,
+		Model:         v.Model,
+		PromptVersion: v.PromptVersion,
+		LatencyMs:     int(v.Latency.Milliseconds()),
+		CreatedAt:     v.CreatedAt,
+		ReviewNote:    v.ReviewNote,
+		ReviewedAt:    v.ReviewedAt,
+	}
+	for i, c := range v.Categories {
+		resp.Categories[i] = string(c)
+	}
+
+	if v.GrumbleID != nil {
+		grumbleUUID, err := uuid.Parse(string(*v.GrumbleID))
+		if err != nil {
+			return nil, err
+		}
+		resp.GrumbleID = &grumbleUUID
+	}
+	if v.ReviewLabel != nil {
+		label := string(*v.ReviewLabel)
+		resp.ReviewLabel = &label
+	}
+	if v.ReviewedBy != nil {
+		reviewerUUID, err := uuid.Parse(string(*v.ReviewedBy))
+		if err != nil {
+			return nil, err
+		}
+		resp.ReviewedBy = &reviewerUUID
+	}
+
+	return resp, nil
+}
+
+// ToAPIVerdicts converts multiple domain Verdicts to API responses
+func (p *ModerationPresenter) ToAPIVerdicts(verdicts []*moderation.Verdict) ([]*ModerationVerdictResponse, error) {
+	result := make([]*ModerationVerdictResponse, len(verdicts))
+	for i, v := range verdicts {
+		apiVerdict, err := p.ToAPIVerdict(v)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = apiVerdict
+	}
+	return result, nil
+}
+
+// ModerationAppealResponse represents a moderation appeal in API responses
+type ModerationAppealResponse struct {
+	AppealID        int64
+	GrumbleID       uuid.UUID
+	UserID          uuid.UUID
+	VerdictID       *int64
+	Status          string
+	Content         string
+	RejectionReason string
+	CreatedAt       time.Time
+	ResolvedBy      *uuid.UUID
+	ResolvedAt      *time.Time
+	ResolutionNote  *string
+}
+
+// ToAPIAppeal converts a domain Appeal to an API response
+func (p *ModerationPresenter) ToAPIAppeal(a *moderation.Appeal) (*ModerationAppealResponse, error) {
+	grumbleUUID, err := uuid.Parse(string(a.GrumbleID))
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(string(a.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ModerationAppealResponse{
+		AppealID:        int64(a.AppealID),
+		GrumbleID:       grumbleUUID,
+		UserID:          userUUID,
+		Status:          string(a.Status),
+		Content:         a.Content,
+		RejectionReason: a.RejectionReason,
+		CreatedAt:       a.CreatedAt,
+		ResolvedAt:      a.ResolvedAt,
+		ResolutionNote:  a.ResolutionNote,
+	}
+	if a.VerdictID != nil {
+		verdictID := int64(*a.VerdictID)
+		resp.VerdictID = &verdictID
+	}
+	if a.ResolvedBy != nil {
+		resolverUUID, err := uuid.Parse(string(*a.ResolvedBy))
+		if err != nil {
+			return nil, err
+		}
+		resp.ResolvedBy = &resolverUUID
+	}
+
+	return resp, nil
+}
+
+// ToAPIAppeals converts multiple domain Appeals to API responses
+func (p *ModerationPresenter) ToAPIAppeals(appeals []*moderation.Appeal) ([]*ModerationAppealResponse, error) {
+	result := make([]*ModerationAppealResponse, len(appeals))
+	for i, a := range appeals {
+		apiAppeal, err := p.ToAPIAppeal(a)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = apiAppeal
+	}
+	return result, nil
+}
+
+// ReportResponse represents a user report in API responses
+type ReportResponse struct {
+	ReportID  int64
+	GrumbleID uuid.UUID
+	Reason    string
+	Comment   *string
+	CreatedAt time.Time
+}
+
+// ReportReasonCountResponse represents the number of reports with one reason
+type ReportReasonCountResponse struct {
+	Reason string
+	Count  int
+}
+
+// ReportedGrumbleResponse represents a report queue entry in API responses
+type ReportedGrumbleResponse struct {
+	GrumbleID        uuid.UUID
+	Content          string
+	ModerationStatus string
+	Archived         bool
+	ReportCount      int
+	Reasons          []ReportReasonCountResponse
+	FirstReportedAt  time.Time
+	LastReportedAt   time.Time
+}
+
+// ToAPIReport converts a domain Report to an API response
+func (p *ModerationPresenter) ToAPIReport(r *moderation.Report) (*ReportResponse, error) {
+	grumbleUUID, err := uuid.Parse(string(r.GrumbleID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportResponse{
+		ReportID:  int64(r.ReportID),
+		GrumbleID: grumbleUUID,
+		Reason:    string(r.Reason),
+		Comment:   r.Comment,
+		CreatedAt: r.CreatedAt,
+	}, nil
+}
+
+// ToAPIReportedGrumbles converts report queue entries to API responses
+func (p *ModerationPresenter) ToAPIReportedGrumbles(items []*moderation.ReportedGrumble) ([]*ReportedGrumbleResponse, error) {
+	result := make([]*ReportedGrumbleResponse, len(items))
+	for i, item := range items {
+		grumbleUUID, err := uuid.Parse(string(item.GrumbleID))
+		if err != nil {
+			return nil, err
+		}
+
+		reasons := make([]ReportReasonCountResponse, 0, len(item.ReasonCounts))
+		for reason, count := range item.ReasonCounts {
+			reasons = append(reasons, ReportReasonCountResponse{Reason: string(reason), Count: count})
+		}
+		sort.Slice(reasons, func(a, b int) bool {
+			if reasons[a].Count != reasons[b].Count {
+				return reasons[a].Count > reasons[b].Count
+			}
+			return reasons[a].Reason < reasons[b].Reason
+		})
+
+		result[i] = &ReportedGrumbleResponse{
+			GrumbleID:        grumbleUUID,
+			Content:          item.Content,
+			ModerationStatus: string(item.ModerationStatus),
+			Archived:         item.Archived,
+			ReportCount:      item.ReportCount,
+			Reasons:          reasons,
+			FirstReportedAt:  item.FirstReportedAt,
+			LastReportedAt:   item.LastReportedAt,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// NotificationController handles notification-related use cases.
+type NotificationController struct {
+	notificationListUC *usecase.NotificationListUseCase
+	logger             logging.Logger
+}
+
+// NewNotificationController creates a new NotificationController.
+func NewNotificationController(
+	notificationListUC *usecase.NotificationListUseCase,
+	logger logging.Logger,
+) *NotificationController {
+	return &NotificationController{
+		notificationListUC: notificationListUC,
+		logger:             logger,
+	}
+}
+
+// NotificationResponse represents a notification in API responses.
+type NotificationResponse struct {
+	NotificationID int64
+	Kind           string
+	GrumbleID      *uuid.UUID
+	Message        string
+	CreatedAt      time.Time
+}
+
+// GetMyNotifications fetches the authenticated user's notifications.
+func (ctrl *NotificationController) GetMyNotifications(ctx context.Context, userID shared.UserID, limit int) ([]*NotificationResponse, error) {
+	notifications, err := ctrl.notificationListUC.List(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		resp := &NotificationResponse{
+			NotificationID: int64(n.NotificationID),
+			Kind:           string(n.Kind),
+			Message:        n.Message,
+			CreatedAt:      n.CreatedAt,
+		}
+		if n.GrumbleID != nil {
+			grumbleUUID, err := uuid.Parse(string(*n.GrumbleID))
+			if err != nil {
+				ctrl.logger.ErrorContext(ctx, "Failed to parse grumble UUID", "error", err)
+				return nil, err
+			}
+			resp.GrumbleID = &grumbleUUID
+		}
+		responses[i] = resp
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+)
+
+// ReportController handles user reports and the moderator report queue.
+type ReportController struct {
+	reportUC  *usecase.GrumbleReportUseCase
+	presenter *ModerationPresenter
+	logger    logging.Logger
+}
+
+// NewReportController creates a new ReportController.
+func NewReportController(
+	reportUC *usecase.GrumbleReportUseCase,
+	presenter *ModerationPresenter,
+	logger logging.Logger,
+) *ReportController {
+	return &ReportController{
+		reportUC:  reportUC,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// ReportGrumbleInput represents a user's report supplied by the HTTP layer.
+type ReportGrumbleInput struct {
+	GrumbleID  shared.GrumbleID
+	ReporterID shared.UserID
+	Reason     string
+	Comment    *string
+}
+
+// ReportQueueResponse represents a page of the report queue.
+type ReportQueueResponse struct {
+	Items []*ReportedGrumbleResponse
+	Total int
+}
+
+// ResolveReportsInput represents a moderator's action on a reported grumble.
+type ResolveReportsInput struct {
+	GrumbleID   shared.GrumbleID
+	Action      string // "restore" or "remove"
+	Note        *string
+	ModeratorID shared.UserID
+}
+
+// ResolveReportsResponse represents the outcome of a moderator's action.
+type ResolveReportsResponse struct {
+	GrumbleID        shared.GrumbleID
+	ModerationStatus string
+	ResolvedReports  int
+}
+
+// reportActions maps API actions to report resolutions.
+var reportActions = map[string]moderation.ReportResolution{
+	"restore": moderation.ReportResolutionRestored,
+	"remove":  moderation.ReportResolutionRemoved,
+}
+
+// ReportGrumble files a report against a published grumble.
+func (ctrl *ReportController) ReportGrumble(ctx context.Context, input ReportGrumbleInput) (*ReportResponse, error) {
+	report, err := ctrl.reportUC.Report(ctx, usecase.ReportGrumbleRequest{
+		GrumbleID:  input.GrumbleID,
+		ReporterID: input.ReporterID,
+		Reason:     moderation.ReportReason(input.Reason),
+		Comment:    input.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ctrl.presenter.ToAPIReport(report)
+}
+
+// ListQueue returns grumbles with unresolved reports.
+func (ctrl *ReportController) ListQueue(ctx context.Context, limit, offset int) (*ReportQueueResponse, error) {
+	resp, err := ctrl.reportUC.ListQueue(ctx, limit, offset)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to list report queue", "error", err)
+		return nil, err
+	}
+
+	items, err := ctrl.presenter.ToAPIReportedGrumbles(resp.Items)
+	if err != nil {
+		ctrl.logger.ErrorContext(ctx, "Failed to convert report queue to API response", "error", err)
+		return nil, err
+	}
+
+	return &ReportQueueResponse{Items: items, Total: resp.Total}, nil
+}
+
+// ResolveReports restores or removes a reported grumble.
+func (ctrl *ReportController) ResolveReports(ctx context.Context, input ResolveReportsInput) (*ResolveReportsResponse, error) {
+	resp, err := ctrl.reportUC.Resolve(ctx, usecase.ResolveReportsRequest{
+		GrumbleID:   input.GrumbleID,
+		Resolution:  reportActions[input.Action],
+		Note:        input.Note,
+		ModeratorID: input.ModeratorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.InfoContext(ctx, "Grumble reports resolved",
+		"grumble_id", resp.GrumbleID,
+		"action", input.Action,
+		"resolved_reports", resp.ResolvedReports,
+		"moderator_id", input.ModeratorID,
+	)
+
+	return &ResolveReportsResponse{
+		GrumbleID:        resp.GrumbleID,
+		ModerationStatus: string(resp.ModerationStatus),
+		ResolvedReports:  resp.ResolvedReports,
+	}, nil
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 	"github.com/dokkiitech/grumble-back/internal/logging"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
@@ -37,8 +38,14 @@ type TimelineQuery struct {
 	ToxicLevelMin *shared.ToxicLevel
 	ToxicLevelMax *shared.ToxicLevel
 	IsPurified    *bool
+	Tag           *string // Normalized tag name
+	Category      *string // Category key
 	Limit         int
 	Offset        int
+	Cursor        *string // next_cursor of the previous page
+	IncludeTotal  bool
+
+	ToxicLevelSource grumble.ToxicLevelSource
 }
 
 // GetGrumbles retrieves the timeline and returns the API-facing response model.
@@ -59,14 +66,19 @@ func (ctrl *TimelineController) GetGrumbles(ctx context.Context, query TimelineQ
 	}
 
 	req := usecase.TimelineRequest{
-		ToxicLevelMin: query.ToxicLevelMin,
-		ToxicLevelMax: query.ToxicLevelMax,
-		IsPurified:    query.IsPurified,
-		UserID:        query.UserID,
-		ViewerUserID:  query.ViewerUserID,
-		Page:          page,
-		PageSize:      pageSize,
-		Offset:        offset,
+		ToxicLevelMin:    query.ToxicLevelMin,
+		ToxicLevelMax:    query.ToxicLevelMax,
+		IsPurified:       query.IsPurified,
+		Tag:              query.Tag,
+		Category:         query.Category,
+		UserID:           query.UserID,
+		ToxicLevelSource: query.ToxicLevelSource,
+		ViewerUserID:     query.ViewerUserID,
+		Page:             page,
+		PageSize:         pageSize,
+		Offset:           offset,
+		Cursor:           query.Cursor,
+		IncludeTotal:     query.IncludeTotal,
 	}
 
 	resp, err := ctrl.timelineGetUC.Get(ctx, req)
@@ -75,7 +87,7 @@ func (ctrl *TimelineController) GetGrumbles(ctx context.Context, query TimelineQ
 		return nil, err
 	}
 
-	apiResp, err := ctrl.presenter.ToAPITimelineResponse(resp)
+	apiResp, err := ctrl.presenter.ToAPITimelineResponse(resp, query.ViewerUserID)
 	if err != nil {
 		ctrl.logger.ErrorContext(ctx, "Failed to convert timeline to API response", "error", err)
 		return nil, err
@@ -1,13 +1,16 @@
 package controller
 
 import (
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 	"github.com/dokkiitech/grumble-back/internal/usecase"
 )
 
 // TimelineResponse represents a timeline response
 type TimelineResponse struct {
-	Grumbles []*GrumbleResponse `json:"grumbles"`
-	Total    int                `json:"total"`
+	Grumbles   []*GrumbleResponse `json:"grumbles"`
+	Total      *int               `json:"total,omitempty"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+	HasMore    bool               `json:"has_more"`
 }
 
 // TimelinePresenter converts timeline results to API responses
@@ -23,14 +26,16 @@ func NewTimelinePresenter(grumblePresenter *GrumblePresenter) *TimelinePresenter
 }
 
 // ToAPITimelineResponse converts usecase timeline response to API format
-func (p *TimelinePresenter) ToAPITimelineResponse(resp *usecase.TimelineResponse) (*TimelineResponse, error) {
-	grumbles, err := p.grumblePresenter.ToAPIGrumbles(resp.Grumbles)
+func (p *TimelinePresenter) ToAPITimelineResponse(resp *usecase.TimelineResponse, viewerID *shared.UserID) (*TimelineResponse, error) {
+	grumbles, err := p.grumblePresenter.ToAPIGrumbles(resp.Grumbles, viewerID)
 	if err != nil {
 		return nil, err
 	}
 
 	return &TimelineResponse{
-		Grumbles: grumbles,
-		Total:    resp.TotalCount,
+		Grumbles:   grumbles,
+		Total:      resp.TotalCount,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
 	}, nil
 }
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// EntryID identifies a persisted audit log entry
+type EntryID int64
+
+// Action is the kind of admin action that was performed
+type Action string
+
+const (
+	ActionGrumbleTakedown Action = "grumble_takedown"
+	ActionGrumbleRestore  Action = "grumble_restore"
+	ActionVirtueAdjust    Action = "virtue_adjust"
+	ActionVerdictReview   Action = "verdict_review"
+	ActionAppealResolve   Action = "appeal_resolve"
+	ActionReportsResolve  Action = "reports_resolve"
+	ActionSanctionIssue   Action = "sanction_issue"
+	ActionSanctionRevoke  Action = "sanction_revoke"
+)
+
+// Validate checks the action is one of the known values
+func (a Action) Validate() error {
+	switch a {
+	case ActionGrumbleTakedown, ActionGrumbleRestore, ActionVirtueAdjust,
+		ActionVerdictReview, ActionAppealResolve, ActionReportsResolve,
+		ActionSanctionIssue, ActionSanctionRevoke:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "action", Message: "unknown audit action"}
+	}
+}
+
+// TargetType is the kind of resource an admin action was applied to
+type TargetType string
+
+const (
+	TargetGrumble  TargetType = "grumble"
+	TargetUser     TargetType = "user"
+	TargetVerdict  TargetType = "verdict"
+	TargetAppeal   TargetType = "appeal"
+	TargetSanction TargetType = "sanction"
+)
+
+// Entry is an immutable record of an admin action.
+// Detail holds action-specific values such as the previous status or the point delta.
+type Entry struct {
+	EntryID    EntryID
+	ActorID    shared.UserID
+	Action     Action
+	TargetType TargetType
+	TargetID   string
+	Reason     *string
+	Detail     map[string]any
+	CreatedAt  time.Time
+}
+
+// Filter represents filtering options for audit log queries
+type Filter struct {
+	ActorID  *shared.UserID
+	Action   *Action
+	TargetID *string
+	Limit    int
+	Offset   int
+}
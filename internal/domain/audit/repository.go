@@ -0,0 +1,15 @@
+package audit
+
+import "context"
+
+// Repository defines the interface for the append-only audit log
+type Repository interface {
+	// Append stores a new entry; entries are never updated or deleted
+	Append(ctx context.Context, entry *Entry) error
+
+	// List returns entries matching the filter, newest first
+	List(ctx context.Context, filter Filter) ([]*Entry, error)
+
+	// Count returns the number of entries matching the filter
+	Count(ctx context.Context, filter Filter) (int, error)
+}
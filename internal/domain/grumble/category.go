@@ -0,0 +1,75 @@
+package grumble
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// Category is the life area a grumble is about, such as work or commute
+type Category string
+
+// CategoryEntry is one category of the catalogue with its display label
+type CategoryEntry struct {
+	Key   Category
+	Label string
+}
+
+// categoryKeyPattern keeps keys usable in URLs and stable across label changes
+var categoryKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+
+// CategoryCatalogue lists the categories authors may choose from, in display order
+type CategoryCatalogue struct {
+	Entries []CategoryEntry
+}
+
+// NewCategoryCatalogue parses configured entries in the "key|label" format.
+// Keys are lower-case ASCII and must be unique; the label defaults to the key.
+func NewCategoryCatalogue(entries []string) (CategoryCatalogue, error) {
+	var catalogue CategoryCatalogue
+	seen := make(map[Category]bool)
+	for _, entry := range entries {
+		key, label, _ := strings.Cut(entry, "|")
+		key, label = strings.TrimSpace(key), strings.TrimSpace(label)
+		if !categoryKeyPattern.MatchString(key) {
+			return catalogue, fmt.Errorf("category key %q must be lower-case letters, digits or underscores", key)
+		}
+		if seen[Category(key)] {
+			return catalogue, fmt.Errorf("category %q is listed twice", key)
+		}
+		seen[Category(key)] = true
+		if label == "" {
+			label = key
+		}
+		catalogue.Entries = append(catalogue.Entries, CategoryEntry{Key: Category(key), Label: label})
+	}
+	return catalogue, nil
+}
+
+// Resolve returns the requested category, or nil when none is requested
+func (c CategoryCatalogue) Resolve(requested *string) (*Category, error) {
+	if requested == nil || strings.TrimSpace(*requested) == "" {
+		return nil, nil
+	}
+
+	key := Category(strings.TrimSpace(*requested))
+	for _, entry := range c.Entries {
+		if entry.Key == key {
+			return &key, nil
+		}
+	}
+	return nil, &shared.ValidationError{
+		Field:   "category",
+		Message: fmt.Sprintf("category must be one of %s", strings.Join(c.keys(), ", ")),
+	}
+}
+
+func (c CategoryCatalogue) keys() []string {
+	keys := make([]string, len(c.Entries))
+	for i, entry := range c.Entries {
+		keys[i] = string(entry.Key)
+	}
+	return keys
+}
@@ -0,0 +1,78 @@
+package grumble
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestNewCategoryCatalogue(t *testing.T) {
+	tests := []struct {
+		name      string
+		entries   []string
+		wantLabel string
+		wantErr   bool
+	}{
+		{"キーと表示名", []string{"work|仕事", "commute|通勤"}, "仕事", false},
+		{"表示名の省略はキー", []string{"work"}, "work", false},
+		{"前後の空白は無視", []string{" work | 仕事 "}, "仕事", false},
+		{"大文字のキーは不可", []string{"Work|仕事"}, "", true},
+		{"空のキーは不可", []string{"|仕事"}, "", true},
+		{"重複したキーは不可", []string{"work|仕事", "work|職場"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCategoryCatalogue(tt.entries)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewCategoryCatalogue(%q) error = %v, wantErr %v", tt.entries, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Entries) != len(tt.entries) || got.Entries[0].Label != tt.wantLabel {
+				t.Errorf("NewCategoryCatalogue(%q) = %+v, want first label %q", tt.entries, got.Entries, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestCategoryCatalogue_Resolve(t *testing.T) {
+	catalogue, err := NewCategoryCatalogue([]string{"work|仕事", "school|学校"})
+	if err != nil {
+		t.Fatalf("NewCategoryCatalogue() error = %v", err)
+	}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		requested *string
+		want      *Category
+		wantErr   bool
+	}{
+		{"未指定ならカテゴリなし", nil, nil, false},
+		{"空文字ならカテゴリなし", str(" "), nil, false},
+		{"一覧にあるカテゴリ", str("school"), (*Category)(str("school")), false},
+		{"一覧にないカテゴリ", str("hobby"), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := catalogue.Resolve(tt.requested)
+			if tt.wantErr {
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Resolve() error = %v, want ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package grumble
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxContentLength is the content limit in user-perceived characters (grapheme clusters)
+const MaxContentLength = 280
+
+// maxBlankLines keeps at most one blank line between paragraphs
+const maxBlankLines = 1
+
+// NormalizeContent canonicalizes grumble text before it is validated, moderated and stored:
+// NFKC normalization, CRLF to LF, removal of invisible format and control characters,
+// trailing spaces on each line, runs of blank lines and surrounding whitespace.
+func NormalizeContent(content string) string {
+	content = norm.NFKC.String(content)
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	content = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n':
+			return r
+		case r == '\t':
+			return ' '
+		case isInvisibleRune(r):
+			return -1
+		}
+		return r
+	}, content)
+
+	lines := strings.Split(content, "\n")
+	kept := lines[:0]
+	blank := 0
+	for _, line := range lines {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" {
+			blank++
+			if blank > maxBlankLines {
+				continue
+			}
+		} else {
+			blank = 0
+		}
+		kept = append(kept, line)
+	}
+
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// ContentLength counts content in grapheme clusters, so an emoji sequence or a letter with combining marks is one character
+func ContentLength(content string) int {
+	return uniseg.GraphemeClusterCount(content)
+}
+
+// isInvisibleRune reports control characters and invisible format characters such as zero-width spaces,
+// BOMs and direction marks. The zero-width joiner and emoji tag characters are kept because emoji sequences depend on them.
+func isInvisibleRune(r rune) bool {
+	if r == '\u200d' || (r >= '\U000E0020' && r <= '\U000E007F') {
+		return false
+	}
+	return unicode.Is(unicode.Cc, r) || unicode.Is(unicode.Cf, r)
+}
@@ -1,35 +1,108 @@
 package grumble
 
-import "context"
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
 
-// ContentModerationPrompt is the prompt template for Gemini API to moderate content
-const ContentModerationPrompt = `以下の投稿内容を審査し、JSON形式で判定結果を出力して。
-**jsonのみ出力してください**
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
 
-# 不適切と判定する基準
-1. 誹謗中傷・攻撃的な表現
-2. 差別的な内容（人種、性別、宗教、国籍、障害等）
-3. 個人情報（氏名、住所、電話番号、メールアドレス等）
-4. 違法行為の助長、自傷行為の勧誘
+// ModerationPromptPlaceholder marks where the post content is inserted into a prompt template
+const ModerationPromptPlaceholder = "{{content}}"
 
-# 出力形式
-{
-  "is_appropriate": true/false,
-  "reason": "理由"
+// ModerationPrompt is a versioned moderation prompt template.
+// Templates live outside the binary so wording can change without a deploy.
+type ModerationPrompt struct {
+	Version  string
+	Template string
 }
 
-# 投稿内容
-%s`
+// Validate checks the template has exactly one content placeholder
+func (p ModerationPrompt) Validate() error {
+	if p.Version == "" {
+		return &shared.ValidationError{Field: "version", Message: "prompt version cannot be empty"}
+	}
+	if strings.Count(p.Template, ModerationPromptPlaceholder) != 1 {
+		return &shared.ValidationError{
+			Field:   "template",
+			Message: fmt.Sprintf("prompt %s must contain %s exactly once", p.Version, ModerationPromptPlaceholder),
+		}
+	}
+	return nil
+}
+
+// Render inserts content into the template
+func (p ModerationPrompt) Render(content string) string {
+	return strings.Replace(p.Template, ModerationPromptPlaceholder, content, 1)
+}
+
+// ModerationRequest is the input to a content filter
+type ModerationRequest struct {
+	Content string
+	Prompt  ModerationPrompt
+}
+
+// ModerationCategory classifies why content was flagged by moderation
+type ModerationCategory string
+
+const (
+	ModerationCategoryHarassment     ModerationCategory = "harassment"
+	ModerationCategoryDiscrimination ModerationCategory = "discrimination"
+	ModerationCategoryPersonalInfo   ModerationCategory = "personal_info"
+	ModerationCategoryIllegal        ModerationCategory = "illegal"
+	ModerationCategorySelfHarm       ModerationCategory = "self_harm"
+)
 
 // ModerationResult represents the result of content moderation
 type ModerationResult struct {
-	IsAppropriate bool   `json:"is_appropriate"`
-	Reason        string `json:"reason"`
+	IsAppropriate       bool                 `json:"is_appropriate"`
+	Categories          []ModerationCategory `json:"categories"`
+	EstimatedToxicLevel int                  `json:"estimated_toxic_level"`
+	Reason              string               `json:"reason"`
+
+	// Provenance, filled in by the client rather than parsed from the model output
+	Model         string `json:"-"`
+	PromptVersion string `json:"-"`
+}
+
+// HasCategory reports whether the result was flagged with the given category
+func (r *ModerationResult) HasCategory(category ModerationCategory) bool {
+	for _, c := range r.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// EstimatedLevel returns the model's toxic level estimate, or nil when it is missing or out of range
+func (r *ModerationResult) EstimatedLevel() *shared.ToxicLevel {
+	level := shared.ToxicLevel(r.EstimatedToxicLevel)
+	if level.Validate() != nil {
+		return nil
+	}
+	return &level
+}
+
+// IsSelfHarm reports whether the content was flagged as self-harm.
+// Self-harm takes precedence over IsAppropriate so the author is never met with a plain rejection.
+func (r *ModerationResult) IsSelfHarm() bool {
+	return r.HasCategory(ModerationCategorySelfHarm)
 }
 
 // ContentFilterClient is an interface for content moderation
 type ContentFilterClient interface {
-	// FilterContent checks if the content is appropriate
+	// FilterContent checks if the content is appropriate using the request's prompt
 	// Returns ModerationResult with the filtering decision
-	FilterContent(ctx context.Context, content string) (*ModerationResult, error)
+	FilterContent(ctx context.Context, req ModerationRequest) (*ModerationResult, error)
+}
+
+// HashContent returns a stable hex digest of the content.
+// Used wherever moderation needs to reference content without storing it verbatim.
+func HashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
 }
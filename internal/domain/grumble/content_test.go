@@ -0,0 +1,70 @@
+package grumble
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// family is a single grapheme made of three emoji joined by zero-width joiners
+const family = "\U0001F468\u200d\U0001F469\u200d\U0001F467"
+
+func TestNormalizeContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"前後の空白を除去", "  月曜日つらい \n", "月曜日つらい"},
+		{"全角英数字と半角カナをNFKCで統一", "ＡＢＣ１２３ ｶﾞﾝﾊﾞﾙ", "ABC123 ガンバル"},
+		{"ゼロ幅スペースとBOMを除去", "つ\u200bら\ufeffい", "つらい"},
+		{"制御文字を除去しタブは空白に", "残業\x07\tつらい", "残業 つらい"},
+		{"CRLFをLFに", "一行目\r\n二行目", "一行目\n二行目"},
+		{"連続する空行は1行に", "一行目\n\n\n\n二行目", "一行目\n\n二行目"},
+		{"空白だけの行も空行として扱う", "一行目\n \n　\n二行目", "一行目\n\n二行目"},
+		{"絵文字の結合子は残す", "家族" + family, "家族" + family},
+		{"不可視文字だけなら空", "\u200b\u200e\n\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeContent(tt.content); got != tt.want {
+				t.Errorf("NormalizeContent(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumbleValidate_ContentLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"日本語280文字は投稿できる", strings.Repeat("愚", 280), false},
+		{"日本語281文字は長すぎる", strings.Repeat("愚", 281), true},
+		{"絵文字の結合列は1文字と数える", strings.Repeat(family, 280), false},
+		{"結合文字付きの仮名は1文字と数える", strings.Repeat("\u304b\u3099", 280), false},
+		{"空は不可", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postedAt := time.Now()
+			g := &Grumble{
+				Content:           tt.content,
+				ToxicLevel:        shared.ToxicLevel2,
+				PurifiedThreshold: 10,
+				PostedAt:          postedAt,
+				ExpiresAt:         postedAt.Add(24 * time.Hour),
+			}
+
+			err := g.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
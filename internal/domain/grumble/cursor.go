@@ -0,0 +1,50 @@
+package grumble
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// TimelineCursor is a position in a timeline ordered by posted_at and then grumble_id, newest first.
+// Paging from a cursor rather than an offset keeps grumbles posted in the meantime from shifting later pages.
+type TimelineCursor struct {
+	PostedAt  time.Time
+	GrumbleID shared.GrumbleID
+}
+
+// CursorAfter returns the cursor for the page that follows g
+func CursorAfter(g *Grumble) TimelineCursor {
+	return TimelineCursor{PostedAt: g.PostedAt, GrumbleID: g.GrumbleID}
+}
+
+// Encode returns the cursor as an opaque URL-safe token
+func (c TimelineCursor) Encode() string {
+	raw := c.PostedAt.UTC().Format(time.RFC3339Nano) + "|" + string(c.GrumbleID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTimelineCursor parses a token made by Encode. Tampered or truncated tokens are a ValidationError.
+func DecodeTimelineCursor(token string) (*TimelineCursor, error) {
+	invalid := &shared.ValidationError{Field: "cursor", Message: "cursor is invalid"}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	postedAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, invalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, postedAt)
+	if err != nil {
+		return nil, invalid
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, invalid
+	}
+	return &TimelineCursor{PostedAt: t, GrumbleID: shared.GrumbleID(id)}, nil
+}
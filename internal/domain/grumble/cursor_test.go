@@ -0,0 +1,49 @@
+package grumble
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestTimelineCursor_RoundTrip(t *testing.T) {
+	g := &Grumble{
+		GrumbleID: "00000000-0000-0000-0000-0000000000a1",
+		PostedAt:  time.Date(2026, 3, 2, 21, 15, 30, 123456000, time.FixedZone("JST", 9*60*60)),
+	}
+
+	got, err := DecodeTimelineCursor(CursorAfter(g).Encode())
+	if err != nil {
+		t.Fatalf("DecodeTimelineCursor() error = %v", err)
+	}
+	if !got.PostedAt.Equal(g.PostedAt) || got.GrumbleID != g.GrumbleID {
+		t.Errorf("DecodeTimelineCursor() = %+v, want posted_at %s and grumble_id %s", got, g.PostedAt, g.GrumbleID)
+	}
+}
+
+func TestDecodeTimelineCursor_Invalid(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"base64ではない", "not a cursor!"},
+		{"区切りがない", encode("2026-03-02T12:00:00Z")},
+		{"時刻が不正", encode("yesterday|00000000-0000-0000-0000-0000000000a1")},
+		{"投稿IDが不正", encode("2026-03-02T12:00:00Z|1 OR 1=1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeTimelineCursor(tt.token)
+			var validationErr *shared.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("DecodeTimelineCursor(%q) error = %v, want ValidationError", tt.token, err)
+			}
+		})
+	}
+}
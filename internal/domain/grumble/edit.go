@@ -0,0 +1,65 @@
+package grumble
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// Revision is a version of a grumble that was replaced by an edit
+type Revision struct {
+	RevisionID int64
+	GrumbleID  shared.GrumbleID
+	Content    string
+	ToxicLevel shared.ToxicLevel
+	EditedAt   time.Time // When the edit replaced this version
+}
+
+// IsEdited reports whether the author changed the grumble after posting it
+func (g *Grumble) IsEdited() bool {
+	return g.EditedAt != nil
+}
+
+// CheckEditable refuses edits once the window after posting has passed or the grumble has received a vibe.
+// Grumbles held, rejected or taken off the timeline by moderation, purified or deleted cannot be edited either.
+func (g *Grumble) CheckEditable(window time.Duration, now time.Time) error {
+	switch g.ModerationStatus {
+	case ModerationStatusPublished, ModerationStatusShadowed:
+	default:
+		return &shared.ConflictError{Message: "grumble cannot be edited in its moderation status"}
+	}
+	if g.Status != StatusActive && g.Status != StatusPending {
+		return &shared.ConflictError{Message: "grumble cannot be edited once it has left the timeline"}
+	}
+	if now.After(g.PostedAt.Add(window)) {
+		return &shared.ConflictError{Message: "edit window has closed"}
+	}
+	if g.VibeCount > 0 {
+		return &shared.ConflictError{Message: "grumbles with vibes cannot be edited"}
+	}
+	return nil
+}
+
+// Edit replaces content and toxic level and returns the revision holding the previous version.
+// The grumble goes back to published so moderation can decide on the new content; the previous AI estimate no longer applies.
+// Hashtags follow the new content.
+func (g *Grumble) Edit(content string, toxicLevel shared.ToxicLevel, now time.Time) (*Revision, error) {
+	revision := &Revision{
+		GrumbleID:  g.GrumbleID,
+		Content:    g.Content,
+		ToxicLevel: g.ToxicLevel,
+		EditedAt:   now,
+	}
+
+	g.Content = content
+	g.ToxicLevel = toxicLevel
+	g.AIToxicLevel = nil
+	g.ToxicLevelMismatch = false
+	g.EditedAt = &now
+	g.RefreshHashtags()
+	if err := g.Publish(); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,83 @@
+package grumble
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestGrumble_CheckEditable(t *testing.T) {
+	postedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	const window = 60 * time.Second
+
+	tests := []struct {
+		name      string
+		status    ModerationStatus
+		lifecycle Status
+		vibeCount int
+		elapsed   time.Duration
+		wantErr   bool
+	}{
+		{"投稿直後は編集できる", ModerationStatusPublished, StatusActive, 0, 10 * time.Second, false},
+		{"ちょうど編集期限までは編集できる", ModerationStatusPublished, StatusActive, 0, window, false},
+		{"編集期限を過ぎたら不可", ModerationStatusPublished, StatusActive, 0, window + time.Second, true},
+		{"わかるが付いたら不可", ModerationStatusPublished, StatusActive, 1, 10 * time.Second, true},
+		{"モデレーション待ちは編集できる", ModerationStatusPublished, StatusPending, 0, 10 * time.Second, false},
+		{"シャドウBAN中も本人には公開扱いなので編集できる", ModerationStatusShadowed, StatusActive, 0, 10 * time.Second, false},
+		{"保留中は不可", ModerationStatusHeld, StatusActive, 0, 10 * time.Second, true},
+		{"拒否済みは不可", ModerationStatusRejected, StatusRemoved, 0, 10 * time.Second, true},
+		{"モデレーターが削除したら不可", ModerationStatusRemoved, StatusRemoved, 0, 10 * time.Second, true},
+		{"成仏済みは不可", ModerationStatusPublished, StatusPurified, 0, 10 * time.Second, true},
+		{"投稿者が削除したら不可", ModerationStatusPublished, StatusDeleted, 0, 10 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grumble{ModerationStatus: tt.status, Status: tt.lifecycle, VibeCount: tt.vibeCount, PostedAt: postedAt}
+
+			err := g.CheckEditable(window, postedAt.Add(tt.elapsed))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckEditable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var conflictErr *shared.ConflictError
+			if tt.wantErr && !errors.As(err, &conflictErr) {
+				t.Errorf("CheckEditable() error = %v, want ConflictError", err)
+			}
+		})
+	}
+}
+
+func TestGrumble_Edit(t *testing.T) {
+	postedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	estimate := shared.ToxicLevel4
+	g := &Grumble{
+		GrumbleID:        "00000000-0000-0000-0000-0000000000aa",
+		Content:          "月曜日つらいい",
+		ToxicLevel:       shared.ToxicLevel2,
+		AIToxicLevel:     &estimate,
+		ModerationStatus: ModerationStatusPublished,
+		Status:           StatusPending,
+		PostedAt:         postedAt,
+	}
+	editedAt := postedAt.Add(30 * time.Second)
+
+	revision, err := g.Edit("月曜日つらい", shared.ToxicLevel3, editedAt)
+	if err != nil {
+		t.Fatalf("Edit() error = %v", err)
+	}
+
+	if revision.Content != "月曜日つらいい" || revision.ToxicLevel != shared.ToxicLevel2 || !revision.EditedAt.Equal(editedAt) {
+		t.Errorf("revision = %+v, want the previous version", revision)
+	}
+	if g.Content != "月曜日つらい" || g.ToxicLevel != shared.ToxicLevel3 {
+		t.Errorf("grumble = %q (level %d), want edited content", g.Content, g.ToxicLevel)
+	}
+	if !g.IsEdited() || !g.EditedAt.Equal(editedAt) {
+		t.Errorf("EditedAt = %v, want %v", g.EditedAt, editedAt)
+	}
+	if g.AIToxicLevel != nil || !g.IsPublished() || g.Status != StatusActive {
+		t.Errorf("AIToxicLevel = %v, status = %s, want estimate cleared for re-moderation", g.AIToxicLevel, g.ModerationStatus)
+	}
+}
@@ -1,11 +1,25 @@
 package grumble
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 )
 
+// ModerationStatus represents whether a grumble is visible to everyone or only to its author.
+// Where the grumble is in its lifecycle, including whether it is still awaiting moderation, is decided by its Status.
+type ModerationStatus string
+
+const (
+	ModerationStatusPublished ModerationStatus = "published" // Visible on the public timeline
+	ModerationStatusHeld      ModerationStatus = "held"      // Held privately; visible only to the author
+	ModerationStatusRejected  ModerationStatus = "rejected"  // Rejected by asynchronous moderation; visible to no one
+	ModerationStatusHidden    ModerationStatus = "hidden"    // Auto-hidden after enough user reports, awaiting moderator review; visible to no one
+	ModerationStatusRemoved   ModerationStatus = "removed"   // Removed by a moderator after review; visible to no one
+	ModerationStatusShadowed  ModerationStatus = "shadowed"  // Posted while the author is shadow-banned; visible only to the author, who sees it as published
+)
+
 // Grumble represents a user's complaint post (愚痴投稿)
 type Grumble struct {
 	GrumbleID         shared.GrumbleID
@@ -14,26 +28,38 @@ type Grumble struct {
 	ToxicLevel        shared.ToxicLevel
 	VibeCount         int
 	PurifiedThreshold int
-	IsPurified        bool
+	Status            Status
 	PostedAt          time.Time
 	ExpiresAt         time.Time
+	Lifetime          Lifetime // Lifetime the author chose when posting; an approved appeal starts it again
 	IsEventGrumble    bool
+	ModerationStatus  ModerationStatus
+	AIToxicLevel      *shared.ToxicLevel // Estimated by moderation; nil when no estimate is available
+	EditedAt          *time.Time         // Last edit by the author; nil when never edited
+	Timezone          string             // IANA zone of the author at posting time; midnight expiry and the event day follow it
+	Tags              []Tag              // Hashtags parsed from the content, or the topic suggested by AI when there are none
+	Category          *Category          // Life area chosen by the author; nil when none was chosen
+	RemovedFrom       *Status            // Status before it was hidden by reports or taken down; Restore returns it there
 	HasVibed          *bool
+
+	// ToxicLevelMismatch is set on post when the nudge policy flags the self-reported level; not persisted
+	ToxicLevelMismatch bool
 }
 
 // Validate checks if the grumble meets business rules
 func (g *Grumble) Validate() error {
-	// Content length: 1-280 characters
-	if len(g.Content) == 0 {
+	// Content length: 1-280 characters, counted as grapheme clusters of the normalized content
+	length := ContentLength(g.Content)
+	if length == 0 {
 		return &shared.ValidationError{
 			Field:   "content",
 			Message: "content cannot be empty",
 		}
 	}
-	if len(g.Content) > 280 {
+	if length > MaxContentLength {
 		return &shared.ValidationError{
 			Field:   "content",
-			Message: "content must be 280 characters or less",
+			Message: fmt.Sprintf("content must be %d characters or less", MaxContentLength),
 		}
 	}
 
@@ -68,12 +94,164 @@ func (g *Grumble) CalculateTimeRemaining() time.Duration {
 	return remaining
 }
 
-// CanBePurified checks if the grumble has enough vibes for purification
+// CanBePurified checks if the grumble is active and has enough vibes for purification
 func (g *Grumble) CanBePurified(threshold int) bool {
-	return !g.IsPurified && g.VibeCount >= threshold
+	return g.Status == StatusActive && g.VibeCount >= threshold
 }
 
 // Purify marks the grumble as purified (成仏)
-func (g *Grumble) Purify() {
-	g.IsPurified = true
+func (g *Grumble) Purify() error {
+	return g.transition(StatusPurified)
+}
+
+// IsPublished reports whether the grumble is visible to users other than its author
+func (g *Grumble) IsPublished() bool {
+	return g.ModerationStatus == ModerationStatusPublished && g.isListed()
+}
+
+// isListed reports whether the grumble's lifecycle lets anyone see it.
+// Pending grumbles have not passed moderation yet; removed and deleted ones are listed nowhere.
+func (g *Grumble) isListed() bool {
+	return g.Status != StatusPending && g.Status != StatusRemoved && g.Status != StatusDeleted
+}
+
+// IsVisibleTo reports whether the viewer (nil when anonymous) may see the grumble.
+// Pending, held and shadowed grumbles are visible only to their author; removed and deleted ones to no one.
+func (g *Grumble) IsVisibleTo(viewerID *shared.UserID) bool {
+	if g.IsPublished() {
+		return true
+	}
+	if g.Status == StatusRemoved || g.Status == StatusDeleted {
+		return false
+	}
+	return viewerID != nil && *viewerID == g.UserID
+}
+
+// Hold keeps the grumble private to its author instead of publishing it
+func (g *Grumble) Hold() error {
+	if err := g.transition(StatusActive); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusHeld
+	return nil
+}
+
+// IsHeld reports whether the grumble is held privately for its author
+func (g *Grumble) IsHeld() bool {
+	return g.ModerationStatus == ModerationStatusHeld
+}
+
+// IsPendingModeration reports whether the grumble is still waiting for the moderation worker
+func (g *Grumble) IsPendingModeration() bool {
+	return g.Status == StatusPending
+}
+
+// AwaitModeration stores the grumble privately until the moderation worker decides on it.
+// It keeps the published audience it will have once it passes moderation.
+func (g *Grumble) AwaitModeration() error {
+	if err := g.transition(StatusPending); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusPublished
+	return nil
+}
+
+// Publish makes the grumble visible on the public timeline
+func (g *Grumble) Publish() error {
+	if err := g.transition(StatusActive); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusPublished
+	return nil
+}
+
+// Reject hides the grumble from everyone, including its author
+func (g *Grumble) Reject() error {
+	if err := g.transition(StatusRemoved); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusRejected
+	return nil
+}
+
+// Shadow keeps a grumble that would be published visible only to its shadow-banned author.
+// Only grumbles on the timeline are shadowed; pending ones are shadowed once they pass moderation.
+func (g *Grumble) Shadow() error {
+	if !g.IsPublished() {
+		return nil
+	}
+	if err := g.transition(StatusActive); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusShadowed
+	return nil
+}
+
+// HideForReview takes a published grumble off the timeline until a moderator reviews its reports
+func (g *Grumble) HideForReview() error {
+	if !g.IsPublished() {
+		return nil
+	}
+	if err := g.remove(); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusHidden
+	return nil
+}
+
+// IsRejected reports whether moderation rejected the grumble; it stays private unless an appeal is approved
+func (g *Grumble) IsRejected() bool {
+	return g.ModerationStatus == ModerationStatusRejected
+}
+
+// IsRemoved reports whether a moderator took the grumble down
+func (g *Grumble) IsRemoved() bool {
+	return g.ModerationStatus == ModerationStatusRemoved
+}
+
+// TakeDown removes the grumble from every view on a moderator's decision
+func (g *Grumble) TakeDown() error {
+	if g.IsRemoved() {
+		return &shared.ConflictError{Message: "grumble is already taken down"}
+	}
+	if err := g.remove(); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusRemoved
+	return nil
+}
+
+// remove moves the grumble to removed, remembering the status Restore should bring it back to.
+// A grumble hidden by reports keeps the status it had before it was hidden when a moderator then takes it down.
+func (g *Grumble) remove() error {
+	from := g.Status
+	if err := g.transition(StatusRemoved); err != nil {
+		return err
+	}
+	if from != StatusRemoved {
+		g.RemovedFrom = &from
+	}
+	return nil
+}
+
+// Restore publishes a grumble that was hidden by reports or taken down by a moderator.
+// A purified grumble stays purified, so it cannot be purified and rewarded again.
+func (g *Grumble) Restore() error {
+	if g.ModerationStatus != ModerationStatusHidden && !g.IsRemoved() {
+		return &shared.ConflictError{Message: "only hidden or taken down grumbles can be restored"}
+	}
+	// A grumble whose lifetime passed while it was taken down comes back as expired
+	to := StatusActive
+	switch {
+	case g.RemovedFrom != nil && *g.RemovedFrom == StatusPurified:
+		to = StatusPurified
+	case g.IsExpired():
+		to = StatusExpired
+	}
+	if err := g.transition(to); err != nil {
+		return err
+	}
+	g.ModerationStatus = ModerationStatusPublished
+	g.RemovedFrom = nil
+	return nil
 }
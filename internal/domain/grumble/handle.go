@@ -0,0 +1,36 @@
+package grumble
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+)
+
+// handleEncoding renders handles in lowercase base32 without padding
+var handleEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// handleLength is the number of characters kept from the HMAC
+const handleLength = 8
+
+// AuthorHandles derives the pseudonym shown in place of a grumble's author.
+// Handles are keyed by a server-side secret and differ for every grumble,
+// so grumbles cannot be linked to each other or to the author's user ID.
+type AuthorHandles struct {
+	secret []byte
+}
+
+// NewAuthorHandles creates an AuthorHandles keyed by secret
+func NewAuthorHandles(secret []byte) *AuthorHandles {
+	return &AuthorHandles{secret: secret}
+}
+
+// Handle returns the grumble's author pseudonym
+func (h *AuthorHandles) Handle(g *Grumble) string {
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write([]byte(g.UserID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(g.GrumbleID))
+	encoded := handleEncoding.EncodeToString(mac.Sum(nil))
+	return strings.ToLower(encoded[:handleLength])
+}
@@ -0,0 +1,44 @@
+package grumble
+
+import (
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestAuthorHandles_Handle(t *testing.T) {
+	handles := NewAuthorHandles([]byte("secret"))
+	grumbleOf := func(userID, grumbleID string) *Grumble {
+		return &Grumble{UserID: shared.UserID(userID), GrumbleID: shared.GrumbleID(grumbleID)}
+	}
+	const (
+		alice = "00000000-0000-0000-0000-000000000001"
+		bob   = "00000000-0000-0000-0000-000000000002"
+		first = "10000000-0000-0000-0000-000000000001"
+		other = "10000000-0000-0000-0000-000000000002"
+	)
+
+	base := handles.Handle(grumbleOf(alice, first))
+	if len(base) != handleLength {
+		t.Fatalf("Handle() = %q, want %d characters", base, handleLength)
+	}
+
+	tests := []struct {
+		name     string
+		handle   string
+		wantSame bool
+	}{
+		{"同じ投稿なら同じハンドル", handles.Handle(grumbleOf(alice, first)), true},
+		{"同じ投稿者でも投稿ごとに異なる", handles.Handle(grumbleOf(alice, other)), false},
+		{"投稿者が違えば異なる", handles.Handle(grumbleOf(bob, first)), false},
+		{"秘密鍵が違えば異なる", NewAuthorHandles([]byte("rotated")).Handle(grumbleOf(alice, first)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.handle == base; got != tt.wantSame {
+				t.Errorf("Handle() = %q, base %q, want same = %v", tt.handle, base, tt.wantSame)
+			}
+		})
+	}
+}
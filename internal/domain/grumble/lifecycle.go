@@ -0,0 +1,83 @@
+package grumble
+
+import (
+	"fmt"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// Status is where a grumble is in its lifecycle.
+// Who may see a grumble is decided separately by its ModerationStatus.
+type Status string
+
+const (
+	StatusPending  Status = "pending"  // Awaiting asynchronous moderation
+	StatusActive   Status = "active"   // On the timeline until it expires
+	StatusPurified Status = "purified" // Reached its purification threshold; stays purified once archived
+	StatusExpired  Status = "expired"  // Moved to the archive by the archive job after its lifetime
+	StatusRemoved  Status = "removed"  // Rejected or taken down by moderation, or expired before moderation decided on it; an appeal or restore brings it back
+	StatusDeleted  Status = "deleted"  // Deleted by its author; listed nowhere
+)
+
+// transitions lists the statuses each status may move to.
+// Moderation can take down and restore grumbles that are already archived or purified, so removed leads back to expired and purified as well.
+var transitions = map[Status][]Status{
+	StatusPending:  {StatusActive, StatusRemoved, StatusDeleted},
+	StatusActive:   {StatusPending, StatusPurified, StatusRemoved, StatusExpired, StatusDeleted},
+	StatusPurified: {StatusRemoved, StatusDeleted},
+	StatusRemoved:  {StatusActive, StatusPurified, StatusExpired, StatusDeleted},
+	StatusExpired:  {StatusRemoved, StatusDeleted},
+	StatusDeleted:  nil,
+}
+
+// CanTransitionTo reports whether a grumble in this status may move to the given status
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchivedStatus is the status a grumble in this status is archived with when its lifetime ends.
+// Active grumbles become expired. A pending grumble never passed moderation, so it becomes removed and is listed nowhere;
+// purified and removed grumbles keep their status.
+func (s Status) ArchivedStatus() Status {
+	switch s {
+	case StatusActive:
+		return StatusExpired
+	case StatusPending:
+		return StatusRemoved
+	default:
+		return s
+	}
+}
+
+// transition moves the grumble to a new status, refusing moves the lifecycle does not allow.
+// Staying in the same status is always allowed.
+func (g *Grumble) transition(to Status) error {
+	if g.Status == to {
+		return nil
+	}
+	if !g.Status.CanTransitionTo(to) {
+		return &shared.ConflictError{Message: fmt.Sprintf("grumble cannot move from %s to %s", g.Status, to)}
+	}
+	g.Status = to
+	return nil
+}
+
+// IsPurified reports whether the grumble has been purified (成仏)
+func (g *Grumble) IsPurified() bool {
+	return g.Status == StatusPurified
+}
+
+// IsDeleted reports whether the author deleted the grumble
+func (g *Grumble) IsDeleted() bool {
+	return g.Status == StatusDeleted
+}
+
+// Delete records that the author deleted the grumble
+func (g *Grumble) Delete() error {
+	return g.transition(StatusDeleted)
+}
@@ -0,0 +1,184 @@
+package grumble
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from Status
+		to   Status
+		want bool
+	}{
+		{"モデレーション待ちから公開", StatusPending, StatusActive, true},
+		{"モデレーション待ちから却下", StatusPending, StatusRemoved, true},
+		{"モデレーション待ちは成仏できない", StatusPending, StatusPurified, false},
+		{"公開中から成仏", StatusActive, StatusPurified, true},
+		{"公開中から期限切れ", StatusActive, StatusExpired, true},
+		{"成仏済みは公開に戻らない", StatusPurified, StatusActive, false},
+		{"成仏済みもモデレーターは削除できる", StatusPurified, StatusRemoved, true},
+		{"期限切れは公開に戻らない", StatusExpired, StatusActive, false},
+		{"削除された投稿は復元で公開に戻る", StatusRemoved, StatusActive, true},
+		{"成仏済みだった投稿は復元で成仏済みに戻る", StatusRemoved, StatusPurified, true},
+		{"モデレーション待ちは期限切れにならない", StatusPending, StatusExpired, false},
+		{"投稿者が削除したら戻せない", StatusDeleted, StatusActive, false},
+		{"投稿者が削除したらモデレーションも不可", StatusDeleted, StatusRemoved, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumble_LifecycleTransitions(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		status     Status
+		moderation ModerationStatus
+		expiresAt  time.Time
+		apply      func(g *Grumble) error
+		want       Status
+		wantErr    bool
+	}{
+		{"公開中の投稿を成仏", StatusActive, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Purify, StatusPurified, false},
+		{"非同期モデレーション待ちにする", StatusActive, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).AwaitModeration, StatusPending, false},
+		{"モデレーション待ちを公開", StatusPending, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Publish, StatusActive, false},
+		{"モデレーション待ちを却下", StatusPending, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Reject, StatusRemoved, false},
+		{"期限内の削除済み投稿を復元すると公開", StatusRemoved, ModerationStatusRemoved, now.Add(time.Hour), (*Grumble).Restore, StatusActive, false},
+		{"期限切れの削除済み投稿を復元すると期限切れ", StatusRemoved, ModerationStatusRemoved, now.Add(-time.Hour), (*Grumble).Restore, StatusExpired, false},
+		{"投稿者が削除", StatusPurified, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Delete, StatusDeleted, false},
+		{"成仏済みは公開に戻せない", StatusPurified, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Publish, StatusPurified, true},
+		{"投稿者が削除した投稿は却下できない", StatusDeleted, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Reject, StatusDeleted, true},
+		{"通報で非表示にすると削除扱い", StatusActive, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).HideForReview, StatusRemoved, false},
+		{"成仏済みも通報で非表示にできる", StatusPurified, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).HideForReview, StatusRemoved, false},
+		{"非表示の投稿を復元すると公開", StatusRemoved, ModerationStatusHidden, now.Add(time.Hour), (*Grumble).Restore, StatusActive, false},
+		{"成仏済みを非表示にして復元すると成仏済みのまま", StatusPurified, ModerationStatusPublished, now.Add(time.Hour), func(g *Grumble) error {
+			if err := g.HideForReview(); err != nil {
+				return err
+			}
+			return g.Restore()
+		}, StatusPurified, false},
+		{"成仏済みを非表示から削除して復元しても成仏済みのまま", StatusPurified, ModerationStatusPublished, now.Add(-time.Hour), func(g *Grumble) error {
+			if err := g.HideForReview(); err != nil {
+				return err
+			}
+			if err := g.TakeDown(); err != nil {
+				return err
+			}
+			return g.Restore()
+		}, StatusPurified, false},
+		{"公開中を削除して復元すると公開", StatusActive, ModerationStatusPublished, now.Add(time.Hour), func(g *Grumble) error {
+			if err := g.TakeDown(); err != nil {
+				return err
+			}
+			return g.Restore()
+		}, StatusActive, false},
+		{"シャドウBANしても公開中のまま", StatusActive, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Shadow, StatusActive, false},
+		{"モデレーション待ちはシャドウBANしない", StatusPending, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Shadow, StatusPending, false},
+		{"成仏済みはシャドウBANできない", StatusPurified, ModerationStatusPublished, now.Add(time.Hour), (*Grumble).Shadow, StatusPurified, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grumble{Status: tt.status, ModerationStatus: tt.moderation, ExpiresAt: tt.expiresAt}
+
+			err := tt.apply(g)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var conflictErr *shared.ConflictError
+			if tt.wantErr && !errors.As(err, &conflictErr) {
+				t.Errorf("error = %v, want ConflictError", err)
+			}
+			if g.Status != tt.want {
+				t.Errorf("Status = %s, want %s", g.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumble_IsVisibleTo(t *testing.T) {
+	author := shared.UserID("00000000-0000-0000-0000-000000000001")
+	other := shared.UserID("00000000-0000-0000-0000-000000000002")
+	tests := []struct {
+		name       string
+		status     Status
+		moderation ModerationStatus
+		wantAuthor bool
+		wantOther  bool
+	}{
+		{"公開中は誰でも見られる", StatusActive, ModerationStatusPublished, true, true},
+		{"成仏済みも誰でも見られる", StatusPurified, ModerationStatusPublished, true, true},
+		{"モデレーション待ちは本人だけ", StatusPending, ModerationStatusPublished, true, false},
+		{"保留中は本人だけ", StatusActive, ModerationStatusHeld, true, false},
+		{"シャドウBAN中は本人だけ", StatusActive, ModerationStatusShadowed, true, false},
+		{"通報で非表示は誰も見られない", StatusRemoved, ModerationStatusHidden, false, false},
+		{"却下済みは誰も見られない", StatusRemoved, ModerationStatusRejected, false, false},
+		{"投稿者が削除したら誰も見られない", StatusDeleted, ModerationStatusPublished, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grumble{UserID: author, Status: tt.status, ModerationStatus: tt.moderation}
+
+			if got := g.IsVisibleTo(&author); got != tt.wantAuthor {
+				t.Errorf("IsVisibleTo(author) = %v, want %v", got, tt.wantAuthor)
+			}
+			if got := g.IsVisibleTo(&other); got != tt.wantOther {
+				t.Errorf("IsVisibleTo(other) = %v, want %v", got, tt.wantOther)
+			}
+			if got := g.IsVisibleTo(nil); got != tt.wantOther {
+				t.Errorf("IsVisibleTo(nil) = %v, want %v", got, tt.wantOther)
+			}
+		})
+	}
+}
+
+func TestStatus_ArchivedStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status Status
+		want   Status
+	}{
+		{"公開中は期限切れになる", StatusActive, StatusExpired},
+		{"モデレーション待ちは公開せず削除扱い", StatusPending, StatusRemoved},
+		{"成仏済みは成仏済みのまま", StatusPurified, StatusPurified},
+		{"削除済みは削除済みのまま", StatusRemoved, StatusRemoved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.ArchivedStatus(); got != tt.want {
+				t.Errorf("ArchivedStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumble_PendingReachingExpiryStaysPrivate(t *testing.T) {
+	author := shared.UserID("00000000-0000-0000-0000-000000000001")
+	other := shared.UserID("00000000-0000-0000-0000-000000000002")
+	g := &Grumble{UserID: author, Status: StatusActive, ExpiresAt: time.Now().Add(-time.Minute)}
+	if err := g.AwaitModeration(); err != nil {
+		t.Fatalf("AwaitModeration() error = %v", err)
+	}
+
+	g.Status = g.Status.ArchivedStatus()
+
+	if g.IsPublished() {
+		t.Error("IsPublished() = true, want false for a grumble that expired before moderation")
+	}
+	if g.IsVisibleTo(&other) || g.IsVisibleTo(nil) {
+		t.Error("IsVisibleTo(other) = true, want false for a grumble that expired before moderation")
+	}
+}
@@ -0,0 +1,116 @@
+package grumble
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// MaxLifetime keeps grumbles ephemeral: none stays on the timeline longer than a day
+const MaxLifetime = 24 * time.Hour
+
+// Lifetime is how long a grumble stays on the timeline: a fixed duration such as "6h", or until the next midnight
+type Lifetime string
+
+// LifetimeUntilMidnight expires the grumble at the next midnight (JST)
+const LifetimeUntilMidnight Lifetime = "midnight"
+
+// ParseLifetime accepts "midnight" or a duration between one minute and MaxLifetime
+func ParseLifetime(s string) (Lifetime, error) {
+	l := Lifetime(strings.TrimSpace(s))
+	if l == LifetimeUntilMidnight {
+		return l, nil
+	}
+	d, err := time.ParseDuration(string(l))
+	if err != nil {
+		return "", fmt.Errorf("lifetime %q must be %q or a duration such as 6h", s, LifetimeUntilMidnight)
+	}
+	if d < time.Minute || d > MaxLifetime {
+		return "", fmt.Errorf("lifetime %q must be between 1m and %s", s, MaxLifetime)
+	}
+	return l, nil
+}
+
+// LifetimePolicy lists the lifetimes authors may choose from and guarantees a minimum lifetime
+type LifetimePolicy struct {
+	Options []Lifetime    // Lifetimes authors may choose; the default is always allowed
+	Default Lifetime      // Used when the author chooses none; empty means LifetimeUntilMidnight
+	Minimum time.Duration // No grumble expires sooner than this after posting, even just before midnight
+}
+
+// NewLifetimePolicy parses configured lifetime options and default.
+// minimum may not exceed MaxLifetime.
+func NewLifetimePolicy(options []string, def string, minimum time.Duration) (LifetimePolicy, error) {
+	policy := LifetimePolicy{Minimum: minimum}
+	if minimum < 0 || minimum > MaxLifetime {
+		return policy, fmt.Errorf("minimum lifetime must be between 0 and %s", MaxLifetime)
+	}
+
+	for _, o := range options {
+		l, err := ParseLifetime(o)
+		if err != nil {
+			return policy, err
+		}
+		policy.Options = append(policy.Options, l)
+	}
+
+	l, err := ParseLifetime(def)
+	if err != nil {
+		return policy, fmt.Errorf("default %w", err)
+	}
+	policy.Default = l
+	return policy, nil
+}
+
+// Resolve returns the requested lifetime, or the default when none is requested
+func (p LifetimePolicy) Resolve(requested *string) (Lifetime, error) {
+	def := p.Default
+	if def == "" {
+		def = LifetimeUntilMidnight
+	}
+	if requested == nil || *requested == "" {
+		return def, nil
+	}
+
+	l := Lifetime(strings.TrimSpace(*requested))
+	if l == def {
+		return l, nil
+	}
+	for _, option := range p.Options {
+		if l == option {
+			return l, nil
+		}
+	}
+	return "", &shared.ValidationError{
+		Field:   "lifetime",
+		Message: fmt.Sprintf("lifetime must be one of %s", strings.Join(p.optionNames(def), ", ")),
+	}
+}
+
+// ExpiresAt computes when a grumble posted at postedAt with lifetime l expires.
+// nextMidnight is the midnight following postedAt; the result is never earlier than postedAt plus Minimum.
+func (p LifetimePolicy) ExpiresAt(l Lifetime, postedAt, nextMidnight time.Time) time.Time {
+	expiresAt := nextMidnight
+	if l != LifetimeUntilMidnight {
+		if d, err := time.ParseDuration(string(l)); err == nil {
+			expiresAt = postedAt.Add(d)
+		}
+	}
+
+	if earliest := postedAt.Add(p.Minimum); expiresAt.Before(earliest) {
+		expiresAt = earliest
+	}
+	return expiresAt
+}
+
+func (p LifetimePolicy) optionNames(def Lifetime) []string {
+	names := []string{string(def)}
+	for _, option := range p.Options {
+		if option != def {
+			names = append(names, string(option))
+		}
+	}
+	return names
+}
@@ -0,0 +1,91 @@
+package grumble
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestParseLifetime(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"深夜0時まで", "midnight", false},
+		{"1時間", "1h", false},
+		{"24時間", "24h", false},
+		{"24時間を超える寿命は不可", "25h", true},
+		{"1分未満は不可", "30s", true},
+		{"不正な値", "forever", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseLifetime(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("ParseLifetime(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLifetimePolicy_Resolve(t *testing.T) {
+	policy := LifetimePolicy{Options: []Lifetime{"1h", "6h", "24h"}, Default: LifetimeUntilMidnight}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		requested *string
+		want      Lifetime
+		wantErr   bool
+	}{
+		{"未指定ならデフォルト", nil, LifetimeUntilMidnight, false},
+		{"許可された寿命", str("6h"), "6h", false},
+		{"デフォルトは常に許可", str("midnight"), LifetimeUntilMidnight, false},
+		{"許可されていない寿命", str("3h"), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.Resolve(tt.requested)
+			if tt.wantErr {
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Resolve() error = %v, want ValidationError", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Resolve() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLifetimePolicy_ExpiresAt(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	midnight := time.Date(2026, 3, 3, 0, 0, 0, 0, jst)
+	policy := LifetimePolicy{Minimum: time.Hour}
+
+	tests := []struct {
+		name     string
+		lifetime Lifetime
+		postedAt time.Time
+		want     time.Time
+	}{
+		{"深夜0時まで", LifetimeUntilMidnight, midnight.Add(-5 * time.Hour), midnight},
+		{"23:55の投稿も最低寿命は保証", LifetimeUntilMidnight, midnight.Add(-5 * time.Minute), midnight.Add(55 * time.Minute)},
+		{"6時間", "6h", midnight.Add(-time.Hour), midnight.Add(5 * time.Hour)},
+		{"24時間は日付をまたぐ", "24h", midnight.Add(-5 * time.Minute), midnight.Add(24*time.Hour - 5*time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.ExpiresAt(tt.lifetime, tt.postedAt, midnight); !got.Equal(tt.want) {
+				t.Errorf("ExpiresAt() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package grumble
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// NormalizeForModeration canonicalises content so trivially different posts share a moderation result.
+// Surrounding whitespace is trimmed and internal whitespace runs collapse to a single space.
+func NormalizeForModeration(content string) string {
+	return strings.Join(strings.Fields(content), " ")
+}
+
+// ModerationCacheKey identifies a cached moderation result.
+// The model and prompt version are part of the key so switching either never serves stale decisions.
+func ModerationCacheKey(content, promptVersion, model string) string {
+	return HashContent(model + "\x00" + promptVersion + "\x00" + NormalizeForModeration(content))
+}
+
+// ModerationCacheStore persists moderation results beyond the lifetime of a process
+type ModerationCacheStore interface {
+	// Get returns the cached result and its expiry, or nil when absent or expired
+	Get(ctx context.Context, key string) (*ModerationResult, time.Time, error)
+
+	// Put stores a result until expiresAt, replacing any existing entry
+	Put(ctx context.Context, key string, result *ModerationResult, expiresAt time.Time) error
+
+	// DeleteExpired removes entries past their expiry and returns how many were removed
+	DeleteExpired(ctx context.Context) (int, error)
+}
+
+// ModerationCacheStats reports moderation cache effectiveness
+type ModerationCacheStats struct {
+	Hits    int64
+	Misses  int64
+	Entries int
+}
+
+// HitRate returns the fraction of lookups served from the cache
+func (s ModerationCacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
@@ -0,0 +1,57 @@
+package grumble
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// PromptSelector picks the moderation prompt for a user.
+// A candidate version can run side by side with the active one for a stable share of users.
+type PromptSelector struct {
+	active           ModerationPrompt
+	candidate        *ModerationPrompt
+	candidatePercent int
+}
+
+// NewPromptSelector builds a selector from the available prompts.
+// candidateVersion may be empty to disable the A/B split.
+func NewPromptSelector(prompts map[string]ModerationPrompt, activeVersion, candidateVersion string, candidatePercent int) (*PromptSelector, error) {
+	active, ok := prompts[activeVersion]
+	if !ok {
+		return nil, &shared.ValidationError{Field: "prompt_version", Message: fmt.Sprintf("unknown moderation prompt version %q", activeVersion)}
+	}
+
+	s := &PromptSelector{active: active}
+	if candidateVersion == "" || candidatePercent <= 0 {
+		return s, nil
+	}
+
+	candidate, ok := prompts[candidateVersion]
+	if !ok {
+		return nil, &shared.ValidationError{Field: "candidate_prompt_version", Message: fmt.Sprintf("unknown moderation prompt version %q", candidateVersion)}
+	}
+	if candidatePercent > 100 {
+		return nil, &shared.ValidationError{Field: "candidate_percent", Message: "candidate_percent must be between 0 and 100"}
+	}
+
+	s.candidate = &candidate
+	s.candidatePercent = candidatePercent
+	return s, nil
+}
+
+// Select returns the prompt for userID. The same user always lands in the same bucket.
+func (s *PromptSelector) Select(userID shared.UserID) ModerationPrompt {
+	if s.candidate != nil && promptBucket(userID) < s.candidatePercent {
+		return *s.candidate
+	}
+	return s.active
+}
+
+// promptBucket maps a user ID to a stable bucket in [0, 100)
+func promptBucket(userID shared.UserID) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(userID))
+	return int(h.Sum32() % 100)
+}
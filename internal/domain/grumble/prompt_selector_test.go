@@ -0,0 +1,76 @@
+package grumble
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestPromptSelector_Select(t *testing.T) {
+	prompts := map[string]ModerationPrompt{
+		"v1": {Version: "v1", Template: "a " + ModerationPromptPlaceholder},
+		"v2": {Version: "v2", Template: "b " + ModerationPromptPlaceholder},
+	}
+
+	tests := []struct {
+		name          string
+		candidate     string
+		percent       int
+		wantCandidate func(count int) bool
+	}{
+		{"候補なしは全員active", "", 50, func(c int) bool { return c == 0 }},
+		{"0%は全員active", "v2", 0, func(c int) bool { return c == 0 }},
+		{"100%は全員candidate", "v2", 100, func(c int) bool { return c == 1000 }},
+		{"50%はおおよそ半分", "v2", 50, func(c int) bool { return c > 400 && c < 600 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewPromptSelector(prompts, "v1", tt.candidate, tt.percent)
+			if err != nil {
+				t.Fatalf("NewPromptSelector() error = %v", err)
+			}
+
+			count := 0
+			for i := 0; i < 1000; i++ {
+				userID := shared.UserID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+				got := s.Select(userID)
+				if again := s.Select(userID); again.Version != got.Version {
+					t.Fatalf("Select(%s) is not stable: %s then %s", userID, got.Version, again.Version)
+				}
+				if got.Version == "v2" {
+					count++
+				}
+			}
+			if !tt.wantCandidate(count) {
+				t.Errorf("candidate selected %d/1000 times", count)
+			}
+		})
+	}
+}
+
+func TestNewPromptSelector_UnknownVersion(t *testing.T) {
+	prompts := map[string]ModerationPrompt{
+		"v1": {Version: "v1", Template: ModerationPromptPlaceholder},
+	}
+
+	tests := []struct {
+		name      string
+		active    string
+		candidate string
+		percent   int
+	}{
+		{"未知のactive", "v9", "", 0},
+		{"未知のcandidate", "v1", "v9", 10},
+		{"割合が100超", "v1", "v1", 101},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPromptSelector(prompts, tt.active, tt.candidate, tt.percent); err == nil {
+				t.Error("NewPromptSelector() error = nil, want error")
+			}
+		})
+	}
+}
@@ -9,52 +9,91 @@ import (
 
 // TimelineFilter represents filtering options for timeline queries
 type TimelineFilter struct {
-	ToxicLevelMin  *shared.ToxicLevel // Minimum toxic level (inclusive)
-	ToxicLevelMax  *shared.ToxicLevel // Maximum toxic level (inclusive)
-	IsPurified     *bool              // Restrict to a specific purification state when provided
-	ExcludeExpired bool               // Exclude expired grumbles
-	UserID         *shared.UserID     // Filter by author user ID
-	ViewerUserID   *shared.UserID     // Authenticated viewer for vibe state
-	Limit          int                // Number of results to return
-	Offset         int                // Number of results to skip
+	ToxicLevelMin    *shared.ToxicLevel // Minimum toxic level (inclusive)
+	ToxicLevelMax    *shared.ToxicLevel // Maximum toxic level (inclusive)
+	ToxicLevelSource ToxicLevelSource   // Which level ToxicLevelMin/Max compare against; defaults to self-reported
+	IsPurified       *bool              // Restrict to a specific purification state when provided
+	UserID           *shared.UserID     // Filter by author user ID
+	ViewerUserID     *shared.UserID     // Authenticated viewer for vibe state
+	Tag              *string            // Restrict to grumbles carrying this normalized tag name
+	Category         *Category          // Restrict to grumbles in this category
+	After            *TimelineCursor    // Keyset pagination: only grumbles after this position; used instead of Offset
+	Limit            int                // Number of results to return
+	Offset           int                // Number of results to skip
 }
 
 // Repository defines the interface for grumble persistence
 type Repository interface {
-	// Create stores a new grumble
+	// Create stores a new grumble with its tags
 	Create(ctx context.Context, grumble *Grumble) error
 
 	// FindByID retrieves a grumble by its ID
 	FindByID(ctx context.Context, id shared.GrumbleID) (*Grumble, error)
 
-	// FindTimeline retrieves grumbles for the timeline with filtering
+	// FindExpiredByID retrieves a grumble archived because it expired; grumbles deleted by their author are not found
+	FindExpiredByID(ctx context.Context, id shared.GrumbleID) (*Grumble, error)
+
+	// FindByIDIncludingArchive retrieves a grumble by its ID from the live or the archive table
+	FindByIDIncludingArchive(ctx context.Context, id shared.GrumbleID) (*Grumble, error)
+
+	// FindByAuthor retrieves an author's most recent grumbles in any moderation status, live or archived
+	FindByAuthor(ctx context.Context, userID shared.UserID, limit int) ([]*Grumble, error)
+
+	// CountByAuthorStatus counts an author's grumbles, live or archived, per moderation status
+	CountByAuthorStatus(ctx context.Context, userID shared.UserID) (map[ModerationStatus]int, error)
+
+	// FindTimeline retrieves grumbles for the timeline with filtering, newest first with grumble_id breaking ties
 	FindTimeline(ctx context.Context, filter TimelineFilter) ([]*Grumble, error)
 
-	// CountTimeline returns the total count of grumbles matching the filter
+	// CountTimeline returns the total count of grumbles matching the filter, ignoring After
 	CountTimeline(ctx context.Context, filter TimelineFilter) (int, error)
 
 	// Update updates an existing grumble
 	Update(ctx context.Context, grumble *Grumble) error
 
-	// ArchiveExpired moves expired grumbles to archive table and removes them from main table
+	// SaveEdit stores an edited grumble and its tags together with the revision it replaced.
+	// It fails with a ConflictError if the grumble received a vibe in the meantime.
+	SaveEdit(ctx context.Context, grumble *Grumble, revision *Revision) error
+
+	// SaveStatus stores the moderation and lifecycle status of a grumble, whether live or already archived
+	SaveStatus(ctx context.Context, g *Grumble) error
+
+	// ArchiveExpired moves expired grumbles, with their vibes and tags, to archive table and removes them from main table
 	ArchiveExpired(ctx context.Context) (int, error)
 
+	// DeleteByAuthor moves a grumble its author deleted, with its vibes and tags, to the archive as StatusDeleted.
+	// An already archived grumble is marked as deleted instead.
+	DeleteByAuthor(ctx context.Context, id shared.GrumbleID) error
+
 	// FindPurificationCandidates finds grumbles that meet purification threshold
 	// but are not yet purified
 	FindPurificationCandidates(ctx context.Context, threshold int) ([]*Grumble, error)
 
+	// ClaimPendingModeration claims unexpired grumbles awaiting asynchronous moderation, oldest first.
+	// Claimed grumbles are skipped by other workers until the lease runs out.
+	ClaimPendingModeration(ctx context.Context, limit int, lease time.Duration) ([]*Grumble, error)
+
+	// SaveModeration stores the outcome of asynchronous moderation of a claimed grumble.
+	// It fails with a ConflictError if the grumble was edited or decided since it was claimed.
+	SaveModeration(ctx context.Context, g *Grumble) error
+
 	// IncrementVibeCount atomically increments the vibe count for a grumble
 	IncrementVibeCount(ctx context.Context, id shared.GrumbleID) error
 
-	// FindArchivedTimeline retrieves grumbles from archive table for a specific date
+	// FindArchivedTimeline retrieves the grumbles posted on targetDate's calendar date, in each author's own timezone,
+	// for the event archive, live or archived, excluding those deleted by their author, in the same order as FindTimeline
 	FindArchivedTimeline(ctx context.Context, filter TimelineFilter, targetDate time.Time) ([]*Grumble, error)
 
-	// CountArchivedTimeline returns the total count of archived grumbles for a specific date
+	// CountArchivedTimeline returns the total count of grumbles FindArchivedTimeline would return for a specific date, ignoring After
 	CountArchivedTimeline(ctx context.Context, filter TimelineFilter, targetDate time.Time) (int, error)
 
-	// Stats aggregates grumble statistics by granularity and date range.
-	Stats(ctx context.Context, granularity Granularity, from, to time.Time) ([]StatsRow, error)
+	// Stats aggregates grumble statistics by granularity and date range, optionally for one category.
+	Stats(ctx context.Context, granularity Granularity, from, to time.Time, category *Category) ([]StatsRow, error)
+
+	// StatsByToxic aggregates grumble statistics by granularity, date range, and toxic level (optional filter),
+	// optionally for one category.
+	StatsByToxic(ctx context.Context, granularity Granularity, from, to time.Time, toxicLevel *int, category *Category) ([]StatsRow, error)
 
-	// StatsByToxic aggregates grumble statistics by granularity, date range, and toxic level (optional filter).
-	StatsByToxic(ctx context.Context, granularity Granularity, from, to time.Time, toxicLevel *int) ([]StatsRow, error)
+	// StatsByCategory aggregates grumble statistics by granularity, date range, and category (optional filter).
+	StatsByCategory(ctx context.Context, granularity Granularity, from, to time.Time, category *Category) ([]StatsRow, error)
 }
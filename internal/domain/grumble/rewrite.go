@@ -0,0 +1,39 @@
+package grumble
+
+import "context"
+
+// ContentRewritePrompt is the prompt template for Gemini API to soften rejected content.
+// Arguments: the moderation reason, then the original content.
+const ContentRewritePrompt = `以下の投稿はモデレーションで不適切と判定されました。
+投稿者の不満やつらさはそのまま残しつつ、不適切な部分を取り除いた書き換え案をJSON形式で出力して。
+**jsonのみ出力してください**
+
+# 書き換えのルール
+1. 特定の個人・団体への攻撃や誹謗中傷は、自分の気持ちの表現に置き換える
+2. 氏名、住所、電話番号、メールアドレスなどの個人情報は削除する
+3. 差別的な表現や違法行為の助長は削除する
+4. 投稿者の口調や愚痴としての温度感はなるべく保つ
+5. 280文字以内
+
+# 判定理由
+%s
+
+# 出力形式
+{
+  "rewrite": "書き換え案"
+}
+
+# 投稿内容
+%s`
+
+// RewriteSuggestion represents a softened rewrite returned by the LLM
+type RewriteSuggestion struct {
+	Rewrite string `json:"rewrite"`
+}
+
+// RewriteSuggester proposes a rewrite of content that moderation rejected
+type RewriteSuggester interface {
+	// SuggestRewrite returns a softened version of content that keeps the frustration
+	// but drops what result flagged
+	SuggestRewrite(ctx context.Context, content string, result *ModerationResult) (string, error)
+}
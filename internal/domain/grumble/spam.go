@@ -0,0 +1,168 @@
+package grumble
+
+import (
+	"context"
+	"hash/fnv"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// QuotaWindow is the rolling window the daily post quota is counted over
+const QuotaWindow = 24 * time.Hour
+
+// Near-duplicate detection compares SimHashes of character bigrams.
+// Short posts share too many bigrams by chance, so they only match exactly.
+const (
+	shingleSize        = 2
+	maxSimHashDistance = 10
+	minSimHashLength   = 16
+)
+
+// Fingerprint identifies content for duplicate detection without keeping the text itself
+type Fingerprint struct {
+	Hash    string // Exact match of the folded content
+	SimHash uint64 // Locality-sensitive hash; similar content differs in few bits
+	Length  int    // Characters in the folded content
+}
+
+// NewFingerprint fingerprints normalized content, ignoring case, whitespace and punctuation
+func NewFingerprint(content string) Fingerprint {
+	folded := []rune(foldForFingerprint(content))
+	return Fingerprint{
+		Hash:    HashContent(string(folded)),
+		SimHash: simHash(folded),
+		Length:  len(folded),
+	}
+}
+
+// IsNearDuplicate reports whether two fingerprints belong to the same or nearly the same content
+func (f Fingerprint) IsNearDuplicate(other Fingerprint) bool {
+	if f.Hash == other.Hash {
+		return true
+	}
+	if f.Length < minSimHashLength || other.Length < minSimHashLength {
+		return false
+	}
+	return bits.OnesCount64(f.SimHash^other.SimHash) <= maxSimHashDistance
+}
+
+func foldForFingerprint(content string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, content)
+}
+
+// simHash combines FNV hashes of overlapping character shingles into a 64-bit SimHash
+func simHash(runes []rune) uint64 {
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	add := func(shingle []rune) {
+		h := fnv.New64a()
+		h.Write([]byte(string(shingle)))
+		sum := h.Sum64()
+		for i := range weights {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	if len(runes) <= shingleSize {
+		add(runes)
+	} else {
+		for i := 0; i+shingleSize <= len(runes); i++ {
+			add(runes[i : i+shingleSize])
+		}
+	}
+
+	var hash uint64
+	for i, w := range weights {
+		if w > 0 {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// PostLogEntry is one accepted post attempt in a user's post log
+type PostLogEntry struct {
+	Fingerprint Fingerprint
+	PostedAt    time.Time
+}
+
+// PostLog keeps fingerprints of recent posts per user for spam detection
+type PostLog interface {
+	// Recent returns the user's entries posted at or after since, oldest first
+	Recent(ctx context.Context, userID shared.UserID, since time.Time) ([]PostLogEntry, error)
+
+	// Record appends a post to the user's log
+	Record(ctx context.Context, userID shared.UserID, entry PostLogEntry) error
+
+	// Reserve records entry unless check refuses the user's entries posted at or after since.
+	// Reservations for the same user run one at a time, so concurrent posts see each other's entries.
+	Reserve(ctx context.Context, userID shared.UserID, since time.Time, entry PostLogEntry, check func(recent []PostLogEntry) error) error
+
+	// Release removes a reserved entry whose post was never stored
+	Release(ctx context.Context, userID shared.UserID, entry PostLogEntry) error
+
+	// DeleteBefore removes entries no policy looks at anymore and returns how many were removed
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// SpamPolicy limits repeated and excessive posting per user
+type SpamPolicy struct {
+	DuplicateWindow time.Duration // Near-duplicates of posts within this window are refused; zero disables
+	DailyQuota      int           // Posts allowed per QuotaWindow; zero disables
+}
+
+// Lookback returns how far back the post log has to be read to apply the policy
+func (p SpamPolicy) Lookback() time.Duration {
+	if p.DailyQuota > 0 && QuotaWindow > p.DuplicateWindow {
+		return QuotaWindow
+	}
+	return p.DuplicateWindow
+}
+
+// Check refuses a post that nearly duplicates a recent one or exceeds the daily quota.
+// recent must hold the user's entries within Lookback.
+func (p SpamPolicy) Check(fp Fingerprint, recent []PostLogEntry, now time.Time) error {
+	if p.DuplicateWindow > 0 {
+		since := now.Add(-p.DuplicateWindow)
+		for _, e := range recent {
+			if !e.PostedAt.Before(since) && fp.IsNearDuplicate(e.Fingerprint) {
+				return &shared.DuplicateGrumbleError{Window: p.DuplicateWindow}
+			}
+		}
+	}
+
+	if p.DailyQuota > 0 {
+		since := now.Add(-QuotaWindow)
+		var counted []time.Time
+		for _, e := range recent {
+			if !e.PostedAt.Before(since) {
+				counted = append(counted, e.PostedAt)
+			}
+		}
+		if len(counted) >= p.DailyQuota {
+			// The quota frees up once enough of the oldest posts leave the window
+			sort.Slice(counted, func(i, j int) bool { return counted[i].Before(counted[j]) })
+			freedAt := counted[len(counted)-p.DailyQuota].Add(QuotaWindow)
+			return &shared.QuotaExceededError{Limit: p.DailyQuota, RetryAfter: freedAt.Sub(now)}
+		}
+	}
+
+	return nil
+}
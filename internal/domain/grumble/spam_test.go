@@ -0,0 +1,81 @@
+package grumble
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestFingerprint_IsNearDuplicate(t *testing.T) {
+	const long = "今日も上司に理不尽なことで怒られた。もう限界かもしれない"
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"同一内容", long, long, true},
+		{"句読点と空白の違いは無視", long, "今日も上司に理不尽なことで怒られた! もう限界かもしれない", true},
+		{"大文字小文字の違いは無視", "Monday is the worst", "monday IS the worst", true},
+		{"末尾に一文字足しただけ", long, long + "w", true},
+		{"一語だけ言い換え", long, "今日も上司に理不尽なことで叱られた。もう限界かもしれない", true},
+		{"別の愚痴", long, "満員電車で足を踏まれたのに謝られなかった", false},
+		{"短文は完全一致のみ", "月曜日つらい", "火曜日つらい", false},
+		{"短文でも完全一致は重複", "眠い", "眠い。", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFingerprint(tt.a).IsNearDuplicate(NewFingerprint(tt.b)); got != tt.want {
+				t.Errorf("IsNearDuplicate(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpamPolicy_Check(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	policy := SpamPolicy{DuplicateWindow: 10 * time.Minute, DailyQuota: 3}
+	same := NewFingerprint("月曜日つらい")
+	other := func(s string) PostLogEntry {
+		return PostLogEntry{Fingerprint: NewFingerprint(s), PostedAt: now.Add(-20 * time.Hour)}
+	}
+
+	tests := []struct {
+		name           string
+		recent         []PostLogEntry
+		wantDuplicate  bool
+		wantRetryAfter time.Duration
+	}{
+		{"履歴なし", nil, false, 0},
+		{"期間内の重複", []PostLogEntry{{Fingerprint: same, PostedAt: now.Add(-5 * time.Minute)}}, true, 0},
+		{"期間外なら同じ内容でも可", []PostLogEntry{{Fingerprint: same, PostedAt: now.Add(-11 * time.Minute)}}, false, 0},
+		{"上限到達で最古の投稿が24時間を過ぎるまで待つ",
+			[]PostLogEntry{other("残業"), other("寝不足"), {Fingerprint: NewFingerprint("雨"), PostedAt: now.Add(-time.Hour)}},
+			false, 4 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(same, tt.recent, now)
+
+			var duplicateErr *shared.DuplicateGrumbleError
+			if got := errors.As(err, &duplicateErr); got != tt.wantDuplicate {
+				t.Errorf("Check() error = %v, want duplicate = %v", err, tt.wantDuplicate)
+			}
+			var quotaErr *shared.QuotaExceededError
+			if tt.wantRetryAfter > 0 {
+				if !errors.As(err, &quotaErr) {
+					t.Fatalf("Check() error = %v, want QuotaExceededError", err)
+				}
+				if quotaErr.RetryAfter != tt.wantRetryAfter {
+					t.Errorf("RetryAfter = %s, want %s", quotaErr.RetryAfter, tt.wantRetryAfter)
+				}
+			} else if errors.As(err, &quotaErr) {
+				t.Errorf("Check() error = %v, want no quota error", err)
+			}
+		})
+	}
+}
@@ -17,5 +17,6 @@ type StatsRow struct {
 	PurifiedCount   int
 	UnpurifiedCount int
 	TotalVibes      int
-	ToxicLevel      *int // Optional; present for toxic-level breakdown
+	ToxicLevel      *int      // Optional; present for toxic-level breakdown
+	Category        *Category // Optional; present for category breakdown, nil there for grumbles without a category
 }
@@ -0,0 +1,152 @@
+package grumble
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxTagLength is the tag name limit in user-perceived characters (grapheme clusters)
+const MaxTagLength = 30
+
+// MaxTagsPerGrumble caps the tags kept for one grumble; hashtags beyond it are left as plain text
+const MaxTagsPerGrumble = 5
+
+// TagSource tells where a tag came from
+type TagSource string
+
+const (
+	TagSourceHashtag TagSource = "hashtag" // Written by the author as #tag in the content
+	TagSourceTopic   TagSource = "topic"   // Suggested by AI for a grumble without hashtags
+)
+
+// Tag is a normalized theme attached to a grumble, such as 今週の理不尽上司
+type Tag struct {
+	Name   string
+	Source TagSource
+}
+
+// hashtagPattern finds #tags that start a word. A # right after a letter or digit, as in a URL fragment, is not a hashtag.
+// Content is NFKC-normalized before parsing, so the full-width ＃ arrives as #.
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])#([\p{L}\p{M}\p{N}_]+)`)
+
+// tagNamePattern is the set of characters a tag name may consist of
+var tagNamePattern = regexp.MustCompile(`^[\p{L}\p{M}\p{N}_]+$`)
+
+// digitsPattern matches names made only of digits, which are not treated as tags (#1, #2024)
+var digitsPattern = regexp.MustCompile(`^\p{N}+$`)
+
+// NormalizeTag canonicalizes a tag name given with or without its leading #: NFKC normalization and lower case.
+// It returns a ValidationError for names that could not have been written as a hashtag.
+func NormalizeTag(name string) (string, error) {
+	name = strings.ToLower(norm.NFKC.String(strings.TrimSpace(name)))
+	name = strings.TrimPrefix(name, "#")
+	if name == "" {
+		return "", &shared.ValidationError{Field: "tag", Message: "tag cannot be empty"}
+	}
+	if !tagNamePattern.MatchString(name) || digitsPattern.MatchString(name) {
+		return "", &shared.ValidationError{Field: "tag", Message: "tag may contain only letters, digits and underscores, and not digits alone"}
+	}
+	if ContentLength(name) > MaxTagLength {
+		return "", &shared.ValidationError{Field: "tag", Message: "tag is too long"}
+	}
+	return name, nil
+}
+
+// ExtractHashtags returns the distinct hashtags in normalized content, in order of appearance.
+// Invalid hashtags are skipped and at most MaxTagsPerGrumble are returned.
+func ExtractHashtags(content string) []Tag {
+	var tags []Tag
+	seen := make(map[string]bool)
+	for _, match := range hashtagPattern.FindAllStringSubmatch(content, -1) {
+		name, err := NormalizeTag(match[1])
+		if err != nil || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, Tag{Name: name, Source: TagSourceHashtag})
+		if len(tags) == MaxTagsPerGrumble {
+			break
+		}
+	}
+	return tags
+}
+
+// RefreshHashtags replaces the grumble's hashtags with those in its current content.
+// A topic suggested earlier is kept unless the author now tags the grumble themselves.
+func (g *Grumble) RefreshHashtags() {
+	tags := ExtractHashtags(g.Content)
+	if len(tags) == 0 {
+		if topic := g.Topic(); topic != nil {
+			tags = append(tags, *topic)
+		}
+	}
+	g.Tags = tags
+}
+
+// HasHashtags reports whether the author tagged the grumble in its content
+func (g *Grumble) HasHashtags() bool {
+	for _, t := range g.Tags {
+		if t.Source == TagSourceHashtag {
+			return true
+		}
+	}
+	return false
+}
+
+// Topic returns the tag suggested by AI, or nil when there is none
+func (g *Grumble) Topic() *Tag {
+	for _, t := range g.Tags {
+		if t.Source == TagSourceTopic {
+			topic := t
+			return &topic
+		}
+	}
+	return nil
+}
+
+// SetTopic attaches a topic suggested by AI. Grumbles the author tagged themselves keep only their hashtags.
+func (g *Grumble) SetTopic(name string) error {
+	if g.HasHashtags() {
+		return nil
+	}
+	name, err := NormalizeTag(name)
+	if err != nil {
+		return err
+	}
+	g.Tags = []Tag{{Name: name, Source: TagSourceTopic}}
+	return nil
+}
+
+// TopicSuggestionPrompt is the prompt template for the LLM to name the theme of a grumble.
+// Argument: the content.
+const TopicSuggestionPrompt = `以下の愚痴投稿のテーマを、ハッシュタグとして使える短い名詞で1つだけJSON形式で出力して。
+**jsonのみ出力してください**
+
+# ルール
+1. 「上司」「残業」「満員電車」のように、同じ悩みを持つ人が探しやすい一般的な言葉にする
+2. 個人名、会社名、住所などの固有の情報は含めない
+3. 記号や空白、先頭の # は付けない
+4. 30文字以内
+
+# 出力形式
+{
+  "topic": "テーマ"
+}
+
+# 投稿内容
+%s`
+
+// TopicSuggestion represents a topic returned by the LLM
+type TopicSuggestion struct {
+	Topic string `json:"topic"`
+}
+
+// TopicSuggester names the theme of a grumble its author did not tag
+type TopicSuggester interface {
+	// SuggestTopic returns a short tag name describing what content is about
+	SuggestTopic(ctx context.Context, content string) (string, error)
+}
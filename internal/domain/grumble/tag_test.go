@@ -0,0 +1,100 @@
+package grumble
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"タグなし", "月曜日つらい", nil},
+		{"日本語のタグ", "また会議が延びた #今週の理不尽上司", []string{"今週の理不尽上司"}},
+		{"全角の＃と英字は正規化済みの本文で小文字になる", NormalizeContent("残業 ＃ＯＶＥＲＴＩＭＥ"), []string{"overtime"}},
+		{"出現順に重複なく並ぶ", "#会議 #残業 #会議", []string{"会議", "残業"}},
+		{"句読点でタグが終わる", "#月曜日、つらい", []string{"月曜日"}},
+		{"長音符もタグに含める", "#ブラックバイト", []string{"ブラックバイト"}},
+		{"単語の途中の#はタグにしない", "https://example.com/page#top", nil},
+		{"数字だけはタグにしない", "#1 #2024", nil},
+		{"長すぎるタグは飛ばす", "#" + strings.Repeat("あ", MaxTagLength+1) + " #短い", []string{"短い"}},
+		{"最大件数まで", "#a #b #c #d #e #f", []string{"a", "b", "c", "d", "e"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, tag := range ExtractHashtags(tt.content) {
+				if tag.Source != TagSourceHashtag {
+					t.Errorf("Source = %q, want %q", tag.Source, TagSourceHashtag)
+				}
+				got = append(got, tag.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractHashtags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"そのまま", "会議", "会議", false},
+		{"先頭の#を外す", "#会議", "会議", false},
+		{"全角英字は小文字の半角にする", "ＭＯＮＤＡＹ", "monday", false},
+		{"空は不可", " # ", "", true},
+		{"空白を含むのは不可", "会議 延長", "", true},
+		{"数字だけは不可", "2024", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTag(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var validationErr *shared.ValidationError
+			if tt.wantErr && !errors.As(err, &validationErr) {
+				t.Errorf("NormalizeTag() error = %v, want ValidationError", err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumble_RefreshHashtags(t *testing.T) {
+	topic := Tag{Name: "仕事", Source: TagSourceTopic}
+	tests := []struct {
+		name    string
+		content string
+		tags    []Tag
+		want    []Tag
+	}{
+		{"編集で付けたハッシュタグがテーマに代わる", "#残業 終わらない", []Tag{topic}, []Tag{{Name: "残業", Source: TagSourceHashtag}}},
+		{"ハッシュタグがなければテーマを残す", "終わらない", []Tag{topic}, []Tag{topic}},
+		{"消したハッシュタグは外れる", "終わらない", []Tag{{Name: "残業", Source: TagSourceHashtag}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grumble{Content: tt.content, Tags: tt.tags}
+			g.RefreshHashtags()
+			if !reflect.DeepEqual(g.Tags, tt.want) {
+				t.Errorf("Tags = %v, want %v", g.Tags, tt.want)
+			}
+		})
+	}
+}
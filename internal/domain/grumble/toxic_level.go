@@ -0,0 +1,55 @@
+package grumble
+
+import "github.com/dokkiitech/grumble-back/internal/domain/shared"
+
+// ToxicLevelSource selects which toxic level timeline filters compare against
+type ToxicLevelSource string
+
+const (
+	ToxicLevelSourceSelf ToxicLevelSource = "self" // Self-reported by the author (default)
+	ToxicLevelSourceAI   ToxicLevelSource = "ai"   // Estimated by moderation, falling back to self-reported
+)
+
+// ToxicLevelPolicyMode decides what happens when the self-reported level diverges from the estimate
+type ToxicLevelPolicyMode string
+
+const (
+	ToxicLevelPolicyOff   ToxicLevelPolicyMode = "off"   // Store the estimate only
+	ToxicLevelPolicyNudge ToxicLevelPolicyMode = "nudge" // Keep the author's level but flag the mismatch
+	ToxicLevelPolicyClamp ToxicLevelPolicyMode = "clamp" // Pull the author's level to within MaxGap of the estimate
+)
+
+// ToxicLevelPolicy reconciles self-reported and estimated toxic levels
+type ToxicLevelPolicy struct {
+	Mode   ToxicLevelPolicyMode
+	MaxGap int // Largest gap tolerated before the policy applies
+}
+
+// Apply records the estimate on g and enforces the policy.
+// Returns true when the gap exceeded MaxGap.
+func (p ToxicLevelPolicy) Apply(g *Grumble, estimate *shared.ToxicLevel) bool {
+	g.AIToxicLevel = estimate
+	if estimate == nil {
+		return false
+	}
+
+	gap := int(g.ToxicLevel) - int(*estimate)
+	if gap < 0 {
+		gap = -gap
+	}
+	if gap <= p.MaxGap {
+		return false
+	}
+
+	switch p.Mode {
+	case ToxicLevelPolicyNudge:
+		g.ToxicLevelMismatch = true
+	case ToxicLevelPolicyClamp:
+		if g.ToxicLevel < *estimate {
+			g.ToxicLevel = *estimate - shared.ToxicLevel(p.MaxGap)
+		} else {
+			g.ToxicLevel = *estimate + shared.ToxicLevel(p.MaxGap)
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package grumble
+
+import (
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+func TestToxicLevelPolicy_Apply(t *testing.T) {
+	level := func(l shared.ToxicLevel) *shared.ToxicLevel { return &l }
+
+	tests := []struct {
+		name         string
+		mode         ToxicLevelPolicyMode
+		selfReported shared.ToxicLevel
+		estimate     *shared.ToxicLevel
+		wantMismatch bool
+		wantLevel    shared.ToxicLevel
+		wantFlag     bool
+	}{
+		{"推定なしは何もしない", ToxicLevelPolicyClamp, 1, nil, false, 1, false},
+		{"差が許容範囲内なら何もしない", ToxicLevelPolicyClamp, 1, level(3), false, 1, false},
+		{"offは推定値を保存するだけ", ToxicLevelPolicyOff, 1, level(5), true, 1, false},
+		{"nudgeは自己申告を保ったまま通知", ToxicLevelPolicyNudge, 1, level(5), true, 1, true},
+		{"clampは過小申告を推定値-MaxGapに補正", ToxicLevelPolicyClamp, 1, level(5), true, 3, false},
+		{"clampは過大申告を推定値+MaxGapに補正", ToxicLevelPolicyClamp, 5, level(1), true, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Grumble{ToxicLevel: tt.selfReported}
+			policy := ToxicLevelPolicy{Mode: tt.mode, MaxGap: 2}
+
+			if got := policy.Apply(g, tt.estimate); got != tt.wantMismatch {
+				t.Errorf("Apply() = %v, want %v", got, tt.wantMismatch)
+			}
+			if g.ToxicLevel != tt.wantLevel {
+				t.Errorf("ToxicLevel = %d, want %d", g.ToxicLevel, tt.wantLevel)
+			}
+			if g.ToxicLevelMismatch != tt.wantFlag {
+				t.Errorf("ToxicLevelMismatch = %v, want %v", g.ToxicLevelMismatch, tt.wantFlag)
+			}
+			if g.AIToxicLevel != tt.estimate {
+				t.Errorf("AIToxicLevel = %v, want %v", g.AIToxicLevel, tt.estimate)
+			}
+		})
+	}
+}
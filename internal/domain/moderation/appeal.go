@@ -0,0 +1,92 @@
+package moderation
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// AppealID identifies a persisted moderation appeal
+type AppealID int64
+
+// AppealStatus is the state of an appeal in the moderator queue
+type AppealStatus string
+
+const (
+	AppealStatusPending  AppealStatus = "pending"
+	AppealStatusApproved AppealStatus = "approved" // The grumble was published
+	AppealStatusDenied   AppealStatus = "denied"   // The rejection stands
+)
+
+// Validate checks the status is one of the known values
+func (s AppealStatus) Validate() error {
+	switch s {
+	case AppealStatusPending, AppealStatusApproved, AppealStatusDenied:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "status", Message: "must be pending, approved or denied"}
+	}
+}
+
+// Appeal is an author's request to overturn the rejection of their grumble.
+// Content and RejectionReason are snapshots taken when the appeal is filed.
+type Appeal struct {
+	AppealID        AppealID
+	GrumbleID       shared.GrumbleID
+	UserID          shared.UserID
+	VerdictID       *VerdictID // The rejecting verdict, when it was recorded
+	Status          AppealStatus
+	Content         string
+	RejectionReason string
+	CreatedAt       time.Time
+
+	ResolvedBy     *shared.UserID
+	ResolvedAt     *time.Time
+	ResolutionNote *string
+}
+
+// NewAppeal files an appeal for a rejected grumble on behalf of its author.
+// verdict may be nil when the rejecting verdict could not be recorded.
+func NewAppeal(g *grumble.Grumble, userID shared.UserID, verdict *Verdict, at time.Time) (*Appeal, error) {
+	if g.UserID != userID {
+		return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(g.GrumbleID)}
+	}
+	if g.ModerationStatus != grumble.ModerationStatusRejected {
+		return nil, &shared.ValidationError{Field: "grumble_id", Message: "only rejected grumbles can be appealed"}
+	}
+
+	appeal := &Appeal{
+		GrumbleID: g.GrumbleID,
+		UserID:    userID,
+		Status:    AppealStatusPending,
+		Content:   g.Content,
+		CreatedAt: at,
+	}
+	if verdict != nil {
+		appeal.VerdictID = &verdict.VerdictID
+		appeal.RejectionReason = verdict.Reason
+	}
+	return appeal, nil
+}
+
+// IsPending reports whether the appeal still awaits a moderator
+func (a *Appeal) IsPending() bool {
+	return a.Status == AppealStatusPending
+}
+
+// Resolve records a moderator's decision on a pending appeal
+func (a *Appeal) Resolve(status AppealStatus, note *string, moderator shared.UserID, at time.Time) error {
+	if status != AppealStatusApproved && status != AppealStatusDenied {
+		return &shared.ValidationError{Field: "decision", Message: "must be approved or denied"}
+	}
+	if !a.IsPending() {
+		return &shared.ConflictError{Message: "appeal has already been resolved"}
+	}
+
+	a.Status = status
+	a.ResolutionNote = note
+	a.ResolvedBy = &moderator
+	a.ResolvedAt = &at
+	return nil
+}
@@ -0,0 +1,117 @@
+package moderation
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// ReportID identifies a persisted user report
+type ReportID int64
+
+// ReportReason is the category a user picks when reporting a grumble
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonDiscrimination ReportReason = "discrimination"
+	ReportReasonPersonalInfo   ReportReason = "personal_info"
+	ReportReasonIllegal        ReportReason = "illegal"
+	ReportReasonSelfHarm       ReportReason = "self_harm"
+	ReportReasonOther          ReportReason = "other"
+)
+
+// Validate checks the reason is one of the known values
+func (r ReportReason) Validate() error {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonDiscrimination, ReportReasonPersonalInfo,
+		ReportReasonIllegal, ReportReasonSelfHarm, ReportReasonOther:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "reason", Message: "must be spam, harassment, discrimination, personal_info, illegal, self_harm or other"}
+	}
+}
+
+// ReportResolution is the moderator action taken on a reported grumble
+type ReportResolution string
+
+const (
+	ReportResolutionRestored ReportResolution = "restored" // The grumble is published again
+	ReportResolutionRemoved  ReportResolution = "removed"  // The grumble is taken down for good
+)
+
+// Validate checks the resolution is one of the known values
+func (r ReportResolution) Validate() error {
+	switch r {
+	case ReportResolutionRestored, ReportResolutionRemoved:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "action", Message: "must be restore or remove"}
+	}
+}
+
+// Apply takes the grumble down or publishes it again as the resolution decides
+func (r ReportResolution) Apply(g *grumble.Grumble) error {
+	if r == ReportResolutionRemoved {
+		if g.IsRemoved() {
+			return nil
+		}
+		return g.TakeDown()
+	}
+	if g.ModerationStatus == grumble.ModerationStatusHidden || g.IsRemoved() {
+		return g.Restore()
+	}
+	return nil
+}
+
+// maxReportCommentLength bounds the free-text comment attached to a report
+const maxReportCommentLength = 500
+
+// Report is one user's flag on a published grumble.
+// Reports reference the grumble by ID only, so they survive its move to the archive.
+type Report struct {
+	ReportID   ReportID
+	GrumbleID  shared.GrumbleID
+	ReporterID shared.UserID
+	Reason     ReportReason
+	Comment    *string
+	CreatedAt  time.Time
+}
+
+// NewReport validates a user's report of a grumble
+func NewReport(g *grumble.Grumble, reporterID shared.UserID, reason ReportReason, comment *string, at time.Time) (*Report, error) {
+	if err := reason.Validate(); err != nil {
+		return nil, err
+	}
+	if comment != nil && len([]rune(*comment)) > maxReportCommentLength {
+		return nil, &shared.ValidationError{Field: "comment", Message: "comment must be 500 characters or less"}
+	}
+	if !g.IsPublished() {
+		return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(g.GrumbleID)}
+	}
+	if g.UserID == reporterID {
+		return nil, &shared.ValidationError{Field: "grumble_id", Message: "cannot report your own grumble"}
+	}
+
+	return &Report{
+		GrumbleID:  g.GrumbleID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Comment:    comment,
+		CreatedAt:  at,
+	}, nil
+}
+
+// ReportedGrumble aggregates the unresolved reports of one grumble for the moderator queue
+type ReportedGrumble struct {
+	GrumbleID        shared.GrumbleID
+	Content          string
+	ModerationStatus grumble.ModerationStatus
+	Archived         bool // The grumble has already expired into grumbles_archive
+	ReportCount      int
+	ReasonCounts     map[ReportReason]int
+	FirstReportedAt  time.Time
+	LastReportedAt   time.Time
+}
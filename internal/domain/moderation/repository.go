@@ -0,0 +1,90 @@
+package moderation
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// VerdictFilter represents filtering options for browsing the verdict log
+type VerdictFilter struct {
+	Category      *grumble.ModerationCategory // Verdicts flagged with this category
+	Decision      *Decision                   // Restrict to a specific decision
+	ReviewLabel   *ReviewLabel                // Restrict to a specific review label
+	PromptVersion *string                     // Restrict to verdicts produced by a prompt version
+	Unreviewed    bool                        // Only verdicts without a review label
+	From          *time.Time                  // created_at lower bound (inclusive)
+	To            *time.Time                  // created_at upper bound (exclusive)
+	Limit         int
+	Offset        int
+}
+
+// VerdictRepository defines persistence for the moderation verdict log
+type VerdictRepository interface {
+	// Create appends a verdict to the log
+	Create(ctx context.Context, verdict *Verdict) error
+
+	// FindByID retrieves a verdict by its ID
+	FindByID(ctx context.Context, id VerdictID) (*Verdict, error)
+
+	// FindLatestByGrumble retrieves the most recent verdict recorded for a grumble
+	FindLatestByGrumble(ctx context.Context, grumbleID shared.GrumbleID) (*Verdict, error)
+
+	// List returns verdicts matching the filter, newest first
+	List(ctx context.Context, filter VerdictFilter) ([]*Verdict, error)
+
+	// Count returns the number of verdicts matching the filter
+	Count(ctx context.Context, filter VerdictFilter) (int, error)
+
+	// UpdateReview stores the review fields of a verdict
+	UpdateReview(ctx context.Context, verdict *Verdict) error
+
+	// CountRejectedByUserSince counts the user's rejected verdicts created at or after since,
+	// leaving out those a moderator labelled as false positives
+	CountRejectedByUserSince(ctx context.Context, userID shared.UserID, since time.Time) (int, error)
+}
+
+// AppealFilter represents filtering options for the appeal queue
+type AppealFilter struct {
+	Status *AppealStatus // Restrict to a specific status
+	Limit  int
+	Offset int
+}
+
+// AppealRepository defines persistence for moderation appeals
+type AppealRepository interface {
+	// Create files a new appeal; a grumble can be appealed only once
+	Create(ctx context.Context, appeal *Appeal) error
+
+	// FindByID retrieves an appeal by its ID
+	FindByID(ctx context.Context, id AppealID) (*Appeal, error)
+
+	// List returns appeals matching the filter, oldest first
+	List(ctx context.Context, filter AppealFilter) ([]*Appeal, error)
+
+	// Count returns the number of appeals matching the filter
+	Count(ctx context.Context, filter AppealFilter) (int, error)
+
+	// UpdateResolution stores the resolution of a pending appeal
+	UpdateResolution(ctx context.Context, appeal *Appeal) error
+}
+
+// ReportRepository defines persistence for user reports
+type ReportRepository interface {
+	// Create stores a report; each user can report a grumble only once
+	Create(ctx context.Context, report *Report) error
+
+	// CountUnresolved returns the number of reports on a grumble that no moderator has acted on yet
+	CountUnresolved(ctx context.Context, grumbleID shared.GrumbleID) (int, error)
+
+	// ListQueue returns grumbles with unresolved reports, most reported first
+	ListQueue(ctx context.Context, limit, offset int) ([]*ReportedGrumble, error)
+
+	// CountQueue returns the number of grumbles with unresolved reports
+	CountQueue(ctx context.Context) (int, error)
+
+	// Resolve marks every unresolved report on a grumble as handled and returns how many were resolved
+	Resolve(ctx context.Context, grumbleID shared.GrumbleID, resolution ReportResolution, note *string, resolvedBy shared.UserID, at time.Time) (int, error)
+}
@@ -0,0 +1,91 @@
+package moderation
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// VerdictID identifies a persisted moderation verdict
+type VerdictID int64
+
+// Decision is the outcome applied to a post after moderation
+type Decision string
+
+const (
+	DecisionApproved Decision = "approved" // Published
+	DecisionRejected Decision = "rejected" // Blocked with a reason
+	DecisionHeld     Decision = "held"     // Stored privately (e.g. self-harm)
+)
+
+// ReviewLabel is a moderator's assessment of a verdict
+type ReviewLabel string
+
+const (
+	ReviewLabelCorrect       ReviewLabel = "correct"
+	ReviewLabelFalsePositive ReviewLabel = "false_positive" // Blocked or held, but was acceptable
+	ReviewLabelFalseNegative ReviewLabel = "false_negative" // Approved, but should have been blocked
+)
+
+// Validate checks the label is one of the known values
+func (l ReviewLabel) Validate() error {
+	switch l {
+	case ReviewLabelCorrect, ReviewLabelFalsePositive, ReviewLabelFalseNegative:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "label", Message: "must be correct, false_positive or false_negative"}
+	}
+}
+
+// Verdict is an immutable record of one moderation decision.
+// Only the review fields are filled in later by moderators.
+type Verdict struct {
+	VerdictID     VerdictID
+	ContentHash   string
+	UserID        shared.UserID
+	GrumbleID     *shared.GrumbleID // Set when the post was stored
+	Decision      Decision
+	Categories    []grumble.ModerationCategory
+	Reason        string
+	Model         string
+	PromptVersion string
+	Latency       time.Duration
+	CreatedAt     time.Time
+
+	ReviewLabel *ReviewLabel
+	ReviewNote  *string
+	ReviewedBy  *shared.UserID
+	ReviewedAt  *time.Time
+}
+
+// DecisionFromResult derives the applied decision from a moderation result
+func DecisionFromResult(result *grumble.ModerationResult) Decision {
+	switch {
+	case result.IsSelfHarm():
+		return DecisionHeld
+	case !result.IsAppropriate:
+		return DecisionRejected
+	default:
+		return DecisionApproved
+	}
+}
+
+// Review records a moderator's assessment of the verdict
+func (v *Verdict) Review(label ReviewLabel, note *string, reviewer shared.UserID, at time.Time) error {
+	if err := label.Validate(); err != nil {
+		return err
+	}
+	if label == ReviewLabelFalsePositive && v.Decision == DecisionApproved {
+		return &shared.ValidationError{Field: "label", Message: "false_positive applies only to rejected or held verdicts"}
+	}
+	if label == ReviewLabelFalseNegative && v.Decision != DecisionApproved {
+		return &shared.ValidationError{Field: "label", Message: "false_negative applies only to approved verdicts"}
+	}
+
+	v.ReviewLabel = &label
+	v.ReviewNote = note
+	v.ReviewedBy = &reviewer
+	v.ReviewedAt = &at
+	return nil
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// NotificationID identifies a persisted notification
+type NotificationID int64
+
+// Kind classifies what a notification is about
+type Kind string
+
+const (
+	KindGrumbleRejected  Kind = "grumble_rejected"  // Asynchronous moderation rejected a grumble
+	KindGrumbleHeld      Kind = "grumble_held"      // Asynchronous moderation held a grumble and offers crisis support
+	KindAppealApproved   Kind = "appeal_approved"   // A moderator overturned a rejection and published the grumble
+	KindAppealDenied     Kind = "appeal_denied"     // A moderator upheld a rejection
+	KindSanctionWarning  Kind = "sanction_warning"  // Repeated rejections earned a warning
+	KindSanctionCooldown Kind = "sanction_cooldown" // Posting is paused for a while
+)
+
+// Notification is a message delivered to a single user
+type Notification struct {
+	NotificationID NotificationID
+	UserID         shared.UserID
+	Kind           Kind
+	GrumbleID      *shared.GrumbleID
+	Message        string
+	CreatedAt      time.Time
+}
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// Repository defines persistence for user notifications
+type Repository interface {
+	// Create stores a new notification
+	Create(ctx context.Context, notification *Notification) error
+
+	// ListByUser returns the user's notifications, newest first
+	ListByUser(ctx context.Context, userID shared.UserID, limit int) ([]*Notification, error)
+}
@@ -0,0 +1,33 @@
+package sanction
+
+import "time"
+
+// escalation is the order in which automatic sanctions are issued to repeat offenders.
+// It stops at a shadow-ban: banning an account is left to an admin.
+var escalation = []Level{LevelWarning, LevelCooldown, LevelShadowBan}
+
+// Policy decides when and how strongly rejected posts are sanctioned automatically
+type Policy struct {
+	RejectionThreshold int           // Rejections within Window that trigger a sanction
+	Window             time.Duration // How far back rejections are counted
+	EscalationLookback time.Duration // How far back earlier automatic sanctions raise the next level
+	CooldownDuration   time.Duration
+	ShadowBanDuration  time.Duration
+}
+
+// Next returns the level and duration of the next automatic sanction,
+// given how many unrevoked automatic sanctions the user received within EscalationLookback.
+func (p Policy) Next(previous int) (Level, time.Duration) {
+	if previous >= len(escalation) {
+		previous = len(escalation) - 1
+	}
+	level := escalation[previous]
+	switch level {
+	case LevelCooldown:
+		return level, p.CooldownDuration
+	case LevelShadowBan:
+		return level, p.ShadowBanDuration
+	default:
+		return level, 0
+	}
+}
@@ -0,0 +1,29 @@
+package sanction
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// Repository defines the interface for sanction persistence
+type Repository interface {
+	// Create stores a new sanction
+	Create(ctx context.Context, sanction *Sanction) error
+
+	// FindByID retrieves a sanction by its ID
+	FindByID(ctx context.Context, id SanctionID) (*Sanction, error)
+
+	// FindActiveByUser returns the user's unrevoked, unexpired sanctions
+	FindActiveByUser(ctx context.Context, userID shared.UserID, at time.Time) ([]*Sanction, error)
+
+	// ListByUser returns every sanction issued to the user, newest first
+	ListByUser(ctx context.Context, userID shared.UserID) ([]*Sanction, error)
+
+	// UpdateRevocation stores the revocation of a sanction
+	UpdateRevocation(ctx context.Context, sanction *Sanction) error
+
+	// LockUser serializes automatic sanction decisions for the user until the surrounding transaction ends
+	LockUser(ctx context.Context, userID shared.UserID) error
+}
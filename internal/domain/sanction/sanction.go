@@ -0,0 +1,128 @@
+package sanction
+
+import (
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// SanctionID identifies a persisted sanction
+type SanctionID int64
+
+// Level is how strongly a sanction restricts a user
+type Level string
+
+const (
+	LevelWarning   Level = "warning"    // Recorded and notified; no restriction
+	LevelCooldown  Level = "cooldown"   // Posting is blocked until the sanction expires
+	LevelShadowBan Level = "shadow_ban" // New grumbles are visible only to their author
+	LevelBan       Level = "ban"        // Every authenticated request is refused
+)
+
+// Validate checks the level is one of the known values
+func (l Level) Validate() error {
+	switch l {
+	case LevelWarning, LevelCooldown, LevelShadowBan, LevelBan:
+		return nil
+	default:
+		return &shared.ValidationError{Field: "level", Message: "must be warning, cooldown, shadow_ban or ban"}
+	}
+}
+
+// Source records whether a sanction was issued by the rejection policy or by an admin
+type Source string
+
+const (
+	SourceAutomatic Source = "automatic"
+	SourceManual    Source = "manual"
+)
+
+// Sanction is a restriction placed on a user.
+// A nil ExpiresAt means the sanction lasts until it is revoked.
+type Sanction struct {
+	SanctionID SanctionID
+	UserID     shared.UserID
+	Level      Level
+	Source     Source
+	Reason     string
+	IssuedBy   *shared.UserID // nil for automatic sanctions
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+
+	RevokedBy *shared.UserID
+	RevokedAt *time.Time
+}
+
+// New creates a sanction starting at the given time.
+// A cooldown needs a positive duration; for other levels zero means no expiry.
+func New(userID shared.UserID, level Level, source Source, reason string, issuedBy *shared.UserID, duration time.Duration, at time.Time) (*Sanction, error) {
+	if err := level.Validate(); err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return nil, &shared.ValidationError{Field: "reason", Message: "reason is required"}
+	}
+	if duration < 0 || (level == LevelCooldown && duration == 0) {
+		return nil, &shared.ValidationError{Field: "duration", Message: "duration must be positive for a cooldown and not negative otherwise"}
+	}
+
+	s := &Sanction{
+		UserID:    userID,
+		Level:     level,
+		Source:    source,
+		Reason:    reason,
+		IssuedBy:  issuedBy,
+		CreatedAt: at,
+	}
+	if duration > 0 {
+		expiresAt := at.Add(duration)
+		s.ExpiresAt = &expiresAt
+	}
+	return s, nil
+}
+
+// IsActive reports whether the sanction restricts the user at the given time
+func (s *Sanction) IsActive(at time.Time) bool {
+	if s.RevokedAt != nil {
+		return false
+	}
+	return s.ExpiresAt == nil || s.ExpiresAt.After(at)
+}
+
+// Revoke lifts the sanction before it expires
+func (s *Sanction) Revoke(by shared.UserID, at time.Time) error {
+	if s.RevokedAt != nil {
+		return &shared.ConflictError{Message: "sanction has already been revoked"}
+	}
+	s.RevokedBy = &by
+	s.RevokedAt = &at
+	return nil
+}
+
+// Standing is the combined effect of a user's active sanctions
+type Standing struct {
+	Banned        bool
+	ShadowBanned  bool
+	CooldownUntil *time.Time // Latest expiry among active cooldowns
+}
+
+// StandingOf combines the sanctions that are active at the given time
+func StandingOf(sanctions []*Sanction, at time.Time) Standing {
+	var standing Standing
+	for _, s := range sanctions {
+		if !s.IsActive(at) {
+			continue
+		}
+		switch s.Level {
+		case LevelBan:
+			standing.Banned = true
+		case LevelShadowBan:
+			standing.ShadowBanned = true
+		case LevelCooldown:
+			if standing.CooldownUntil == nil || s.ExpiresAt.After(*standing.CooldownUntil) {
+				standing.CooldownUntil = s.ExpiresAt
+			}
+		}
+	}
+	return standing
+}
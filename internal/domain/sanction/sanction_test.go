@@ -0,0 +1,65 @@
+package sanction
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStandingOf(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	soon := now.Add(10 * time.Minute)
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		sanctions    []*Sanction
+		wantBanned   bool
+		wantShadow   bool
+		wantCooldown *time.Time
+	}{
+		{"制裁なし", nil, false, false, nil},
+		{"警告は制限しない", []*Sanction{{Level: LevelWarning}}, false, false, nil},
+		{"期限切れのクールダウン", []*Sanction{{Level: LevelCooldown, ExpiresAt: &past}}, false, false, nil},
+		{"クールダウンは最も遅い期限", []*Sanction{{Level: LevelCooldown, ExpiresAt: &soon}, {Level: LevelCooldown, ExpiresAt: &later}}, false, false, &later},
+		{"無期限のBAN", []*Sanction{{Level: LevelBan}}, true, false, nil},
+		{"解除済みのシャドウBAN", []*Sanction{{Level: LevelShadowBan, RevokedAt: &past}}, false, false, nil},
+		{"有効なシャドウBAN", []*Sanction{{Level: LevelShadowBan, ExpiresAt: &later}}, false, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StandingOf(tt.sanctions, now)
+			if got.Banned != tt.wantBanned || got.ShadowBanned != tt.wantShadow {
+				t.Errorf("StandingOf() = %+v, want banned=%v shadow=%v", got, tt.wantBanned, tt.wantShadow)
+			}
+			if (got.CooldownUntil == nil) != (tt.wantCooldown == nil) ||
+				(got.CooldownUntil != nil && !got.CooldownUntil.Equal(*tt.wantCooldown)) {
+				t.Errorf("CooldownUntil = %v, want %v", got.CooldownUntil, tt.wantCooldown)
+			}
+		})
+	}
+}
+
+func TestPolicy_Next(t *testing.T) {
+	p := Policy{CooldownDuration: time.Hour, ShadowBanDuration: 24 * time.Hour}
+
+	tests := []struct {
+		previous     int
+		wantLevel    Level
+		wantDuration time.Duration
+	}{
+		{0, LevelWarning, 0},
+		{1, LevelCooldown, time.Hour},
+		{2, LevelShadowBan, 24 * time.Hour},
+		{3, LevelShadowBan, 24 * time.Hour},
+		{7, LevelShadowBan, 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		level, duration := p.Next(tt.previous)
+		if level != tt.wantLevel || duration != tt.wantDuration {
+			t.Errorf("Next(%d) = (%s, %v), want (%s, %v)", tt.previous, level, duration, tt.wantLevel, tt.wantDuration)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package shared
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // NotFoundError represents a resource not found error
 type NotFoundError struct {
@@ -32,6 +35,15 @@ func (e *DuplicateVibeError) Error() string {
 	return fmt.Sprintf("user %s already gave vibe to grumble %s", e.UserID, e.GrumbleID)
 }
 
+// ConflictError represents a request that conflicts with the current state of a resource
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Message)
+}
+
 // UnauthorizedError represents an authentication failure
 type UnauthorizedError struct {
 	Message string
@@ -41,6 +53,43 @@ func (e *UnauthorizedError) Error() string {
 	return fmt.Sprintf("unauthorized: %s", e.Message)
 }
 
+// ForbiddenError represents an authenticated user who is not allowed to perform an action
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("forbidden: %s", e.Message)
+}
+
+// CooldownError represents an action refused until a cooldown expires
+type CooldownError struct {
+	RetryAfter time.Duration
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("cooldown active: retry after %s", e.RetryAfter.Round(time.Second))
+}
+
+// DuplicateGrumbleError represents a grumble that repeats one the user posted recently
+type DuplicateGrumbleError struct {
+	Window time.Duration
+}
+
+func (e *DuplicateGrumbleError) Error() string {
+	return fmt.Sprintf("a nearly identical grumble was already posted within the last %s", e.Window)
+}
+
+// QuotaExceededError represents a user who has used up their post quota
+type QuotaExceededError struct {
+	Limit      int
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("post quota of %d per day exceeded: retry after %s", e.Limit, e.RetryAfter.Round(time.Second))
+}
+
 // InternalError represents an internal system error
 type InternalError struct {
 	Message string
@@ -60,9 +109,22 @@ func (e *InternalError) Unwrap() error {
 
 // InappropriateContentError represents content that violates moderation rules
 type InappropriateContentError struct {
-	Reason string
+	Reason           string
+	GrumbleID        *GrumbleID // Rejected draft kept privately so the author can appeal
+	SuggestedRewrite *string    // Softened rewrite that already passed moderation, when available
 }
 
 func (e *InappropriateContentError) Error() string {
 	return e.Reason
 }
+
+// SelfHarmContentError represents content flagged as self-harm.
+// The grumble is held privately and the author is offered support resources instead of a plain rejection.
+type SelfHarmContentError struct {
+	GrumbleID GrumbleID
+	Support   CrisisSupport
+}
+
+func (e *SelfHarmContentError) Error() string {
+	return fmt.Sprintf("grumble %s held for crisis support", e.GrumbleID)
+}
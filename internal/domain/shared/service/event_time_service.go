@@ -3,6 +3,7 @@ package service
 import "time"
 
 // EventTimeService handles event time window logic and time calculations.
+// It computes in JST unless a user's zone is selected with In.
 type EventTimeService struct {
 	timezone       *time.Location
 	eventStartHour int // イベント開始時刻（00:00）
@@ -24,7 +25,27 @@ func NewEventTimeService() *EventTimeService {
 	}
 }
 
-// IsEventTimeWindow checks if current time is in event display window (00:00-12:00 JST).
+// Location returns the zone named by a user's timezone preference,
+// or the default zone (JST) when the name is empty or unknown.
+func (s *EventTimeService) Location(name string) *time.Location {
+	if name == "" {
+		return s.timezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return s.timezone
+	}
+	return loc
+}
+
+// In returns a copy of the service that computes days, midnights and the event window in loc.
+func (s *EventTimeService) In(loc *time.Location) *EventTimeService {
+	copied := *s
+	copied.timezone = loc
+	return &copied
+}
+
+// IsEventTimeWindow checks if current time is in event display window (00:00-12:00 in the service's zone).
 func (s *EventTimeService) IsEventTimeWindow(now time.Time) bool {
 	nowJST := now.In(s.timezone)
 	hour := nowJST.Hour()
@@ -47,7 +68,7 @@ func (s *EventTimeService) GetEventTargetDate(now time.Time) time.Time {
 	return nowJST
 }
 
-// GetDayBounds returns the start and end time of a given date in JST.
+// GetDayBounds returns the start and end time of a given date in the service's zone.
 func (s *EventTimeService) GetDayBounds(date time.Time) (start, end time.Time) {
 	dateJST := date.In(s.timezone)
 
@@ -60,13 +81,13 @@ func (s *EventTimeService) GetDayBounds(date time.Time) (start, end time.Time) {
 		s.timezone,
 	)
 
-	// その日の23:59:59
-	end = start.Add(24*time.Hour - time.Second)
+	// その日の23:59:59（夏時間のある地域では1日が24時間とは限らない）
+	end = start.AddDate(0, 0, 1).Add(-time.Second)
 
 	return start, end
 }
 
-// CalculateNextMidnight calculates the next midnight (00:00) in JST.
+// CalculateNextMidnight calculates the next midnight (00:00) in the service's zone.
 // Used for setting expiration time of grumbles.
 func (s *EventTimeService) CalculateNextMidnight(now time.Time) time.Time {
 	nowJST := now.In(s.timezone)
@@ -80,6 +101,6 @@ func (s *EventTimeService) CalculateNextMidnight(now time.Time) time.Time {
 		s.timezone,
 	)
 
-	// 翌日の00:00
-	return midnight.Add(24 * time.Hour)
+	// 翌日の00:00（夏時間のある地域では24時間後とは限らない）
+	return midnight.AddDate(0, 0, 1)
 }
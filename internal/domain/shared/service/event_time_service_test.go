@@ -144,3 +144,38 @@ func TestEventTimeService_CalculateNextMidnight(t *testing.T) {
 		})
 	}
 }
+
+func TestEventTimeService_In(t *testing.T) {
+	svc := NewEventTimeService()
+	newYork := svc.Location("America/New_York")
+
+	tests := []struct {
+		name        string
+		currentTime time.Time
+		expected    time.Time
+	}{
+		{
+			name:        "ニューヨークの22時 - 現地の翌日0時を返す",
+			currentTime: time.Date(2026, 3, 2, 22, 0, 0, 0, newYork),
+			expected:    time.Date(2026, 3, 3, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:        "夏時間の開始日 - 23時間後の翌日0時を返す",
+			currentTime: time.Date(2026, 3, 8, 0, 30, 0, 0, newYork),
+			expected:    time.Date(2026, 3, 9, 0, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := svc.In(newYork).CalculateNextMidnight(tt.currentTime)
+			if !result.Equal(tt.expected) {
+				t.Errorf("CalculateNextMidnight(%v) = %v, want %v", tt.currentTime, result, tt.expected)
+			}
+		})
+	}
+
+	if svc.Location("") != svc.Location("Not/AZone") {
+		t.Errorf("Location() should fall back to the default zone for empty and unknown names")
+	}
+}
@@ -35,6 +35,5 @@ func (s *PurifyService) Purify(g *grumble.Grumble) error {
 	if !s.ShouldPurify(g) {
 		return errors.New("grumble does not meet purification criteria")
 	}
-	g.Purify()
-	return nil
+	return g.Purify()
 }
@@ -37,3 +37,16 @@ func (v VibeType) Validate() error {
 	}
 	return nil
 }
+
+// SupportResource represents a hotline or service offered to users in crisis
+type SupportResource struct {
+	Name    string
+	Contact string // Phone number or other direct contact
+	URL     string // Optional
+}
+
+// CrisisSupport bundles the message and resources shown when self-harm content is detected
+type CrisisSupport struct {
+	Message   string
+	Resources []SupportResource
+}
@@ -0,0 +1,37 @@
+package user
+
+// Role grants access to the admin API.
+// Roles come from the Firebase "role" custom claim or from the configured allowlists.
+type Role string
+
+const (
+	RoleNone      Role = ""
+	RoleModerator Role = "moderator" // Can work the moderation queues and take down or restore grumbles
+	RoleAdmin     Role = "admin"     // Everything a moderator can do, plus virtue adjustments and the audit log
+)
+
+// ParseRole converts a custom claim value into a Role, ignoring unknown values
+func ParseRole(value string) Role {
+	switch Role(value) {
+	case RoleModerator, RoleAdmin:
+		return Role(value)
+	default:
+		return RoleNone
+	}
+}
+
+// Satisfies reports whether the role grants at least the required role
+func (r Role) Satisfies(required Role) bool {
+	return r.level() >= required.level()
+}
+
+func (r Role) level() int {
+	switch r {
+	case RoleAdmin:
+		return 2
+	case RoleModerator:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -12,6 +12,7 @@ type AnonymousUser struct {
 	VirtuePoints int
 	CreatedAt    time.Time
 	ProfileTitle *string // Optional title like "今週の菩薩"
+	Timezone     *string // IANA timezone name for expiry and daily boundaries; nil uses the default zone
 }
 
 // Rank returns the virtue-based rank derived from virtue points.
@@ -64,3 +65,21 @@ func (u *AnonymousUser) UpdateTitle(title string) error {
 	u.ProfileTitle = &title
 	return nil
 }
+
+// SetTimezone sets the user's timezone preference from an IANA name such as "America/New_York"
+func (u *AnonymousUser) SetTimezone(name string) error {
+	if name == "" || name == "Local" {
+		return &shared.ValidationError{
+			Field:   "timezone",
+			Message: "timezone must be an IANA timezone name",
+		}
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return &shared.ValidationError{
+			Field:   "timezone",
+			Message: "unknown timezone: " + name,
+		}
+	}
+	u.Timezone = &name
+	return nil
+}
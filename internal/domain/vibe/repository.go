@@ -11,7 +11,7 @@ type Repository interface {
 	// Create persists a new vibe and returns resulting counters.
 	Create(ctx context.Context, vibe *Vibe) (*CreateResult, error)
 
-	// Exists checks whether the user has already vibed the grumble.
+	// Exists checks whether the user has already vibed the grumble, including vibes archived with it.
 	Exists(ctx context.Context, grumbleID shared.GrumbleID, userID shared.UserID) (bool, error)
 
 	// CountByGrumble returns the number of vibes for a grumble.
@@ -0,0 +1,139 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresAuditLogRepository implements audit.Repository using PostgreSQL.
+// The table rejects UPDATE and DELETE, so entries can only be appended.
+type PostgresAuditLogRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresAuditLogRepository creates a new PostgresAuditLogRepository
+func NewPostgresAuditLogRepository(db *pgxpool.Pool) *PostgresAuditLogRepository {
+	return &PostgresAuditLogRepository{db: db}
+}
+
+const auditColumns = "entry_id, actor_id, action, target_type, target_id, reason, detail, created_at"
+
+// Append stores a new entry
+func (r *PostgresAuditLogRepository) Append(ctx context.Context, e *audit.Entry) error {
+	query := `
+		INSERT INTO admin_audit_log (actor_id, action, target_type, target_id, reason, detail, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING entry_id
+	`
+
+	detail := e.Detail
+	if detail == nil {
+		detail = map[string]any{}
+	}
+
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		e.ActorID, e.Action, e.TargetType, e.TargetID, e.Reason, detail, e.CreatedAt,
+	).Scan(&e.EntryID)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to append audit log entry",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// List returns entries matching the filter, newest first
+func (r *PostgresAuditLogRepository) List(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	query, args := buildAuditFilter("SELECT "+auditColumns+" FROM admin_audit_log WHERE 1=1", filter)
+
+	query += " ORDER BY created_at DESC, entry_id DESC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, filter.Offset)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query audit log",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var entries []*audit.Entry
+	for rows.Next() {
+		e, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan audit log entry",
+				Err:     err,
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating audit log",
+			Err:     err,
+		}
+	}
+
+	return entries, nil
+}
+
+// Count returns the number of entries matching the filter
+func (r *PostgresAuditLogRepository) Count(ctx context.Context, filter audit.Filter) (int, error) {
+	query, args := buildAuditFilter("SELECT COUNT(*) FROM admin_audit_log WHERE 1=1", filter)
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count audit log",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+func scanAuditEntry(row pgx.Row) (*audit.Entry, error) {
+	var e audit.Entry
+	err := row.Scan(&e.EntryID, &e.ActorID, &e.Action, &e.TargetType, &e.TargetID, &e.Reason, &e.Detail, &e.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func buildAuditFilter(base string, filter audit.Filter) (string, []interface{}) {
+	query := base
+	args := []interface{}{}
+
+	if filter.ActorID != nil {
+		query += fmt.Sprintf(" AND actor_id = $%d", len(args)+1)
+		args = append(args, string(*filter.ActorID))
+	}
+	if filter.Action != nil {
+		query += fmt.Sprintf(" AND action = $%d", len(args)+1)
+		args = append(args, string(*filter.Action))
+	}
+	if filter.TargetID != nil {
+		query += fmt.Sprintf(" AND target_id = $%d", len(args)+1)
+		args = append(args, *filter.TargetID)
+	}
+
+	return query, args
+}
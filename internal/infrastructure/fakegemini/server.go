@@ -0,0 +1,220 @@
+// Package fakegemini provides a local stand-in for the Gemini generateContent API.
+//
+// Responses are served from recorded fixtures so tests are deterministic and run offline.
+// With GEMINI_RECORD=1 (and GEMINI_API_KEY set) matching requests are forwarded to the real
+// API and the fixture files are rewritten with the live responses.
+package fakegemini
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// upstreamURL is the real Gemini API endpoint used in record mode
+const upstreamURL = "https://generativelanguage.googleapis.com"
+
+// Fixture is one canned generateContent exchange, stored as <name>.json.
+type Fixture struct {
+	Name string `json:"-"`
+	// Match selects the fixture: the first fixture whose Match appears in the prompt wins
+	Match  string `json:"match"`
+	Status int    `json:"status"`
+	// DelayMS holds the response back, e.g. to trigger client timeouts
+	DelayMS int `json:"delay_ms,omitempty"`
+	// Synthetic fixtures describe broken responses the real API would not return; they are never re-recorded
+	Synthetic bool            `json:"synthetic,omitempty"`
+	Response  json.RawMessage `json:"response"`
+}
+
+// Server is an httptest server that speaks the Gemini REST API.
+type Server struct {
+	*httptest.Server
+
+	dir      string
+	record   bool
+	apiKey   string
+	fixtures []*Fixture
+
+	mu      sync.Mutex
+	prompts []string
+}
+
+// NewServer starts a fake Gemini server serving the fixtures in dir; it is closed when the test ends.
+func NewServer(t testing.TB, dir string) *Server {
+	t.Helper()
+
+	fixtures, err := loadFixtures(dir)
+	if err != nil {
+		t.Fatalf("fakegemini: %v", err)
+	}
+
+	s := &Server{
+		dir:      dir,
+		record:   os.Getenv("GEMINI_RECORD") == "1",
+		apiKey:   os.Getenv("GEMINI_API_KEY"),
+		fixtures: fixtures,
+	}
+	if s.record && s.apiKey == "" {
+		t.Fatal("fakegemini: GEMINI_RECORD=1 requires GEMINI_API_KEY")
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Prompts returns the prompt text of every request received so far.
+func (s *Server) Prompts() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.prompts...)
+}
+
+// generateContentRequest is the subset of the request body needed to find the prompt
+type generateContentRequest struct {
+	Contents []struct {
+		Parts []struct {
+			Text string `json:"text"`
+		} `json:"parts"`
+	} `json:"contents"`
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, ":generateContent") {
+		http.Error(w, "unsupported endpoint", http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req generateContentRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var prompt strings.Builder
+	for _, c := range req.Contents {
+		for _, p := range c.Parts {
+			prompt.WriteString(p.Text)
+		}
+	}
+	s.mu.Lock()
+	s.prompts = append(s.prompts, prompt.String())
+	s.mu.Unlock()
+
+	fixture := s.match(prompt.String())
+	if fixture == nil {
+		http.Error(w, "no fixture matches the prompt", http.StatusNotFound)
+		return
+	}
+
+	if s.record && !fixture.Synthetic {
+		if err := s.recordFixture(r, body, fixture); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+
+	if fixture.DelayMS > 0 {
+		select {
+		case <-time.After(time.Duration(fixture.DelayMS) * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(fixture.Status)
+	_, _ = w.Write(fixture.Response)
+}
+
+func (s *Server) match(prompt string) *Fixture {
+	for _, f := range s.fixtures {
+		if strings.Contains(prompt, f.Match) {
+			return f
+		}
+	}
+	return nil
+}
+
+// recordFixture forwards the request to the real API and saves the live response into fixture
+func (s *Server) recordFixture(r *http.Request, body []byte, fixture *Fixture) error {
+	upstreamReq, err := http.NewRequestWithContext(r.Context(), http.MethodPost, upstreamURL+r.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	upstreamReq.Header.Set("Content-Type", "application/json")
+	upstreamReq.Header.Set("x-goog-api-key", s.apiKey)
+
+	resp, err := http.DefaultClient.Do(upstreamReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, respBody); err != nil {
+		return fmt.Errorf("upstream returned non-JSON body: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fixture.Status = resp.StatusCode
+	fixture.Response = compact.Bytes()
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.dir, fixture.Name+".json"), append(data, '\n'), 0o644)
+}
+
+// loadFixtures reads every *.json fixture in dir, ordered by file name
+func loadFixtures(dir string) ([]*Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]*Fixture, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var f Fixture
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("fixture %s: %w", path, err)
+		}
+		if f.Match == "" {
+			return nil, fmt.Errorf("fixture %s: match is required", path)
+		}
+		if f.Status == 0 {
+			f.Status = http.StatusOK
+		}
+		f.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+		fixtures = append(fixtures, &f)
+	}
+	return fixtures, nil
+}
@@ -2,46 +2,55 @@ package infrastructure
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"strings"
+	"time"
 
-	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 	"google.golang.org/genai"
 )
 
-// GeminiClient implements grumble.ContentFilterClient using Gemini API
+// GeminiClient implements LLMProvider using Gemini API
 type GeminiClient struct {
-	apiKey string
-	model  string
+	apiKey  string
+	model   string
+	baseURL string
+	timeout time.Duration
 }
 
-// NewGeminiClient creates a new Gemini client
-func NewGeminiClient(apiKey, model string) *GeminiClient {
+// NewGeminiClient creates a new Gemini client.
+// baseURL overrides the API endpoint (empty uses the default) and timeout bounds each call (0 means no limit).
+func NewGeminiClient(apiKey, model, baseURL string, timeout time.Duration) *GeminiClient {
 	return &GeminiClient{
-		apiKey: apiKey,
-		model:  model,
+		apiKey:  apiKey,
+		model:   model,
+		baseURL: baseURL,
+		timeout: timeout,
 	}
 }
 
-// FilterContent implements grumble.ContentFilterClient
-func (c *GeminiClient) FilterContent(ctx context.Context, content string) (*grumble.ModerationResult, error) {
+// Model implements LLMProvider
+func (c *GeminiClient) Model() string {
+	return c.model
+}
+
+// Generate implements LLMProvider
+func (c *GeminiClient) Generate(ctx context.Context, prompt string) (string, error) {
 	// Create client with API key from environment
-	client, err := genai.NewClient(ctx, &genai.ClientConfig{
-		APIKey: c.apiKey,
-	})
+	clientConfig := &genai.ClientConfig{
+		APIKey:      c.apiKey,
+		Backend:     genai.BackendGeminiAPI,
+		HTTPOptions: genai.HTTPOptions{BaseURL: c.baseURL},
+	}
+	if c.timeout > 0 {
+		clientConfig.HTTPOptions.Timeout = &c.timeout
+	}
+	client, err := genai.NewClient(ctx, clientConfig)
 	if err != nil {
-		return nil, &shared.InternalError{
+		return "", &shared.InternalError{
 			Message: "failed to create Gemini client",
 			Err:     err,
 		}
 	}
 
-	// Build prompt
-	prompt := fmt.Sprintf(grumble.ContentModerationPrompt, content)
-
-	// Generate content with JSON response format
 	result, err := client.Models.GenerateContent(
 		ctx,
 		c.model,
@@ -49,7 +58,7 @@ func (c *GeminiClient) FilterContent(ctx context.Context, content string) (*grum
 		nil,
 	)
 	if err != nil {
-		return nil, &shared.InternalError{
+		return "", &shared.InternalError{
 			Message: "failed to generate content from Gemini",
 			Err:     err,
 		}
@@ -58,33 +67,10 @@ func (c *GeminiClient) FilterContent(ctx context.Context, content string) (*grum
 	// Get response text
 	responseText := result.Text()
 	if responseText == "" {
-		return nil, &shared.InternalError{
+		return "", &shared.InternalError{
 			Message: "empty response from Gemini",
 		}
 	}
 
-	// Clean up response text (remove markdown code blocks if present)
-	responseText = strings.TrimSpace(responseText)
-	responseText = strings.TrimPrefix(responseText, "```json")
-	responseText = strings.TrimPrefix(responseText, "```")
-	responseText = strings.TrimSuffix(responseText, "```")
-	responseText = strings.TrimSpace(responseText)
-
-	// Unmarshal and validate
-	var moderationResult grumble.ModerationResult
-	if err := json.Unmarshal([]byte(responseText), &moderationResult); err != nil {
-		return nil, &shared.InternalError{
-			Message: fmt.Sprintf("failed to parse Gemini response: %s", responseText),
-			Err:     err,
-		}
-	}
-
-	// Validate result
-	if moderationResult.Reason == "" {
-		return nil, &shared.InternalError{
-			Message: fmt.Sprintf("invalid moderation result: reason is empty. Response: %s", responseText),
-		}
-	}
-
-	return &moderationResult, nil
+	return responseText, nil
 }
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/infrastructure/fakegemini"
+)
+
+const testGeminiModel = "gemini-2.5-flash-lite"
+
+func newFakeGeminiFilter(t *testing.T) (*LLMContentFilter, *fakegemini.Server) {
+	t.Helper()
+	server := fakegemini.NewServer(t, "testdata/gemini")
+	client := NewGeminiClient("test-api-key", testGeminiModel, server.URL, 200*time.Millisecond)
+	return NewLLMContentFilter(client), server
+}
+
+func TestLLMContentFilter_FilterContent_Gemini(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		wantAppropriate bool
+		wantCategories  []grumble.ModerationCategory
+		wantLevel       int
+	}{
+		{"適切な投稿（コードフェンス付き）", "会議が長すぎて昼ごはんを食べそびれた", true, nil, 2},
+		{"不適切な投稿", "隣の席の田中の住所をさらしてやる", false, []grumble.ModerationCategory{grumble.ModerationCategoryHarassment, grumble.ModerationCategoryPersonalInfo}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, server := newFakeGeminiFilter(t)
+
+			result, err := filter.FilterContent(context.Background(), moderationRequest(tt.content))
+			if err != nil {
+				t.Fatalf("FilterContent() error = %v", err)
+			}
+			if result.IsAppropriate != tt.wantAppropriate {
+				t.Errorf("IsAppropriate = %v, want %v", result.IsAppropriate, tt.wantAppropriate)
+			}
+			if len(result.Categories) != len(tt.wantCategories) {
+				t.Fatalf("Categories = %v, want %v", result.Categories, tt.wantCategories)
+			}
+			for _, c := range tt.wantCategories {
+				if !result.HasCategory(c) {
+					t.Errorf("Categories = %v, missing %s", result.Categories, c)
+				}
+			}
+			if result.EstimatedToxicLevel != tt.wantLevel {
+				t.Errorf("EstimatedToxicLevel = %d, want %d", result.EstimatedToxicLevel, tt.wantLevel)
+			}
+			if result.Model != testGeminiModel || result.PromptVersion != testPrompt.Version {
+				t.Errorf("provenance = (%q, %q), want (%q, %q)", result.Model, result.PromptVersion, testGeminiModel, testPrompt.Version)
+			}
+
+			prompts := server.Prompts()
+			if len(prompts) != 1 || prompts[0] != testPrompt.Render(tt.content) {
+				t.Errorf("prompts sent = %q, want rendered template", prompts)
+			}
+		})
+	}
+}
+
+func TestLLMContentFilter_FilterContent_GeminiErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantMessage string
+	}{
+		{"壊れたJSON", "壊れたJSONを返すケース", "failed to parse moderation response"},
+		{"理由が空", "理由が空のケース", "reason is empty"},
+		{"空の応答", "空の応答を返すケース", "empty response from Gemini"},
+		{"APIエラー", "サーバーエラーのケース", "failed to generate content from Gemini"},
+		{"タイムアウト", "タイムアウトするケース", "failed to generate content from Gemini"},
+		{"該当フィクスチャなし", "どのフィクスチャにも一致しない", "failed to generate content from Gemini"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, _ := newFakeGeminiFilter(t)
+
+			result, err := filter.FilterContent(context.Background(), moderationRequest(tt.content))
+			if err == nil {
+				t.Fatalf("FilterContent() = %+v, want error", result)
+			}
+			var internalErr *shared.InternalError
+			if !errors.As(err, &internalErr) {
+				t.Fatalf("error type = %T, want *shared.InternalError", err)
+			}
+			if !strings.Contains(internalErr.Message, tt.wantMessage) {
+				t.Errorf("error message = %q, want it to contain %q", internalErr.Message, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresGrumbleReportRepository implements moderation.ReportRepository using PostgreSQL
+type PostgresGrumbleReportRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresGrumbleReportRepository creates a new PostgresGrumbleReportRepository
+func NewPostgresGrumbleReportRepository(db *pgxpool.Pool) *PostgresGrumbleReportRepository {
+	return &PostgresGrumbleReportRepository{db: db}
+}
+
+// Create stores a report; each user can report a grumble only once
+func (r *PostgresGrumbleReportRepository) Create(ctx context.Context, report *moderation.Report) error {
+	query := `
+		INSERT INTO grumble_reports (grumble_id, reporter_id, reason, comment, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING report_id
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		report.GrumbleID, report.ReporterID, report.Reason, report.Comment, report.CreatedAt,
+	).Scan(&report.ReportID)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return &shared.ConflictError{Message: fmt.Sprintf("grumble %s has already been reported by this user", report.GrumbleID)}
+		}
+		return &shared.InternalError{
+			Message: "failed to create grumble report",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// CountUnresolved returns the number of reports on a grumble that no moderator has acted on yet
+func (r *PostgresGrumbleReportRepository) CountUnresolved(ctx context.Context, grumbleID shared.GrumbleID) (int, error) {
+	query := "SELECT COUNT(*) FROM grumble_reports WHERE grumble_id = $1 AND resolution IS NULL"
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, grumbleID).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count grumble reports",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+// ListQueue returns grumbles with unresolved reports, most reported first.
+// Reported grumbles that already expired are read from grumbles_archive.
+func (r *PostgresGrumbleReportRepository) ListQueue(ctx context.Context, limit, offset int) ([]*moderation.ReportedGrumble, error) {
+	query := `
+		SELECT r.grumble_id,
+		       COALESCE(g.content, a.content, ''),
+		       COALESCE(g.moderation_status, a.moderation_status, ''),
+		       g.grumble_id IS NULL,
+		       COUNT(*),
+		       MIN(r.created_at),
+		       MAX(r.created_at),
+		       array_agg(r.reason)
+		FROM grumble_reports r
+		LEFT JOIN grumbles g ON g.grumble_id = r.grumble_id
+		LEFT JOIN grumbles_archive a ON a.grumble_id = r.grumble_id
+		WHERE r.resolution IS NULL
+		GROUP BY r.grumble_id, g.grumble_id, g.content, g.moderation_status, a.content, a.moderation_status
+		ORDER BY COUNT(*) DESC, MIN(r.created_at) ASC, r.grumble_id
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query report queue",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var queue []*moderation.ReportedGrumble
+	for rows.Next() {
+		var (
+			item    moderation.ReportedGrumble
+			reasons []string
+		)
+		err := rows.Scan(
+			&item.GrumbleID, &item.Content, &item.ModerationStatus, &item.Archived,
+			&item.ReportCount, &item.FirstReportedAt, &item.LastReportedAt, &reasons,
+		)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan report queue",
+				Err:     err,
+			}
+		}
+
+		item.ReasonCounts = make(map[moderation.ReportReason]int)
+		for _, reason := range reasons {
+			item.ReasonCounts[moderation.ReportReason(reason)]++
+		}
+		queue = append(queue, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating report queue",
+			Err:     err,
+		}
+	}
+
+	return queue, nil
+}
+
+// CountQueue returns the number of grumbles with unresolved reports
+func (r *PostgresGrumbleReportRepository) CountQueue(ctx context.Context) (int, error) {
+	query := "SELECT COUNT(DISTINCT grumble_id) FROM grumble_reports WHERE resolution IS NULL"
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count report queue",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+// Resolve marks every unresolved report on a grumble as handled and returns how many were resolved
+func (r *PostgresGrumbleReportRepository) Resolve(
+	ctx context.Context,
+	grumbleID shared.GrumbleID,
+	resolution moderation.ReportResolution,
+	note *string,
+	resolvedBy shared.UserID,
+	at time.Time,
+) (int, error) {
+	query := `
+		UPDATE grumble_reports
+		SET resolution = $2, resolution_note = $3, resolved_by = $4, resolved_at = $5
+		WHERE grumble_id = $1 AND resolution IS NULL
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, grumbleID, resolution, note, resolvedBy, at)
+	if err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to resolve grumble reports",
+			Err:     err,
+		}
+	}
+
+	return int(result.RowsAffected()), nil
+}
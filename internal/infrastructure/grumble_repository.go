@@ -4,41 +4,52 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
-	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PostgresGrumbleRepository implements grumble.Repository using PostgreSQL
 type PostgresGrumbleRepository struct {
-	db           *pgxpool.Pool
-	eventTimeSvc *sharedservice.EventTimeService
+	db *pgxpool.Pool
 }
 
 // NewPostgresGrumbleRepository creates a new PostgresGrumbleRepository
-func NewPostgresGrumbleRepository(db *pgxpool.Pool, eventTimeSvc *sharedservice.EventTimeService) *PostgresGrumbleRepository {
+func NewPostgresGrumbleRepository(db *pgxpool.Pool) *PostgresGrumbleRepository {
 	return &PostgresGrumbleRepository{
-		db:           db,
-		eventTimeSvc: eventTimeSvc,
+		db: db,
 	}
 }
 
-// Create stores a new grumble
+// Create stores a new grumble with its tags
 func (r *PostgresGrumbleRepository) Create(ctx context.Context, g *grumble.Grumble) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to start transaction",
+			Err:     err,
+		}
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
 	query := `
 		INSERT INTO grumbles (
 			grumble_id, user_id, content, toxic_level, vibe_count,
-			purified_threshold, is_purified, posted_at, expires_at, is_event_grumble
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			purified_threshold, status, posted_at, expires_at, is_event_grumble,
+			moderation_status, ai_toxic_level, timezone, posted_on, category, lifetime
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, ($8 AT TIME ZONE $13)::date, $14, $15)
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	_, err = tx.Exec(ctx, query,
 		g.GrumbleID, g.UserID, g.Content, g.ToxicLevel, g.VibeCount,
-		g.PurifiedThreshold, g.IsPurified, g.PostedAt, g.ExpiresAt, g.IsEventGrumble,
+		g.PurifiedThreshold, g.Status, g.PostedAt, g.ExpiresAt, g.IsEventGrumble,
+		g.ModerationStatus, g.AIToxicLevel, g.Timezone, g.Category, g.Lifetime,
 	)
 	if err != nil {
 		return &shared.InternalError{
@@ -47,6 +58,106 @@ func (r *PostgresGrumbleRepository) Create(ctx context.Context, g *grumble.Grumb
 		}
 	}
 
+	if err := saveTags(ctx, tx, g); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return &shared.InternalError{
+			Message: "failed to commit transaction",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// saveTags replaces the tags of a live grumble with g.Tags, creating tag names not seen before
+func saveTags(ctx context.Context, tx pgx.Tx, g *grumble.Grumble) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM grumble_tags WHERE grumble_id = $1", g.GrumbleID); err != nil {
+		return &shared.InternalError{
+			Message: "failed to clear grumble tags",
+			Err:     err,
+		}
+	}
+
+	// ON CONFLICT DO NOTHING returns no row for existing names, so the no-op update is needed for RETURNING
+	query := `
+		WITH tag AS (
+			INSERT INTO tags (name) VALUES ($2)
+			ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+			RETURNING tag_id
+		)
+		INSERT INTO grumble_tags (grumble_id, tag_id, source, position)
+		SELECT $1, tag_id, $3, $4 FROM tag
+	`
+	for i, t := range g.Tags {
+		if _, err := tx.Exec(ctx, query, g.GrumbleID, t.Name, t.Source, i); err != nil {
+			return &shared.InternalError{
+				Message: "failed to save grumble tag",
+				Err:     err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// attachTags loads the tags of grumbles, live or archived, in one query
+func (r *PostgresGrumbleRepository) attachTags(ctx context.Context, grumbles []*grumble.Grumble) error {
+	if len(grumbles) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(grumbles))
+	byID := make(map[shared.GrumbleID]*grumble.Grumble, len(grumbles))
+	for i, g := range grumbles {
+		ids[i] = string(g.GrumbleID)
+		byID[g.GrumbleID] = g
+	}
+
+	query := `
+		SELECT gt.grumble_id, t.name, gt.source
+		FROM (
+			SELECT grumble_id, tag_id, source, position FROM grumble_tags WHERE grumble_id = ANY($1::uuid[])
+			UNION ALL
+			SELECT grumble_id, tag_id, source, position FROM grumble_tags_archive WHERE grumble_id = ANY($1::uuid[])
+		) gt
+		JOIN tags t ON t.tag_id = gt.tag_id
+		ORDER BY gt.grumble_id, gt.position
+	`
+	rows, err := conn(ctx, r.db).Query(ctx, query, ids)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to query grumble tags",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id  shared.GrumbleID
+			tag grumble.Tag
+		)
+		if err := rows.Scan(&id, &tag.Name, &tag.Source); err != nil {
+			return &shared.InternalError{
+				Message: "failed to scan grumble tag",
+				Err:     err,
+			}
+		}
+		if g, ok := byID[id]; ok {
+			g.Tags = append(g.Tags, tag)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return &shared.InternalError{
+			Message: "error iterating grumble tags",
+			Err:     err,
+		}
+	}
+
 	return nil
 }
 
@@ -54,15 +165,17 @@ func (r *PostgresGrumbleRepository) Create(ctx context.Context, g *grumble.Grumb
 func (r *PostgresGrumbleRepository) FindByID(ctx context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
 	query := `
 		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
-		       purified_threshold, is_purified, posted_at, expires_at, is_event_grumble
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
 		FROM grumbles
 		WHERE grumble_id = $1
 	`
 
 	var g grumble.Grumble
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(
 		&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
-		&g.PurifiedThreshold, &g.IsPurified, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+		&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+		&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, &shared.NotFoundError{
@@ -77,15 +190,208 @@ func (r *PostgresGrumbleRepository) FindByID(ctx context.Context, id shared.Grum
 		}
 	}
 
+	if err := r.attachTags(ctx, []*grumble.Grumble{&g}); err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// FindByIDIncludingArchive retrieves a grumble by its ID from the live or the archive table
+func (r *PostgresGrumbleRepository) FindByIDIncludingArchive(ctx context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	query := `
+		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+		FROM grumbles
+		WHERE grumble_id = $1
+		UNION ALL
+		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+		FROM grumbles_archive
+		WHERE grumble_id = $1
+		LIMIT 1
+	`
+
+	var g grumble.Grumble
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(
+		&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
+		&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+		&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "Grumble",
+			ID:     string(id),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find grumble",
+			Err:     err,
+		}
+	}
+
+	if err := r.attachTags(ctx, []*grumble.Grumble{&g}); err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// FindExpiredByID retrieves a grumble archived because it expired; grumbles deleted by their author are not found
+func (r *PostgresGrumbleRepository) FindExpiredByID(ctx context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	query := `
+		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+		FROM grumbles_archive
+		WHERE grumble_id = $1 AND status IN ('expired', 'purified')
+	`
+
+	var g grumble.Grumble
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(
+		&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
+		&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+		&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "Grumble",
+			ID:     string(id),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find expired grumble",
+			Err:     err,
+		}
+	}
+
+	if err := r.attachTags(ctx, []*grumble.Grumble{&g}); err != nil {
+		return nil, err
+	}
+
 	return &g, nil
 }
 
+// FindByAuthor retrieves an author's most recent grumbles in any moderation status, live or archived
+func (r *PostgresGrumbleRepository) FindByAuthor(ctx context.Context, userID shared.UserID, limit int) ([]*grumble.Grumble, error) {
+	query := `
+		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+		FROM (
+			SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+			       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+			       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+			FROM grumbles
+			WHERE user_id = $1
+			UNION ALL
+			SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+			       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+			       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+			FROM grumbles_archive
+			WHERE user_id = $1
+		) authored
+		ORDER BY posted_at DESC
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query author grumbles",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var grumbles []*grumble.Grumble
+	for rows.Next() {
+		var g grumble.Grumble
+		err := rows.Scan(
+			&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
+			&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
+		)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan grumble",
+				Err:     err,
+			}
+		}
+		grumbles = append(grumbles, &g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating grumbles",
+			Err:     err,
+		}
+	}
+
+	if err := r.attachTags(ctx, grumbles); err != nil {
+		return nil, err
+	}
+
+	return grumbles, nil
+}
+
+// CountByAuthorStatus counts an author's grumbles, live or archived, per moderation status
+func (r *PostgresGrumbleRepository) CountByAuthorStatus(ctx context.Context, userID shared.UserID) (map[grumble.ModerationStatus]int, error) {
+	query := `
+		SELECT moderation_status, COUNT(*)
+		FROM (
+			SELECT moderation_status FROM grumbles WHERE user_id = $1
+			UNION ALL
+			SELECT moderation_status FROM grumbles_archive WHERE user_id = $1
+		) authored
+		GROUP BY moderation_status
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to count author grumbles",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	counts := make(map[grumble.ModerationStatus]int)
+	for rows.Next() {
+		var (
+			status grumble.ModerationStatus
+			count  int
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan author grumble count",
+				Err:     err,
+			}
+		}
+		counts[status] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating author grumble counts",
+			Err:     err,
+		}
+	}
+
+	return counts, nil
+}
+
 // FindTimeline retrieves grumbles for the timeline with filtering
 func (r *PostgresGrumbleRepository) FindTimeline(ctx context.Context, filter grumble.TimelineFilter) ([]*grumble.Grumble, error) {
 	args := []interface{}{}
 	baseQuery := `
 		SELECT g.grumble_id, g.user_id, g.content, g.toxic_level, g.vibe_count,
-		       g.purified_threshold, g.is_purified, g.posted_at, g.expires_at, g.is_event_grumble`
+		       g.purified_threshold, g.status, g.posted_at, g.expires_at, g.is_event_grumble,
+		       g.moderation_status, g.ai_toxic_level, g.edited_at, g.timezone, g.category, g.removed_from, g.lifetime`
 	if filter.ViewerUserID != nil {
 		baseQuery += fmt.Sprintf(", EXISTS (SELECT 1 FROM vibes v WHERE v.grumble_id = g.grumble_id AND v.user_id = $%d) AS has_vibed", len(args)+1)
 		args = append(args, string(*filter.ViewerUserID))
@@ -99,10 +405,15 @@ func (r *PostgresGrumbleRepository) FindTimeline(ctx context.Context, filter gru
 
 	query, args := buildTimelineFilter(baseQuery, filter, args)
 
+	if filter.After != nil {
+		query += fmt.Sprintf(" AND (posted_at, grumble_id) < ($%d, $%d)", len(args)+1, len(args)+2)
+		args = append(args, filter.After.PostedAt, string(filter.After.GrumbleID))
+	}
+
 	argIdx := len(args) + 1
 
-	// Order by most recent first
-	query += " ORDER BY posted_at DESC"
+	// Order by most recent first; grumble_id keeps grumbles posted at the same time in a stable order for cursors
+	query += " ORDER BY posted_at DESC, grumble_id DESC"
 
 	// Pagination
 	if filter.Limit > 0 {
@@ -111,12 +422,12 @@ func (r *PostgresGrumbleRepository) FindTimeline(ctx context.Context, filter gru
 		argIdx++
 	}
 
-	if filter.Offset > 0 {
+	if filter.Offset > 0 && filter.After == nil {
 		query += fmt.Sprintf(" OFFSET $%d", argIdx)
 		args = append(args, filter.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to query timeline",
@@ -133,8 +444,8 @@ func (r *PostgresGrumbleRepository) FindTimeline(ctx context.Context, filter gru
 		)
 		err := rows.Scan(
 			&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
-			&g.PurifiedThreshold, &g.IsPurified, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
-			&hasVibed,
+			&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime, &hasVibed,
 		)
 		if err != nil {
 			return nil, &shared.InternalError{
@@ -156,6 +467,10 @@ func (r *PostgresGrumbleRepository) FindTimeline(ctx context.Context, filter gru
 		}
 	}
 
+	if err := r.attachTags(ctx, grumbles); err != nil {
+		return nil, err
+	}
+
 	return grumbles, nil
 }
 
@@ -166,7 +481,7 @@ func (r *PostgresGrumbleRepository) CountTimeline(ctx context.Context, filter gr
 	query, args := buildTimelineFilter(baseQuery, filter, args)
 
 	var count int
-	err := r.db.QueryRow(ctx, query, args...).Scan(&count)
+	err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, &shared.InternalError{
 			Message: "failed to count timeline",
@@ -182,13 +497,14 @@ func (r *PostgresGrumbleRepository) Update(ctx context.Context, g *grumble.Grumb
 	query := `
 		UPDATE grumbles
 		SET content = $2, toxic_level = $3, vibe_count = $4,
-		    is_purified = $5, expires_at = $6, is_event_grumble = $7
+		    status = $5, expires_at = $6, is_event_grumble = $7,
+		    moderation_status = $8, ai_toxic_level = $9
 		WHERE grumble_id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query,
+	result, err := conn(ctx, r.db).Exec(ctx, query,
 		g.GrumbleID, g.Content, g.ToxicLevel, g.VibeCount,
-		g.IsPurified, g.ExpiresAt, g.IsEventGrumble,
+		g.Status, g.ExpiresAt, g.IsEventGrumble, g.ModerationStatus, g.AIToxicLevel,
 	)
 	if err != nil {
 		return &shared.InternalError{
@@ -207,10 +523,124 @@ func (r *PostgresGrumbleRepository) Update(ctx context.Context, g *grumble.Grumb
 	return nil
 }
 
-// ArchiveExpired moves expired grumbles to archive table and removes them from main table
+// SaveEdit stores an edited grumble and the revision it replaced, unless the grumble has received a vibe since it was read
+func (r *PostgresGrumbleRepository) SaveEdit(ctx context.Context, g *grumble.Grumble, revision *grumble.Revision) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to start transaction",
+			Err:     err,
+		}
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 「わかる…」が付いた後の編集は受け付けない
+	// 判定中の印を外し、編集後の本文がすぐに非同期モデレーションされるようにする
+	updateQuery := `
+		UPDATE grumbles
+		SET content = $2, toxic_level = $3, moderation_status = $4, ai_toxic_level = $5, edited_at = $6, status = $7,
+		    moderation_claimed_at = NULL
+		WHERE grumble_id = $1 AND vibe_count = 0
+	`
+	result, err := tx.Exec(ctx, updateQuery,
+		g.GrumbleID, g.Content, g.ToxicLevel, g.ModerationStatus, g.AIToxicLevel, g.EditedAt, g.Status,
+	)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to update edited grumble",
+			Err:     err,
+		}
+	}
+	if result.RowsAffected() == 0 {
+		return &shared.ConflictError{Message: "grumble can no longer be edited"}
+	}
+
+	insertQuery := `
+		INSERT INTO grumble_revisions (grumble_id, content, toxic_level, edited_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING revision_id
+	`
+	err = tx.QueryRow(ctx, insertQuery,
+		revision.GrumbleID, revision.Content, revision.ToxicLevel, revision.EditedAt,
+	).Scan(&revision.RevisionID)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to record grumble revision",
+			Err:     err,
+		}
+	}
+
+	// 本文のハッシュタグが変わるのでタグを付け直す
+	if err := saveTags(ctx, tx, g); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return &shared.InternalError{
+			Message: "failed to commit transaction",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// SaveStatus stores the moderation and lifecycle status of a grumble, whether live or already archived
+func (r *PostgresGrumbleRepository) SaveStatus(ctx context.Context, g *grumble.Grumble) error {
+	for _, table := range []string{"grumbles", "grumbles_archive"} {
+		query := "UPDATE " + table + " SET moderation_status = $2, status = $3, removed_from = $4 WHERE grumble_id = $1"
+		result, err := conn(ctx, r.db).Exec(ctx, query, g.GrumbleID, g.ModerationStatus, g.Status, g.RemovedFrom)
+		if err != nil {
+			return &shared.InternalError{
+				Message: "failed to update grumble moderation status",
+				Err:     err,
+			}
+		}
+		if result.RowsAffected() > 0 {
+			return nil
+		}
+	}
+
+	return &shared.NotFoundError{
+		Entity: "Grumble",
+		ID:     string(g.GrumbleID),
+	}
+}
+
+// notAwaitingAppealCondition keeps rejected drafts with a pending appeal out of the archive
+// so that an approved appeal can still publish them
+const notAwaitingAppealCondition = `NOT EXISTS (
+	SELECT 1 FROM moderation_appeals a
+	WHERE a.grumble_id = grumbles.grumble_id AND a.status = 'pending'
+)`
+
+// archiveVibesQuery copies vibes of the grumbles selected by the appended WHERE clause to vibes_archive; $1 is the archive time
+const archiveVibesQuery = `
+	INSERT INTO vibes_archive (vibe_id, grumble_id, user_id, vibe_type, voted_at, archived_at)
+	SELECT v.vibe_id, v.grumble_id, v.user_id, v.vibe_type, v.voted_at, $1
+	FROM vibes v
+	JOIN grumbles ON grumbles.grumble_id = v.grumble_id`
+
+// archiveTagsQuery copies tags of the grumbles selected by the appended WHERE clause to grumble_tags_archive; $1 is the archive time
+const archiveTagsQuery = `
+	INSERT INTO grumble_tags_archive (grumble_id, tag_id, source, position, archived_at)
+	SELECT gt.grumble_id, gt.tag_id, gt.source, gt.position, $1
+	FROM grumble_tags gt
+	JOIN grumbles ON grumbles.grumble_id = gt.grumble_id`
+
+// expiredStatusExpr is the lifecycle status a grumble is archived with when its lifetime ends, as decided by Status.ArchivedStatus
+var expiredStatusExpr = fmt.Sprintf(`CASE status WHEN '%s' THEN '%s' WHEN '%s' THEN '%s' ELSE status END`,
+	grumble.StatusActive, grumble.StatusActive.ArchivedStatus(),
+	grumble.StatusPending, grumble.StatusPending.ArchivedStatus(),
+)
+
+// ArchiveExpired moves expired grumbles, with their vibes and tags, to archive table and removes them from main table.
+// expires_at is an absolute instant, so grumbles from authors in different timezones are archived alike.
 func (r *PostgresGrumbleRepository) ArchiveExpired(ctx context.Context) (int, error) {
 	// トランザクション開始
-	tx, err := r.db.Begin(ctx)
+	tx, err := conn(ctx, r.db).Begin(ctx)
 	if err != nil {
 		return 0, &shared.InternalError{
 			Message: "failed to start transaction",
@@ -223,17 +653,40 @@ func (r *PostgresGrumbleRepository) ArchiveExpired(ctx context.Context) (int, er
 
 	now := time.Now()
 
-	// 1. 期限切れの投稿をアーカイブテーブルに挿入
+	// 1. 期限切れの投稿への「わかる…」とタグを退避（grumbles削除時のCASCADEで失われるため）
+	vibesQuery := archiveVibesQuery + `
+		WHERE grumbles.expires_at <= $2 AND ` + notAwaitingAppealCondition
+
+	if _, err := tx.Exec(ctx, vibesQuery, now, now); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to archive vibes of expired grumbles",
+			Err:     err,
+		}
+	}
+
+	// タグも同様に退避
+	tagsQuery := archiveTagsQuery + `
+		WHERE grumbles.expires_at <= $2 AND ` + notAwaitingAppealCondition
+
+	if _, err := tx.Exec(ctx, tagsQuery, now, now); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to archive tags of expired grumbles",
+			Err:     err,
+		}
+	}
+
+	// 2. 期限切れの投稿をアーカイブテーブルに挿入
 	insertQuery := `
 		INSERT INTO grumbles_archive
 			(grumble_id, user_id, content, toxic_level, vibe_count,
-			 purified_threshold, is_purified, posted_at, expires_at, is_event_grumble, archived_at)
+			 purified_threshold, status, posted_at, expires_at, is_event_grumble,
+			 moderation_status, ai_toxic_level, edited_at, timezone, posted_on, category, removed_from, lifetime, archived_at)
 		SELECT
 			grumble_id, user_id, content, toxic_level, vibe_count,
-			purified_threshold, is_purified, posted_at, expires_at, is_event_grumble, $1
+			purified_threshold, ` + expiredStatusExpr + `, posted_at, expires_at, is_event_grumble,
+			moderation_status, ai_toxic_level, edited_at, timezone, posted_on, category, removed_from, lifetime, $1
 		FROM grumbles
-		WHERE expires_at <= $2
-	`
+		WHERE expires_at <= $2 AND ` + notAwaitingAppealCondition
 
 	_, err = tx.Exec(ctx, insertQuery, now, now)
 	if err != nil {
@@ -243,8 +696,8 @@ func (r *PostgresGrumbleRepository) ArchiveExpired(ctx context.Context) (int, er
 		}
 	}
 
-	// 2. grumblesテーブルから削除
-	deleteQuery := "DELETE FROM grumbles WHERE expires_at <= $1"
+	// 3. grumblesテーブルから削除
+	deleteQuery := "DELETE FROM grumbles WHERE expires_at <= $1 AND " + notAwaitingAppealCondition
 	result, err := tx.Exec(ctx, deleteQuery, now)
 	if err != nil {
 		return 0, &shared.InternalError{
@@ -264,16 +717,105 @@ func (r *PostgresGrumbleRepository) ArchiveExpired(ctx context.Context) (int, er
 	return int(result.RowsAffected()), nil
 }
 
+// DeleteByAuthor moves a grumble its author deleted, with its vibes and tags, to the archive table
+func (r *PostgresGrumbleRepository) DeleteByAuthor(ctx context.Context, id shared.GrumbleID) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to start transaction",
+			Err:     err,
+		}
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	now := time.Now()
+
+	// 1. 「わかる…」とタグを退避（grumbles削除時のCASCADEで失われるため）
+	if _, err := tx.Exec(ctx, archiveVibesQuery+" WHERE grumbles.grumble_id = $2", now, id); err != nil {
+		return &shared.InternalError{
+			Message: "failed to archive vibes of deleted grumble",
+			Err:     err,
+		}
+	}
+	if _, err := tx.Exec(ctx, archiveTagsQuery+" WHERE grumbles.grumble_id = $2", now, id); err != nil {
+		return &shared.InternalError{
+			Message: "failed to archive tags of deleted grumble",
+			Err:     err,
+		}
+	}
+
+	// 2. 削除済みの状態でアーカイブテーブルに挿入
+	insertQuery := `
+		INSERT INTO grumbles_archive
+			(grumble_id, user_id, content, toxic_level, vibe_count,
+			 purified_threshold, status, posted_at, expires_at, is_event_grumble,
+			 moderation_status, ai_toxic_level, edited_at, timezone, posted_on, category, removed_from, lifetime, archived_at)
+		SELECT
+			grumble_id, user_id, content, toxic_level, vibe_count,
+			purified_threshold, $2, posted_at, expires_at, is_event_grumble,
+			moderation_status, ai_toxic_level, edited_at, timezone, posted_on, category, removed_from, lifetime, $1
+		FROM grumbles
+		WHERE grumble_id = $3
+	`
+	if _, err := tx.Exec(ctx, insertQuery, now, grumble.StatusDeleted, id); err != nil {
+		return &shared.InternalError{
+			Message: "failed to archive deleted grumble",
+			Err:     err,
+		}
+	}
+
+	// 3. grumblesテーブルから削除
+	result, err := tx.Exec(ctx, "DELETE FROM grumbles WHERE grumble_id = $1", id)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to delete grumble",
+			Err:     err,
+		}
+	}
+
+	// 既にアーカイブ済みなら状態だけを更新
+	if result.RowsAffected() == 0 {
+		result, err = tx.Exec(ctx, `
+			UPDATE grumbles_archive SET status = $1
+			WHERE grumble_id = $2 AND status <> $1
+		`, grumble.StatusDeleted, id)
+		if err != nil {
+			return &shared.InternalError{
+				Message: "failed to mark archived grumble as deleted",
+				Err:     err,
+			}
+		}
+		if result.RowsAffected() == 0 {
+			return &shared.NotFoundError{
+				Entity: "Grumble",
+				ID:     string(id),
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return &shared.InternalError{
+			Message: "failed to commit transaction",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
 // FindPurificationCandidates finds grumbles that meet purification threshold
 func (r *PostgresGrumbleRepository) FindPurificationCandidates(ctx context.Context, threshold int) ([]*grumble.Grumble, error) {
 	query := `
 		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
-		       purified_threshold, is_purified, posted_at, expires_at, is_event_grumble
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
 		FROM grumbles
-		WHERE is_purified = FALSE AND vibe_count >= purified_threshold
+		WHERE status = 'active' AND vibe_count >= purified_threshold
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := conn(ctx, r.db).Query(ctx, query)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to find purification candidates",
@@ -287,7 +829,8 @@ func (r *PostgresGrumbleRepository) FindPurificationCandidates(ctx context.Conte
 		var g grumble.Grumble
 		err := rows.Scan(
 			&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
-			&g.PurifiedThreshold, &g.IsPurified, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
 		)
 		if err != nil {
 			return nil, &shared.InternalError{
@@ -308,11 +851,96 @@ func (r *PostgresGrumbleRepository) FindPurificationCandidates(ctx context.Conte
 	return grumbles, nil
 }
 
+// ClaimPendingModeration claims unexpired grumbles awaiting asynchronous moderation, oldest first.
+// Rows locked by a concurrent claim are skipped, and a claim blocks other workers until the lease runs out.
+func (r *PostgresGrumbleRepository) ClaimPendingModeration(ctx context.Context, limit int, lease time.Duration) ([]*grumble.Grumble, error) {
+	query := `
+		UPDATE grumbles
+		SET moderation_claimed_at = $1
+		WHERE grumble_id IN (
+			SELECT grumble_id
+			FROM grumbles
+			WHERE status = 'pending' AND expires_at > $1
+			  AND (moderation_claimed_at IS NULL OR moderation_claimed_at < $2)
+			ORDER BY posted_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING grumble_id, user_id, content, toxic_level, vibe_count,
+		          purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		          moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+	`
+
+	now := time.Now()
+	rows, err := conn(ctx, r.db).Query(ctx, query, now, now.Add(-lease), limit)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to claim pending grumbles",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var grumbles []*grumble.Grumble
+	for rows.Next() {
+		var g grumble.Grumble
+		err := rows.Scan(
+			&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
+			&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
+		)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan grumble",
+				Err:     err,
+			}
+		}
+		grumbles = append(grumbles, &g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating pending grumbles",
+			Err:     err,
+		}
+	}
+
+	// RETURNING は順序を保証しないため、古い投稿から判定する
+	sort.Slice(grumbles, func(i, j int) bool { return grumbles[i].PostedAt.Before(grumbles[j].PostedAt) })
+
+	return grumbles, nil
+}
+
+// SaveModeration writes back only the columns moderation decides, and only while the grumble is still pending
+// with the content it was claimed with. An edit in the meantime leaves the new content pending for the next claim.
+func (r *PostgresGrumbleRepository) SaveModeration(ctx context.Context, g *grumble.Grumble) error {
+	query := `
+		UPDATE grumbles
+		SET toxic_level = $2, moderation_status = $3, ai_toxic_level = $4, status = $5, moderation_claimed_at = NULL
+		WHERE grumble_id = $1 AND status = 'pending' AND edited_at IS NOT DISTINCT FROM $6::timestamptz
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query,
+		g.GrumbleID, g.ToxicLevel, g.ModerationStatus, g.AIToxicLevel, g.Status, g.EditedAt,
+	)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to save moderation result",
+			Err:     err,
+		}
+	}
+	if result.RowsAffected() == 0 {
+		return &shared.ConflictError{Message: "grumble changed while it was being moderated"}
+	}
+
+	return nil
+}
+
 // IncrementVibeCount atomically increments the vibe count for a grumble
 func (r *PostgresGrumbleRepository) IncrementVibeCount(ctx context.Context, id shared.GrumbleID) error {
 	query := "UPDATE grumbles SET vibe_count = vibe_count + 1 WHERE grumble_id = $1"
 
-	result, err := r.db.Exec(ctx, query, id)
+	result, err := conn(ctx, r.db).Exec(ctx, query, id)
 	if err != nil {
 		return &shared.InternalError{
 			Message: "failed to increment vibe count",
@@ -330,48 +958,95 @@ func (r *PostgresGrumbleRepository) IncrementVibeCount(ctx context.Context, id s
 	return nil
 }
 
-// FindArchivedTimeline retrieves grumbles from archive table for a specific date
+// eventArchiveSource selects the published grumbles posted on date $1 for the event archive.
+// posted_on is the date in the author's own timezone, so each grumble belongs to its author's day.
+// Grumbles with a lifetime longer than the rest of the day are still live when the event starts,
+// so the live table is read alongside the archive; grumbles deleted by their author are left out.
+const eventArchiveSource = `(
+	SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+	       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+	       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+	FROM grumbles
+	WHERE posted_on = $1::date
+	  AND moderation_status = 'published'
+	  AND status IN ('active', 'purified')
+	UNION ALL
+	SELECT grumble_id, user_id, content, toxic_level, vibe_count,
+	       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+	       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+	FROM grumbles_archive
+	WHERE posted_on = $1::date
+	  AND moderation_status = 'published'
+	  AND status IN ('expired', 'purified')
+) event_archive`
+
+// archivedTaggedQuery selects the IDs of live or archived grumbles carrying the tag given by the %d placeholder
+const archivedTaggedQuery = `
+	SELECT gt.grumble_id FROM grumble_tags gt JOIN tags t ON t.tag_id = gt.tag_id WHERE t.name = $%[1]d
+	UNION ALL
+	SELECT gt.grumble_id FROM grumble_tags_archive gt JOIN tags t ON t.tag_id = gt.tag_id WHERE t.name = $%[1]d`
+
+// FindArchivedTimeline retrieves the grumbles posted on targetDate's calendar date, in each author's own timezone,
+// for the event archive, live or archived, excluding those deleted by their author
 func (r *PostgresGrumbleRepository) FindArchivedTimeline(
 	ctx context.Context,
 	filter grumble.TimelineFilter,
 	targetDate time.Time,
 ) ([]*grumble.Grumble, error) {
-	// 対象日の00:00〜23:59を取得
-	dayStart, dayEnd := r.eventTimeSvc.GetDayBounds(targetDate)
-
 	baseQuery := `
 		SELECT grumble_id, user_id, content, toxic_level, vibe_count,
-		       purified_threshold, is_purified, posted_at, expires_at, is_event_grumble
-		FROM grumbles_archive
-		WHERE posted_at >= $1 AND posted_at <= $2
+		       purified_threshold, status, posted_at, expires_at, is_event_grumble,
+		       moderation_status, ai_toxic_level, edited_at, timezone, category, removed_from, lifetime
+		FROM ` + eventArchiveSource + `
+		WHERE TRUE
 	`
 
-	args := []interface{}{dayStart, dayEnd}
-	argIdx := 3
+	// 投稿者のタイムゾーンでの投稿日で絞り込む
+	args := []interface{}{targetDate.Format(time.DateOnly)}
+	argIdx := 2
 
 	// フィルタ条件を追加
 	query := baseQuery
 
 	if filter.ToxicLevelMin != nil {
-		query += fmt.Sprintf(" AND toxic_level >= $%d", argIdx)
+		query += fmt.Sprintf(" AND %s >= $%d", toxicLevelColumn(filter.ToxicLevelSource), argIdx)
 		args = append(args, *filter.ToxicLevelMin)
 		argIdx++
 	}
 
 	if filter.ToxicLevelMax != nil {
-		query += fmt.Sprintf(" AND toxic_level <= $%d", argIdx)
+		query += fmt.Sprintf(" AND %s <= $%d", toxicLevelColumn(filter.ToxicLevelSource), argIdx)
 		args = append(args, *filter.ToxicLevelMax)
 		argIdx++
 	}
 
 	if filter.IsPurified != nil {
-		query += fmt.Sprintf(" AND is_purified = $%d", argIdx)
+		query += fmt.Sprintf(" AND (status = 'purified') = $%d", argIdx)
 		args = append(args, *filter.IsPurified)
 		argIdx++
 	}
 
-	// ソートとページネーション
-	query += " ORDER BY posted_at DESC"
+	if filter.Category != nil {
+		query += fmt.Sprintf(" AND category = $%d", argIdx)
+		args = append(args, string(*filter.Category))
+		argIdx++
+	}
+
+	if filter.Tag != nil {
+		query += fmt.Sprintf(" AND grumble_id IN ("+archivedTaggedQuery+")", argIdx)
+		args = append(args, *filter.Tag)
+		argIdx++
+	}
+
+	// カーソル以降の投稿に絞り込む（キーセットページネーション）
+	if filter.After != nil {
+		query += fmt.Sprintf(" AND (posted_at, grumble_id) < ($%d, $%d)", argIdx, argIdx+1)
+		args = append(args, filter.After.PostedAt, string(filter.After.GrumbleID))
+		argIdx += 2
+	}
+
+	// ソートとページネーション（同時刻の投稿は grumble_id で順序を固定）
+	query += " ORDER BY posted_at DESC, grumble_id DESC"
 
 	if filter.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT $%d", argIdx)
@@ -379,12 +1054,12 @@ func (r *PostgresGrumbleRepository) FindArchivedTimeline(
 		argIdx++
 	}
 
-	if filter.Offset > 0 {
+	if filter.Offset > 0 && filter.After == nil {
 		query += fmt.Sprintf(" OFFSET $%d", argIdx)
 		args = append(args, filter.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to query archived timeline",
@@ -398,7 +1073,8 @@ func (r *PostgresGrumbleRepository) FindArchivedTimeline(
 		var g grumble.Grumble
 		err := rows.Scan(
 			&g.GrumbleID, &g.UserID, &g.Content, &g.ToxicLevel, &g.VibeCount,
-			&g.PurifiedThreshold, &g.IsPurified, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.PurifiedThreshold, &g.Status, &g.PostedAt, &g.ExpiresAt, &g.IsEventGrumble,
+			&g.ModerationStatus, &g.AIToxicLevel, &g.EditedAt, &g.Timezone, &g.Category, &g.RemovedFrom, &g.Lifetime,
 		)
 		if err != nil {
 			return nil, &shared.InternalError{
@@ -416,46 +1092,61 @@ func (r *PostgresGrumbleRepository) FindArchivedTimeline(
 		}
 	}
 
+	if err := r.attachTags(ctx, grumbles); err != nil {
+		return nil, err
+	}
+
 	return grumbles, nil
 }
 
-// CountArchivedTimeline counts archived grumbles for a specific date
+// CountArchivedTimeline counts the grumbles in the event archive for a specific date
 func (r *PostgresGrumbleRepository) CountArchivedTimeline(
 	ctx context.Context,
 	filter grumble.TimelineFilter,
 	targetDate time.Time,
 ) (int, error) {
-	dayStart, dayEnd := r.eventTimeSvc.GetDayBounds(targetDate)
-
 	query := `
 		SELECT COUNT(*)
-		FROM grumbles_archive
-		WHERE posted_at >= $1 AND posted_at <= $2
+		FROM ` + eventArchiveSource + `
+		WHERE TRUE
 	`
 
-	args := []interface{}{dayStart, dayEnd}
-	argIdx := 3
+	// 投稿者のタイムゾーンでの投稿日で絞り込む
+	args := []interface{}{targetDate.Format(time.DateOnly)}
+	argIdx := 2
 
 	if filter.ToxicLevelMin != nil {
-		query += fmt.Sprintf(" AND toxic_level >= $%d", argIdx)
+		query += fmt.Sprintf(" AND %s >= $%d", toxicLevelColumn(filter.ToxicLevelSource), argIdx)
 		args = append(args, *filter.ToxicLevelMin)
 		argIdx++
 	}
 
 	if filter.ToxicLevelMax != nil {
-		query += fmt.Sprintf(" AND toxic_level <= $%d", argIdx)
+		query += fmt.Sprintf(" AND %s <= $%d", toxicLevelColumn(filter.ToxicLevelSource), argIdx)
 		args = append(args, *filter.ToxicLevelMax)
 		argIdx++
 	}
 
 	if filter.IsPurified != nil {
-		query += fmt.Sprintf(" AND is_purified = $%d", argIdx)
+		query += fmt.Sprintf(" AND (status = 'purified') = $%d", argIdx)
 		args = append(args, *filter.IsPurified)
 		argIdx++
 	}
 
+	if filter.Category != nil {
+		query += fmt.Sprintf(" AND category = $%d", argIdx)
+		args = append(args, string(*filter.Category))
+		argIdx++
+	}
+
+	if filter.Tag != nil {
+		query += fmt.Sprintf(" AND grumble_id IN ("+archivedTaggedQuery+")", argIdx)
+		args = append(args, *filter.Tag)
+		argIdx++
+	}
+
 	var count int
-	err := r.db.QueryRow(ctx, query, args...).Scan(&count)
+	err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, &shared.InternalError{
 			Message: "failed to count archived timeline",
@@ -466,17 +1157,24 @@ func (r *PostgresGrumbleRepository) CountArchivedTimeline(
 	return count, nil
 }
 
-// Stats aggregates grumble counts per bucket from the grumble_stats view.
-func (r *PostgresGrumbleRepository) Stats(ctx context.Context, granularity grumble.Granularity, from, to time.Time) ([]grumble.StatsRow, error) {
+// Stats aggregates grumble counts per bucket from the grumble_stats view, which covers published grumbles live or archived.
+// The view breaks buckets down by category, so the categories are summed up unless one is requested.
+func (r *PostgresGrumbleRepository) Stats(ctx context.Context, granularity grumble.Granularity, from, to time.Time, category *grumble.Category) ([]grumble.StatsRow, error) {
 	query := `
-		SELECT bucket, purified_count, unpurified_count, total_vibes
+		SELECT bucket, SUM(purified_count)::bigint, SUM(unpurified_count)::bigint, SUM(total_vibes)::bigint
 		FROM grumble_stats
 		WHERE granularity = $1
 		  AND bucket >= $2 AND bucket < $3
-		ORDER BY bucket DESC
 	`
 
-	rows, err := r.db.Query(ctx, query, granularity, from, to)
+	args := []interface{}{granularity, from, to}
+	if category != nil {
+		query += " AND category = $4"
+		args = append(args, string(*category))
+	}
+	query += " GROUP BY bucket ORDER BY bucket DESC"
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to query grumble stats",
@@ -508,9 +1206,10 @@ func (r *PostgresGrumbleRepository) Stats(ctx context.Context, granularity grumb
 }
 
 // StatsByToxic aggregates grumble counts per bucket and toxic level from grumble_stats_toxic view.
-func (r *PostgresGrumbleRepository) StatsByToxic(ctx context.Context, granularity grumble.Granularity, from, to time.Time, toxicLevel *int) ([]grumble.StatsRow, error) {
+// The view also breaks buckets down by category, so the categories are summed up unless one is requested.
+func (r *PostgresGrumbleRepository) StatsByToxic(ctx context.Context, granularity grumble.Granularity, from, to time.Time, toxicLevel *int, category *grumble.Category) ([]grumble.StatsRow, error) {
 	baseQuery := `
-		SELECT bucket, toxic_level, purified_count, unpurified_count, total_vibes
+		SELECT bucket, toxic_level, SUM(purified_count)::bigint, SUM(unpurified_count)::bigint, SUM(total_vibes)::bigint
 		FROM grumble_stats_toxic
 		WHERE granularity = $1
 		  AND bucket >= $2 AND bucket < $3
@@ -518,12 +1217,16 @@ func (r *PostgresGrumbleRepository) StatsByToxic(ctx context.Context, granularit
 
 	args := []interface{}{granularity, from, to}
 	if toxicLevel != nil {
-		baseQuery += " AND toxic_level = $4"
+		baseQuery += fmt.Sprintf(" AND toxic_level = $%d", len(args)+1)
 		args = append(args, *toxicLevel)
 	}
-	baseQuery += " ORDER BY bucket DESC, toxic_level"
+	if category != nil {
+		baseQuery += fmt.Sprintf(" AND category = $%d", len(args)+1)
+		args = append(args, string(*category))
+	}
+	baseQuery += " GROUP BY bucket, toxic_level ORDER BY bucket DESC, toxic_level"
 
-	rows, err := r.db.Query(ctx, baseQuery, args...)
+	rows, err := conn(ctx, r.db).Query(ctx, baseQuery, args...)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to query grumble stats by toxic level",
@@ -556,6 +1259,54 @@ func (r *PostgresGrumbleRepository) StatsByToxic(ctx context.Context, granularit
 	return result, nil
 }
 
+// StatsByCategory aggregates grumble counts per bucket and category from the grumble_stats view.
+// Grumbles without a category form their own row with a nil Category.
+func (r *PostgresGrumbleRepository) StatsByCategory(ctx context.Context, granularity grumble.Granularity, from, to time.Time, category *grumble.Category) ([]grumble.StatsRow, error) {
+	baseQuery := `
+		SELECT bucket, category, purified_count, unpurified_count, total_vibes
+		FROM grumble_stats
+		WHERE granularity = $1
+		  AND bucket >= $2 AND bucket < $3
+	`
+
+	args := []interface{}{granularity, from, to}
+	if category != nil {
+		baseQuery += " AND category = $4"
+		args = append(args, string(*category))
+	}
+	baseQuery += " ORDER BY bucket DESC, category NULLS LAST"
+
+	rows, err := conn(ctx, r.db).Query(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query grumble stats by category",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var result []grumble.StatsRow
+	for rows.Next() {
+		var row grumble.StatsRow
+		if err := rows.Scan(&row.Bucket, &row.Category, &row.PurifiedCount, &row.UnpurifiedCount, &row.TotalVibes); err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan grumble stats by category",
+				Err:     err,
+			}
+		}
+		result = append(result, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating grumble stats by category",
+			Err:     err,
+		}
+	}
+
+	return result, nil
+}
+
 func buildTimelineFilter(base string, filter grumble.TimelineFilter, args []interface{}) (string, []interface{}) {
 
 	query := base
@@ -569,21 +1320,43 @@ func buildTimelineFilter(base string, filter grumble.TimelineFilter, args []inte
 		addCondition(" AND user_id = $%d", string(*filter.UserID))
 	}
 
-	if filter.IsPurified != nil {
-		addCondition(" AND is_purified = $%d", *filter.IsPurified)
+	// The timeline lists active and purified grumbles until the archive job moves them out as expired.
+	// Pending, held and shadowed grumbles are only visible to their author; removed ones to no one.
+	if filter.ViewerUserID != nil {
+		addCondition(" AND (status IN ('active', 'purified') AND moderation_status = 'published'"+
+			" OR user_id = $%d AND status IN ('pending', 'active', 'purified'))", string(*filter.ViewerUserID))
+	} else {
+		query += " AND status IN ('active', 'purified') AND moderation_status = 'published'"
 	}
 
-	if filter.ExcludeExpired {
-		addCondition(" AND expires_at > $%d", time.Now())
+	if filter.IsPurified != nil {
+		addCondition(" AND (status = 'purified') = $%d", *filter.IsPurified)
 	}
 
 	if filter.ToxicLevelMin != nil {
-		addCondition(" AND toxic_level >= $%d", *filter.ToxicLevelMin)
+		addCondition(" AND "+toxicLevelColumn(filter.ToxicLevelSource)+" >= $%d", *filter.ToxicLevelMin)
 	}
 
 	if filter.ToxicLevelMax != nil {
-		addCondition(" AND toxic_level <= $%d", *filter.ToxicLevelMax)
+		addCondition(" AND "+toxicLevelColumn(filter.ToxicLevelSource)+" <= $%d", *filter.ToxicLevelMax)
+	}
+
+	if filter.Category != nil {
+		addCondition(" AND category = $%d", string(*filter.Category))
+	}
+
+	if filter.Tag != nil {
+		addCondition(" AND grumble_id IN (SELECT gt.grumble_id FROM grumble_tags gt JOIN tags t ON t.tag_id = gt.tag_id WHERE t.name = $%d)", *filter.Tag)
 	}
 
 	return query, args
 }
+
+// toxicLevelColumn returns the SQL expression toxic level filters compare against.
+// Grumbles without an AI estimate fall back to the self-reported level.
+func toxicLevelColumn(source grumble.ToxicLevelSource) string {
+	if source == grumble.ToxicLevelSourceAI {
+		return "COALESCE(ai_toxic_level, toxic_level)"
+	}
+	return "toxic_level"
+}
@@ -0,0 +1,129 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// LLMProvider sends a prompt to a text generation backend and returns its raw reply
+type LLMProvider interface {
+	Generate(ctx context.Context, prompt string) (string, error)
+	Model() string
+}
+
+// LLMContentFilter implements grumble.ContentFilterClient, grumble.RewriteSuggester and grumble.TopicSuggester on top of an LLMProvider
+type LLMContentFilter struct {
+	provider LLMProvider
+}
+
+// NewLLMContentFilter creates a new content filter backed by provider
+func NewLLMContentFilter(provider LLMProvider) *LLMContentFilter {
+	return &LLMContentFilter{provider: provider}
+}
+
+// FilterContent implements grumble.ContentFilterClient
+func (f *LLMContentFilter) FilterContent(ctx context.Context, req grumble.ModerationRequest) (*grumble.ModerationResult, error) {
+	responseText, err := f.generateJSON(ctx, req.Prompt.Render(req.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal and validate
+	var moderationResult grumble.ModerationResult
+	if err := json.Unmarshal([]byte(responseText), &moderationResult); err != nil {
+		return nil, &shared.InternalError{
+			Message: fmt.Sprintf("failed to parse moderation response: %s", responseText),
+			Err:     err,
+		}
+	}
+
+	// Validate result
+	if moderationResult.Reason == "" {
+		return nil, &shared.InternalError{
+			Message: fmt.Sprintf("invalid moderation result: reason is empty. Response: %s", responseText),
+		}
+	}
+
+	moderationResult.Model = f.provider.Model()
+	moderationResult.PromptVersion = req.Prompt.Version
+
+	return &moderationResult, nil
+}
+
+// SuggestRewrite implements grumble.RewriteSuggester
+func (f *LLMContentFilter) SuggestRewrite(ctx context.Context, content string, result *grumble.ModerationResult) (string, error) {
+	responseText, err := f.generateJSON(ctx, fmt.Sprintf(grumble.ContentRewritePrompt, result.Reason, content))
+	if err != nil {
+		return "", err
+	}
+
+	var suggestion grumble.RewriteSuggestion
+	if err := json.Unmarshal([]byte(responseText), &suggestion); err != nil {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("failed to parse rewrite response: %s", responseText),
+			Err:     err,
+		}
+	}
+
+	rewrite := strings.TrimSpace(suggestion.Rewrite)
+	if rewrite == "" {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("invalid rewrite suggestion: rewrite is empty. Response: %s", responseText),
+		}
+	}
+
+	return rewrite, nil
+}
+
+// SuggestTopic implements grumble.TopicSuggester
+func (f *LLMContentFilter) SuggestTopic(ctx context.Context, content string) (string, error) {
+	responseText, err := f.generateJSON(ctx, fmt.Sprintf(grumble.TopicSuggestionPrompt, content))
+	if err != nil {
+		return "", err
+	}
+
+	var suggestion grumble.TopicSuggestion
+	if err := json.Unmarshal([]byte(responseText), &suggestion); err != nil {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("failed to parse topic response: %s", responseText),
+			Err:     err,
+		}
+	}
+
+	topic := strings.TrimSpace(suggestion.Topic)
+	if topic == "" {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("invalid topic suggestion: topic is empty. Response: %s", responseText),
+		}
+	}
+
+	return topic, nil
+}
+
+// generateJSON sends prompt to the provider and returns the response with any markdown fences removed
+func (f *LLMContentFilter) generateJSON(ctx context.Context, prompt string) (string, error) {
+	responseText, err := f.provider.Generate(ctx, prompt)
+	if err != nil {
+		return "", err
+	}
+
+	// Clean up response text (remove markdown code blocks if present)
+	responseText = strings.TrimSpace(responseText)
+	responseText = strings.TrimPrefix(responseText, "```json")
+	responseText = strings.TrimPrefix(responseText, "```")
+	responseText = strings.TrimSuffix(responseText, "```")
+	responseText = strings.TrimSpace(responseText)
+
+	if responseText == "" {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("empty response from %s", f.provider.Model()),
+		}
+	}
+
+	return responseText, nil
+}
@@ -0,0 +1,181 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresModerationAppealRepository implements moderation.AppealRepository using PostgreSQL
+type PostgresModerationAppealRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresModerationAppealRepository creates a new PostgresModerationAppealRepository
+func NewPostgresModerationAppealRepository(db *pgxpool.Pool) *PostgresModerationAppealRepository {
+	return &PostgresModerationAppealRepository{db: db}
+}
+
+const appealColumns = `
+	appeal_id, grumble_id, user_id, verdict_id, status, content, rejection_reason, created_at,
+	resolved_by, resolved_at, resolution_note`
+
+// Create files a new appeal; a grumble can be appealed only once
+func (r *PostgresModerationAppealRepository) Create(ctx context.Context, a *moderation.Appeal) error {
+	query := `
+		INSERT INTO moderation_appeals (
+			grumble_id, user_id, verdict_id, status, content, rejection_reason, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING appeal_id
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		a.GrumbleID, a.UserID, a.VerdictID, a.Status, a.Content, a.RejectionReason, a.CreatedAt,
+	).Scan(&a.AppealID)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return &shared.ConflictError{Message: fmt.Sprintf("grumble %s has already been appealed", a.GrumbleID)}
+		}
+		return &shared.InternalError{
+			Message: "failed to create moderation appeal",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// FindByID retrieves an appeal by its ID
+func (r *PostgresModerationAppealRepository) FindByID(ctx context.Context, id moderation.AppealID) (*moderation.Appeal, error) {
+	query := "SELECT " + appealColumns + " FROM moderation_appeals WHERE appeal_id = $1"
+
+	a, err := scanAppeal(conn(ctx, r.db).QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "ModerationAppeal",
+			ID:     strconv.FormatInt(int64(id), 10),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find moderation appeal",
+			Err:     err,
+		}
+	}
+
+	return a, nil
+}
+
+// List returns appeals matching the filter, oldest first
+func (r *PostgresModerationAppealRepository) List(ctx context.Context, filter moderation.AppealFilter) ([]*moderation.Appeal, error) {
+	query, args := buildAppealFilter("SELECT "+appealColumns+" FROM moderation_appeals WHERE 1=1", filter)
+
+	query += " ORDER BY created_at ASC, appeal_id ASC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, filter.Offset)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query moderation appeals",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var appeals []*moderation.Appeal
+	for rows.Next() {
+		a, err := scanAppeal(rows)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan moderation appeal",
+				Err:     err,
+			}
+		}
+		appeals = append(appeals, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating moderation appeals",
+			Err:     err,
+		}
+	}
+
+	return appeals, nil
+}
+
+// Count returns the number of appeals matching the filter
+func (r *PostgresModerationAppealRepository) Count(ctx context.Context, filter moderation.AppealFilter) (int, error) {
+	query, args := buildAppealFilter("SELECT COUNT(*) FROM moderation_appeals WHERE 1=1", filter)
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count moderation appeals",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+// UpdateResolution stores the resolution of a pending appeal.
+// The pending guard makes concurrent resolutions of the same appeal fail with a conflict.
+func (r *PostgresModerationAppealRepository) UpdateResolution(ctx context.Context, a *moderation.Appeal) error {
+	query := `
+		UPDATE moderation_appeals
+		SET status = $2, resolved_by = $3, resolved_at = $4, resolution_note = $5
+		WHERE appeal_id = $1 AND status = 'pending'
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, a.AppealID, a.Status, a.ResolvedBy, a.ResolvedAt, a.ResolutionNote)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to update moderation appeal",
+			Err:     err,
+		}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &shared.ConflictError{Message: "appeal has already been resolved"}
+	}
+
+	return nil
+}
+
+func scanAppeal(row pgx.Row) (*moderation.Appeal, error) {
+	var a moderation.Appeal
+	err := row.Scan(
+		&a.AppealID, &a.GrumbleID, &a.UserID, &a.VerdictID, &a.Status, &a.Content, &a.RejectionReason, &a.CreatedAt,
+		&a.ResolvedBy, &a.ResolvedAt, &a.ResolutionNote,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func buildAppealFilter(base string, filter moderation.AppealFilter) (string, []interface{}) {
+	query := base
+	args := []interface{}{}
+
+	if filter.Status != nil {
+		query += fmt.Sprintf(" AND status = $%d", len(args)+1)
+		args = append(args, string(*filter.Status))
+	}
+
+	return query, args
+}
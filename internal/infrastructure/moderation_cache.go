@@ -0,0 +1,166 @@
+package infrastructure
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// CachingContentFilter decorates a ContentFilterClient with an in-process LRU cache
+// keyed by normalised content hash, prompt version and model, optionally backed by a persistent store.
+// Rejections are cached as well so repeated spam never reaches the LLM twice.
+type CachingContentFilter struct {
+	inner    grumble.ContentFilterClient
+	model    string                       // Provider and model behind inner
+	store    grumble.ModerationCacheStore // optional
+	capacity int
+	ttl      time.Duration
+	logger   logging.Logger
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front = most recently used
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type moderationCacheEntry struct {
+	key       string
+	result    *grumble.ModerationResult
+	expiresAt time.Time
+}
+
+// NewCachingContentFilter wraps inner with a cache holding up to capacity results for ttl.
+// model names the provider and model behind inner; store may be nil to keep the cache in-process only.
+func NewCachingContentFilter(
+	inner grumble.ContentFilterClient,
+	model string,
+	store grumble.ModerationCacheStore,
+	capacity int,
+	ttl time.Duration,
+	logger logging.Logger,
+) *CachingContentFilter {
+	return &CachingContentFilter{
+		inner:    inner,
+		model:    model,
+		store:    store,
+		capacity: capacity,
+		ttl:      ttl,
+		logger:   logger,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// FilterContent implements grumble.ContentFilterClient
+func (c *CachingContentFilter) FilterContent(ctx context.Context, req grumble.ModerationRequest) (*grumble.ModerationResult, error) {
+	key := grumble.ModerationCacheKey(req.Content, req.Prompt.Version, c.model)
+	now := time.Now()
+
+	if result, ok := c.get(key, now); ok {
+		c.hits.Add(1)
+		return result, nil
+	}
+
+	if c.store != nil {
+		result, expiresAt, err := c.store.Get(ctx, key)
+		if err != nil {
+			// The cache must never block moderation; fall through to the LLM
+			c.logger.WarnContext(ctx, "Failed to read moderation cache store", "error", err)
+		} else if result != nil {
+			c.hits.Add(1)
+			c.put(key, result, expiresAt)
+			return copyModerationResult(result), nil
+		}
+	}
+
+	c.misses.Add(1)
+	result, err := c.inner.FilterContent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(c.ttl)
+	c.put(key, result, expiresAt)
+	if c.store != nil {
+		if err := c.store.Put(ctx, key, result, expiresAt); err != nil {
+			c.logger.WarnContext(ctx, "Failed to write moderation cache store", "error", err)
+		}
+	}
+
+	return result, nil
+}
+
+// Stats reports cache hits, misses and the number of in-process entries
+func (c *CachingContentFilter) Stats() grumble.ModerationCacheStats {
+	c.mu.Lock()
+	entries := c.order.Len()
+	c.mu.Unlock()
+
+	return grumble.ModerationCacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: entries,
+	}
+}
+
+func (c *CachingContentFilter) get(key string, now time.Time) (*grumble.ModerationResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*moderationCacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return copyModerationResult(entry.result), true
+}
+
+func (c *CachingContentFilter) put(key string, result *grumble.ModerationResult, expiresAt time.Time) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*moderationCacheEntry)
+		entry.result = copyModerationResult(result)
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&moderationCacheEntry{
+		key:       key,
+		result:    copyModerationResult(result),
+		expiresAt: expiresAt,
+	})
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*moderationCacheEntry).key)
+	}
+}
+
+// copyModerationResult keeps callers from mutating cached entries
+func copyModerationResult(r *grumble.ModerationResult) *grumble.ModerationResult {
+	cp := *r
+	cp.Categories = append([]grumble.ModerationCategory(nil), r.Categories...)
+	return &cp
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresModerationCacheStore implements grumble.ModerationCacheStore using PostgreSQL
+type PostgresModerationCacheStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresModerationCacheStore creates a new PostgresModerationCacheStore
+func NewPostgresModerationCacheStore(db *pgxpool.Pool) *PostgresModerationCacheStore {
+	return &PostgresModerationCacheStore{db: db}
+}
+
+// Get returns the cached result and its expiry, or nil when absent or expired
+func (s *PostgresModerationCacheStore) Get(ctx context.Context, key string) (*grumble.ModerationResult, time.Time, error) {
+	query := `
+		SELECT is_appropriate, categories, estimated_toxic_level, reason, model, prompt_version, expires_at
+		FROM moderation_cache
+		WHERE cache_key = $1 AND expires_at > $2
+	`
+
+	var (
+		result     grumble.ModerationResult
+		categories []string
+		expiresAt  time.Time
+	)
+	err := s.db.QueryRow(ctx, query, key, time.Now()).Scan(
+		&result.IsAppropriate, &categories, &result.EstimatedToxicLevel, &result.Reason, &result.Model, &result.PromptVersion, &expiresAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, &shared.InternalError{
+			Message: "failed to read moderation cache",
+			Err:     err,
+		}
+	}
+
+	result.Categories = make([]grumble.ModerationCategory, len(categories))
+	for i, c := range categories {
+		result.Categories[i] = grumble.ModerationCategory(c)
+	}
+
+	return &result, expiresAt, nil
+}
+
+// Put stores a result until expiresAt, replacing any existing entry
+func (s *PostgresModerationCacheStore) Put(ctx context.Context, key string, result *grumble.ModerationResult, expiresAt time.Time) error {
+	query := `
+		INSERT INTO moderation_cache (cache_key, is_appropriate, categories, estimated_toxic_level, reason, model, prompt_version, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (cache_key) DO UPDATE
+		SET is_appropriate = EXCLUDED.is_appropriate,
+		    categories = EXCLUDED.categories,
+		    estimated_toxic_level = EXCLUDED.estimated_toxic_level,
+		    reason = EXCLUDED.reason,
+		    model = EXCLUDED.model,
+		    prompt_version = EXCLUDED.prompt_version,
+		    expires_at = EXCLUDED.expires_at
+	`
+
+	_, err := s.db.Exec(ctx, query,
+		key, result.IsAppropriate, categoriesToStrings(result.Categories), result.EstimatedToxicLevel, result.Reason,
+		result.Model, result.PromptVersion, expiresAt,
+	)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to write moderation cache",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpired removes entries past their expiry
+func (s *PostgresModerationCacheStore) DeleteExpired(ctx context.Context) (int, error) {
+	result, err := s.db.Exec(ctx, "DELETE FROM moderation_cache WHERE expires_at <= $1", time.Now())
+	if err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to prune moderation cache",
+			Err:     err,
+		}
+	}
+
+	return int(result.RowsAffected()), nil
+}
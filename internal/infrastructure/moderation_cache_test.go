@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+)
+
+// countingFilter counts calls that reach the underlying moderation backend.
+type countingFilter struct {
+	calls  int
+	result grumble.ModerationResult
+}
+
+func (f *countingFilter) FilterContent(_ context.Context, _ grumble.ModerationRequest) (*grumble.ModerationResult, error) {
+	f.calls++
+	r := f.result
+	return &r, nil
+}
+
+var testPrompt = grumble.ModerationPrompt{Version: "v1", Template: "moderate: " + grumble.ModerationPromptPlaceholder}
+
+func moderationRequest(content string) grumble.ModerationRequest {
+	return grumble.ModerationRequest{Content: content, Prompt: testPrompt}
+}
+
+func newTestCache(inner grumble.ContentFilterClient, capacity int, ttl time.Duration) *CachingContentFilter {
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	return NewCachingContentFilter(inner, "test-model", nil, capacity, ttl, logger)
+}
+
+func TestCachingContentFilter_FilterContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  []string
+		capacity  int
+		wantCalls int
+	}{
+		{"同一内容は2回目以降キャッシュから返す", []string{"月曜日つらい", "月曜日つらい", "月曜日つらい"}, 10, 1},
+		{"前後の空白と連続空白は同一視する", []string{"月曜日 つらい", "  月曜日   つらい\n"}, 10, 1},
+		{"異なる内容はそれぞれ判定する", []string{"月曜日つらい", "火曜日もつらい"}, 10, 2},
+		{"容量を超えると古いものから追い出す", []string{"a", "b", "a"}, 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &countingFilter{result: grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}}
+			cache := newTestCache(inner, tt.capacity, time.Hour)
+
+			for _, content := range tt.contents {
+				if _, err := cache.FilterContent(context.Background(), moderationRequest(content)); err != nil {
+					t.Fatalf("FilterContent() error = %v", err)
+				}
+			}
+
+			if inner.calls != tt.wantCalls {
+				t.Errorf("inner calls = %d, want %d", inner.calls, tt.wantCalls)
+			}
+			stats := cache.Stats()
+			if stats.Misses != int64(tt.wantCalls) || stats.Hits != int64(len(tt.contents)-tt.wantCalls) {
+				t.Errorf("Stats() = %+v, want %d misses", stats, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCachingContentFilter_ServesCachedRejection(t *testing.T) {
+	inner := &countingFilter{result: grumble.ModerationResult{
+		Categories: []grumble.ModerationCategory{grumble.ModerationCategoryHarassment},
+		Reason:     "誹謗中傷",
+	}}
+	cache := newTestCache(inner, 10, time.Hour)
+
+	first, _ := cache.FilterContent(context.Background(), moderationRequest("spam"))
+	first.Categories[0] = grumble.ModerationCategoryIllegal // callers must not corrupt the cache
+
+	second, err := cache.FilterContent(context.Background(), moderationRequest("spam"))
+	if err != nil {
+		t.Fatalf("FilterContent() error = %v", err)
+	}
+	if inner.calls != 1 {
+		t.Errorf("inner calls = %d, want 1", inner.calls)
+	}
+	if second.IsAppropriate || !second.HasCategory(grumble.ModerationCategoryHarassment) {
+		t.Errorf("cached result = %+v, want harassment rejection", second)
+	}
+}
+
+func TestCachingContentFilter_ExpiresAfterTTL(t *testing.T) {
+	inner := &countingFilter{result: grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}}
+	cache := newTestCache(inner, 10, -time.Second)
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.FilterContent(context.Background(), moderationRequest("月曜日つらい")); err != nil {
+			t.Fatalf("FilterContent() error = %v", err)
+		}
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("inner calls = %d, want 2", inner.calls)
+	}
+}
+
+// mapCacheStore is an in-memory ModerationCacheStore shared between cache instances.
+type mapCacheStore struct {
+	grumble.ModerationCacheStore
+	results map[string]*grumble.ModerationResult
+}
+
+func (s *mapCacheStore) Get(_ context.Context, key string) (*grumble.ModerationResult, time.Time, error) {
+	return s.results[key], time.Now().Add(time.Hour), nil
+}
+
+func (s *mapCacheStore) Put(_ context.Context, key string, result *grumble.ModerationResult, _ time.Time) error {
+	s.results[key] = result
+	return nil
+}
+
+func TestCachingContentFilter_ModelChangeMissesStore(t *testing.T) {
+	store := &mapCacheStore{results: make(map[string]*grumble.ModerationResult)}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	inner := &countingFilter{result: grumble.ModerationResult{IsAppropriate: true}}
+
+	for _, model := range []string{"gemini/gemini-2.0-flash", "gemini/gemini-2.0-flash", "openai/gpt-4o-mini"} {
+		cache := NewCachingContentFilter(inner, model, store, 10, time.Hour, logger)
+		if _, err := cache.FilterContent(context.Background(), moderationRequest("月曜日つらい")); err != nil {
+			t.Fatalf("FilterContent() error = %v", err)
+		}
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("inner calls = %d, want 2 (one per model)", inner.calls)
+	}
+}
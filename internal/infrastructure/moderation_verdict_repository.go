@@ -0,0 +1,271 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresModerationVerdictRepository implements moderation.VerdictRepository using PostgreSQL
+type PostgresModerationVerdictRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresModerationVerdictRepository creates a new PostgresModerationVerdictRepository
+func NewPostgresModerationVerdictRepository(db *pgxpool.Pool) *PostgresModerationVerdictRepository {
+	return &PostgresModerationVerdictRepository{db: db}
+}
+
+const verdictColumns = `
+	verdict_id, content_hash, user_id, grumble_id, decision, categories, reason,
+	model, prompt_version, latency_ms, created_at,
+	review_label, review_note, reviewed_by, reviewed_at`
+
+// Create appends a verdict to the log
+func (r *PostgresModerationVerdictRepository) Create(ctx context.Context, v *moderation.Verdict) error {
+	query := `
+		INSERT INTO moderation_verdicts (
+			content_hash, user_id, grumble_id, decision, categories, reason,
+			model, prompt_version, latency_ms, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING verdict_id
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		v.ContentHash, v.UserID, v.GrumbleID, v.Decision, categoriesToStrings(v.Categories), v.Reason,
+		v.Model, v.PromptVersion, v.Latency.Milliseconds(), v.CreatedAt,
+	).Scan(&v.VerdictID)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to create moderation verdict",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// FindByID retrieves a verdict by its ID
+func (r *PostgresModerationVerdictRepository) FindByID(ctx context.Context, id moderation.VerdictID) (*moderation.Verdict, error) {
+	query := "SELECT " + verdictColumns + " FROM moderation_verdicts WHERE verdict_id = $1"
+
+	v, err := scanVerdict(conn(ctx, r.db).QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "ModerationVerdict",
+			ID:     strconv.FormatInt(int64(id), 10),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find moderation verdict",
+			Err:     err,
+		}
+	}
+
+	return v, nil
+}
+
+// FindLatestByGrumble retrieves the most recent verdict recorded for a grumble
+func (r *PostgresModerationVerdictRepository) FindLatestByGrumble(ctx context.Context, grumbleID shared.GrumbleID) (*moderation.Verdict, error) {
+	query := "SELECT " + verdictColumns + " FROM moderation_verdicts WHERE grumble_id = $1 ORDER BY created_at DESC, verdict_id DESC LIMIT 1"
+
+	v, err := scanVerdict(conn(ctx, r.db).QueryRow(ctx, query, grumbleID))
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "ModerationVerdict",
+			ID:     string(grumbleID),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find moderation verdict",
+			Err:     err,
+		}
+	}
+
+	return v, nil
+}
+
+// List returns verdicts matching the filter, newest first
+func (r *PostgresModerationVerdictRepository) List(ctx context.Context, filter moderation.VerdictFilter) ([]*moderation.Verdict, error) {
+	query, args := buildVerdictFilter("SELECT "+verdictColumns+" FROM moderation_verdicts WHERE 1=1", filter)
+
+	query += " ORDER BY created_at DESC, verdict_id DESC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, filter.Offset)
+	}
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query moderation verdicts",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var verdicts []*moderation.Verdict
+	for rows.Next() {
+		v, err := scanVerdict(rows)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan moderation verdict",
+				Err:     err,
+			}
+		}
+		verdicts = append(verdicts, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating moderation verdicts",
+			Err:     err,
+		}
+	}
+
+	return verdicts, nil
+}
+
+// Count returns the number of verdicts matching the filter
+func (r *PostgresModerationVerdictRepository) Count(ctx context.Context, filter moderation.VerdictFilter) (int, error) {
+	query, args := buildVerdictFilter("SELECT COUNT(*) FROM moderation_verdicts WHERE 1=1", filter)
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count moderation verdicts",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+// CountRejectedByUserSince counts the user's rejected verdicts created at or after since, except false positives
+func (r *PostgresModerationVerdictRepository) CountRejectedByUserSince(ctx context.Context, userID shared.UserID, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM moderation_verdicts
+		WHERE user_id = $1 AND decision = 'rejected' AND created_at >= $2
+		  AND review_label IS DISTINCT FROM 'false_positive'
+	`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRow(ctx, query, userID, since).Scan(&count); err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to count rejected moderation verdicts",
+			Err:     err,
+		}
+	}
+
+	return count, nil
+}
+
+// UpdateReview stores the review fields of a verdict
+func (r *PostgresModerationVerdictRepository) UpdateReview(ctx context.Context, v *moderation.Verdict) error {
+	query := `
+		UPDATE moderation_verdicts
+		SET review_label = $2, review_note = $3, reviewed_by = $4, reviewed_at = $5
+		WHERE verdict_id = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, v.VerdictID, v.ReviewLabel, v.ReviewNote, v.ReviewedBy, v.ReviewedAt)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to update moderation verdict review",
+			Err:     err,
+		}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &shared.NotFoundError{
+			Entity: "ModerationVerdict",
+			ID:     strconv.FormatInt(int64(v.VerdictID), 10),
+		}
+	}
+
+	return nil
+}
+
+func scanVerdict(row pgx.Row) (*moderation.Verdict, error) {
+	var (
+		v          moderation.Verdict
+		categories []string
+		latencyMs  int64
+	)
+	err := row.Scan(
+		&v.VerdictID, &v.ContentHash, &v.UserID, &v.GrumbleID, &v.Decision, &categories, &v.Reason,
+		&v.Model, &v.PromptVersion, &latencyMs, &v.CreatedAt,
+		&v.ReviewLabel, &v.ReviewNote, &v.ReviewedBy, &v.ReviewedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	v.Latency = time.Duration(latencyMs) * time.Millisecond
+	v.Categories = make([]grumble.ModerationCategory, len(categories))
+	for i, c := range categories {
+		v.Categories[i] = grumble.ModerationCategory(c)
+	}
+
+	return &v, nil
+}
+
+func categoriesToStrings(categories []grumble.ModerationCategory) []string {
+	result := make([]string, len(categories))
+	for i, c := range categories {
+		result[i] = string(c)
+	}
+	return result
+}
+
+func buildVerdictFilter(base string, filter moderation.VerdictFilter) (string, []interface{}) {
+	query := base
+	args := []interface{}{}
+
+	addCondition := func(condition string, value interface{}) {
+		query += fmt.Sprintf(condition, len(args)+1)
+		args = append(args, value)
+	}
+
+	if filter.Category != nil {
+		addCondition(" AND $%d = ANY(categories)", string(*filter.Category))
+	}
+
+	if filter.Decision != nil {
+		addCondition(" AND decision = $%d", string(*filter.Decision))
+	}
+
+	if filter.PromptVersion != nil {
+		addCondition(" AND prompt_version = $%d", *filter.PromptVersion)
+	}
+
+	if filter.ReviewLabel != nil {
+		addCondition(" AND review_label = $%d", string(*filter.ReviewLabel))
+	} else if filter.Unreviewed {
+		query += " AND review_label IS NULL"
+	}
+
+	if filter.From != nil {
+		addCondition(" AND created_at >= $%d", *filter.From)
+	}
+
+	if filter.To != nil {
+		addCondition(" AND created_at < $%d", *filter.To)
+	}
+
+	return query, args
+}
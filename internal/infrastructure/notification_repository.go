@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresNotificationRepository implements notification.Repository using PostgreSQL
+type PostgresNotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresNotificationRepository creates a new PostgresNotificationRepository
+func NewPostgresNotificationRepository(db *pgxpool.Pool) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{db: db}
+}
+
+// Create stores a new notification
+func (r *PostgresNotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	query := `
+		INSERT INTO notifications (user_id, kind, grumble_id, message, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING notification_id
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query, n.UserID, n.Kind, n.GrumbleID, n.Message, n.CreatedAt).Scan(&n.NotificationID)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to create notification",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// ListByUser returns the user's notifications, newest first
+func (r *PostgresNotificationRepository) ListByUser(ctx context.Context, userID shared.UserID, limit int) ([]*notification.Notification, error) {
+	query := `
+		SELECT notification_id, user_id, kind, grumble_id, message, created_at
+		FROM notifications
+		WHERE user_id = $1
+		ORDER BY created_at DESC, notification_id DESC
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query notifications",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+	for rows.Next() {
+		var n notification.Notification
+		if err := rows.Scan(&n.NotificationID, &n.UserID, &n.Kind, &n.GrumbleID, &n.Message, &n.CreatedAt); err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan notification",
+				Err:     err,
+			}
+		}
+		notifications = append(notifications, &n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating notifications",
+			Err:     err,
+		}
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// openAIRequestTimeout bounds a single chat completion call
+const openAIRequestTimeout = 60 * time.Second
+
+// OpenAICompatibleClient implements LLMProvider against any OpenAI-compatible
+// chat completions endpoint (OpenAI, llama.cpp server, Ollama, vLLM, ...)
+type OpenAICompatibleClient struct {
+	baseURL    string
+	model      string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewOpenAICompatibleClient creates a new client. baseURL is the API root, e.g. http://localhost:11434/v1.
+// apiKey may be empty for local servers that do not require authentication.
+func NewOpenAICompatibleClient(baseURL, model, apiKey string) *OpenAICompatibleClient {
+	return &OpenAICompatibleClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		model:      model,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: openAIRequestTimeout},
+	}
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model          string              `json:"model"`
+	Messages       []openAIChatMessage `json:"messages"`
+	Temperature    float64             `json:"temperature"`
+	ResponseFormat *openAIFormat       `json:"response_format,omitempty"`
+}
+
+type openAIFormat struct {
+	Type string `json:"type"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// Model implements LLMProvider
+func (c *OpenAICompatibleClient) Model() string {
+	return c.model
+}
+
+// Generate implements LLMProvider
+func (c *OpenAICompatibleClient) Generate(ctx context.Context, prompt string) (string, error) {
+	body, err := json.Marshal(openAIChatRequest{
+		Model:          c.model,
+		Messages:       []openAIChatMessage{{Role: "user", Content: prompt}},
+		Temperature:    0,
+		ResponseFormat: &openAIFormat{Type: "json_object"},
+	})
+	if err != nil {
+		return "", &shared.InternalError{Message: "failed to encode chat completion request", Err: err}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", &shared.InternalError{Message: "failed to build chat completion request", Err: err}
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", &shared.InternalError{Message: "failed to call chat completion endpoint", Err: err}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", &shared.InternalError{Message: "failed to read chat completion response", Err: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("chat completion endpoint returned %d: %s", resp.StatusCode, respBody),
+		}
+	}
+
+	var completion openAIChatResponse
+	if err := json.Unmarshal(respBody, &completion); err != nil {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("failed to parse chat completion response: %s", respBody),
+			Err:     err,
+		}
+	}
+	if len(completion.Choices) == 0 || completion.Choices[0].Message.Content == "" {
+		return "", &shared.InternalError{
+			Message: fmt.Sprintf("empty response from %s", c.model),
+		}
+	}
+
+	return completion.Choices[0].Message.Content, nil
+}
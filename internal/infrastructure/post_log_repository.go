@@ -0,0 +1,142 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresPostLogRepository implements grumble.PostLog using PostgreSQL
+type PostgresPostLogRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresPostLogRepository creates a new PostgresPostLogRepository
+func NewPostgresPostLogRepository(db *pgxpool.Pool) *PostgresPostLogRepository {
+	return &PostgresPostLogRepository{db: db}
+}
+
+// Recent returns the user's entries posted at or after since, oldest first
+func (r *PostgresPostLogRepository) Recent(ctx context.Context, userID shared.UserID, since time.Time) ([]grumble.PostLogEntry, error) {
+	query := `
+		SELECT content_hash, simhash, content_length, posted_at
+		FROM grumble_post_log
+		WHERE user_id = $1 AND posted_at >= $2
+		ORDER BY posted_at
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userID, since)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query post log",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var entries []grumble.PostLogEntry
+	for rows.Next() {
+		var (
+			entry   grumble.PostLogEntry
+			simHash int64
+		)
+		if err := rows.Scan(&entry.Fingerprint.Hash, &simHash, &entry.Fingerprint.Length, &entry.PostedAt); err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan post log entry",
+				Err:     err,
+			}
+		}
+		// BIGINT is signed; the SimHash round-trips through its bit pattern
+		entry.Fingerprint.SimHash = uint64(simHash)
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating post log",
+			Err:     err,
+		}
+	}
+
+	return entries, nil
+}
+
+// Record appends a post to the user's log
+func (r *PostgresPostLogRepository) Record(ctx context.Context, userID shared.UserID, entry grumble.PostLogEntry) error {
+	query := `
+		INSERT INTO grumble_post_log (user_id, content_hash, simhash, content_length, posted_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	fp := entry.Fingerprint
+	if _, err := conn(ctx, r.db).Exec(ctx, query, userID, fp.Hash, int64(fp.SimHash), fp.Length, entry.PostedAt); err != nil {
+		return &shared.InternalError{
+			Message: "failed to record post log entry",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// Reserve records entry unless check refuses the user's recent entries.
+// A transaction-scoped advisory lock on the user serializes concurrent reservations.
+func (r *PostgresPostLogRepository) Reserve(
+	ctx context.Context,
+	userID shared.UserID,
+	since time.Time,
+	entry grumble.PostLogEntry,
+	check func(recent []grumble.PostLogEntry) error,
+) error {
+	return NewPostgresTransactor(r.db).WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := conn(ctx, r.db).Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0))", userID); err != nil {
+			return &shared.InternalError{
+				Message: "failed to lock post log",
+				Err:     err,
+			}
+		}
+
+		recent, err := r.Recent(ctx, userID, since)
+		if err != nil {
+			return err
+		}
+		if err := check(recent); err != nil {
+			return err
+		}
+
+		return r.Record(ctx, userID, entry)
+	})
+}
+
+// Release removes a reserved entry whose post was never stored
+func (r *PostgresPostLogRepository) Release(ctx context.Context, userID shared.UserID, entry grumble.PostLogEntry) error {
+	query := `
+		DELETE FROM grumble_post_log
+		WHERE user_id = $1 AND content_hash = $2 AND posted_at = $3
+	`
+
+	if _, err := conn(ctx, r.db).Exec(ctx, query, userID, entry.Fingerprint.Hash, entry.PostedAt); err != nil {
+		return &shared.InternalError{
+			Message: "failed to release post log entry",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// DeleteBefore removes entries posted before the given time
+func (r *PostgresPostLogRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := conn(ctx, r.db).Exec(ctx, "DELETE FROM grumble_post_log WHERE posted_at < $1", before)
+	if err != nil {
+		return 0, &shared.InternalError{
+			Message: "failed to prune post log",
+			Err:     err,
+		}
+	}
+
+	return int(result.RowsAffected()), nil
+}
@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+)
+
+// LoadModerationPrompts reads every <version>.txt template in dir
+func LoadModerationPrompts(dir string) (map[string]grumble.ModerationPrompt, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prompt directory: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no moderation prompts found in %s", dir)
+	}
+
+	prompts := make(map[string]grumble.ModerationPrompt, len(files))
+	for _, file := range files {
+		template, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read prompt %s: %w", file, err)
+		}
+
+		prompt := grumble.ModerationPrompt{
+			Version:  strings.TrimSuffix(filepath.Base(file), ".txt"),
+			Template: strings.TrimRight(string(template), "\n"),
+		}
+		if err := prompt.Validate(); err != nil {
+			return nil, err
+		}
+		prompts[prompt.Version] = prompt
+	}
+
+	return prompts, nil
+}
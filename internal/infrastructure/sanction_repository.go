@@ -0,0 +1,169 @@
+package infrastructure
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresSanctionRepository implements sanction.Repository using PostgreSQL
+type PostgresSanctionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresSanctionRepository creates a new PostgresSanctionRepository
+func NewPostgresSanctionRepository(db *pgxpool.Pool) *PostgresSanctionRepository {
+	return &PostgresSanctionRepository{db: db}
+}
+
+const sanctionColumns = `
+	sanction_id, user_id, level, source, reason, issued_by, created_at, expires_at, revoked_by, revoked_at`
+
+// Create stores a new sanction
+func (r *PostgresSanctionRepository) Create(ctx context.Context, s *sanction.Sanction) error {
+	query := `
+		INSERT INTO user_sanctions (user_id, level, source, reason, issued_by, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING sanction_id
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, query,
+		s.UserID, s.Level, s.Source, s.Reason, s.IssuedBy, s.CreatedAt, s.ExpiresAt,
+	).Scan(&s.SanctionID)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23503" {
+			return &shared.NotFoundError{Entity: "User", ID: string(s.UserID)}
+		}
+		return &shared.InternalError{
+			Message: "failed to create sanction",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// FindByID retrieves a sanction by its ID
+func (r *PostgresSanctionRepository) FindByID(ctx context.Context, id sanction.SanctionID) (*sanction.Sanction, error) {
+	query := "SELECT " + sanctionColumns + " FROM user_sanctions WHERE sanction_id = $1"
+
+	s, err := scanSanction(conn(ctx, r.db).QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, &shared.NotFoundError{
+			Entity: "Sanction",
+			ID:     strconv.FormatInt(int64(id), 10),
+		}
+	}
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to find sanction",
+			Err:     err,
+		}
+	}
+
+	return s, nil
+}
+
+// FindActiveByUser returns the user's unrevoked, unexpired sanctions
+func (r *PostgresSanctionRepository) FindActiveByUser(ctx context.Context, userID shared.UserID, at time.Time) ([]*sanction.Sanction, error) {
+	query := "SELECT " + sanctionColumns + `
+		FROM user_sanctions
+		WHERE user_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY created_at DESC
+	`
+
+	return r.query(ctx, query, userID, at)
+}
+
+// ListByUser returns every sanction issued to the user, newest first
+func (r *PostgresSanctionRepository) ListByUser(ctx context.Context, userID shared.UserID) ([]*sanction.Sanction, error) {
+	query := "SELECT " + sanctionColumns + " FROM user_sanctions WHERE user_id = $1 ORDER BY created_at DESC, sanction_id DESC"
+
+	return r.query(ctx, query, userID)
+}
+
+// UpdateRevocation stores the revocation of a sanction.
+// The unrevoked guard makes concurrent revocations of the same sanction fail with a conflict.
+func (r *PostgresSanctionRepository) UpdateRevocation(ctx context.Context, s *sanction.Sanction) error {
+	query := `
+		UPDATE user_sanctions
+		SET revoked_by = $2, revoked_at = $3
+		WHERE sanction_id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, s.SanctionID, s.RevokedBy, s.RevokedAt)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to revoke sanction",
+			Err:     err,
+		}
+	}
+
+	if result.RowsAffected() == 0 {
+		return &shared.ConflictError{Message: "sanction has already been revoked"}
+	}
+
+	return nil
+}
+
+// LockUser takes a transaction-scoped advisory lock on the user's sanctions.
+// It must run inside a transaction; the lock is keyed apart from the post log lock on the same user.
+func (r *PostgresSanctionRepository) LockUser(ctx context.Context, userID shared.UserID) error {
+	if _, err := conn(ctx, r.db).Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended('sanction:' || $1::text, 0))", userID); err != nil {
+		return &shared.InternalError{
+			Message: "failed to lock user sanctions",
+			Err:     err,
+		}
+	}
+	return nil
+}
+
+func (r *PostgresSanctionRepository) query(ctx context.Context, query string, args ...interface{}) ([]*sanction.Sanction, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, &shared.InternalError{
+			Message: "failed to query sanctions",
+			Err:     err,
+		}
+	}
+	defer rows.Close()
+
+	var sanctions []*sanction.Sanction
+	for rows.Next() {
+		s, err := scanSanction(rows)
+		if err != nil {
+			return nil, &shared.InternalError{
+				Message: "failed to scan sanction",
+				Err:     err,
+			}
+		}
+		sanctions = append(sanctions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, &shared.InternalError{
+			Message: "error iterating sanctions",
+			Err:     err,
+		}
+	}
+
+	return sanctions, nil
+}
+
+func scanSanction(row pgx.Row) (*sanction.Sanction, error) {
+	var s sanction.Sanction
+	err := row.Scan(
+		&s.SanctionID, &s.UserID, &s.Level, &s.Source, &s.Reason, &s.IssuedBy, &s.CreatedAt, &s.ExpiresAt,
+		&s.RevokedBy, &s.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
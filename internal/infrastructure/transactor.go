@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// txKey carries the transaction opened by PostgresTransactor in a context
+type txKey struct{}
+
+// PostgresTransactor runs units of work spanning several repositories in one PostgreSQL transaction
+type PostgresTransactor struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresTransactor creates a new PostgresTransactor
+func NewPostgresTransactor(db *pgxpool.Pool) *PostgresTransactor {
+	return &PostgresTransactor{db: db}
+}
+
+// WithinTx runs fn in a transaction that repositories join through the context passed to fn.
+// The transaction commits when fn returns nil and rolls back otherwise. A nested call joins the outer transaction.
+func (t *PostgresTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return &shared.InternalError{
+			Message: "failed to start transaction",
+			Err:     err,
+		}
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return &shared.InternalError{
+			Message: "failed to commit transaction",
+			Err:     err,
+		}
+	}
+
+	return nil
+}
+
+// querier is the subset of the pool that a transaction provides as well
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// conn returns the transaction opened by WithinTx, or the pool outside of one.
+// Begin on a transaction opens a savepoint, so repository methods with their own transaction nest inside it.
+func conn(ctx context.Context, db *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db
+}
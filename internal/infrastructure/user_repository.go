@@ -22,11 +22,11 @@ func NewPostgresUserRepository(db *pgxpool.Pool) *PostgresUserRepository {
 // Create stores a new anonymous user
 func (r *PostgresUserRepository) Create(ctx context.Context, u *user.AnonymousUser) error {
 	query := `
-		INSERT INTO anonymous_users (user_id, virtue_points, created_at, profile_title)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO anonymous_users (user_id, virtue_points, created_at, profile_title, timezone)
+		VALUES ($1, $2, $3, $4, $5)
 	`
 
-	_, err := r.db.Exec(ctx, query, u.UserID, u.VirtuePoints, u.CreatedAt, u.ProfileTitle)
+	_, err := conn(ctx, r.db).Exec(ctx, query, u.UserID, u.VirtuePoints, u.CreatedAt, u.ProfileTitle, u.Timezone)
 	if err != nil {
 		return &shared.InternalError{
 			Message: "failed to create user",
@@ -40,14 +40,14 @@ func (r *PostgresUserRepository) Create(ctx context.Context, u *user.AnonymousUs
 // FindByID retrieves a user by their ID
 func (r *PostgresUserRepository) FindByID(ctx context.Context, id shared.UserID) (*user.AnonymousUser, error) {
 	query := `
-		SELECT user_id, virtue_points, created_at, profile_title
+		SELECT user_id, virtue_points, created_at, profile_title, timezone
 		FROM anonymous_users
 		WHERE user_id = $1
 	`
 
 	var u user.AnonymousUser
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&u.UserID, &u.VirtuePoints, &u.CreatedAt, &u.ProfileTitle,
+	err := conn(ctx, r.db).QueryRow(ctx, query, id).Scan(
+		&u.UserID, &u.VirtuePoints, &u.CreatedAt, &u.ProfileTitle, &u.Timezone,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, &shared.NotFoundError{
@@ -69,11 +69,11 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id shared.UserID)
 func (r *PostgresUserRepository) Update(ctx context.Context, u *user.AnonymousUser) error {
 	query := `
 		UPDATE anonymous_users
-		SET virtue_points = $2, profile_title = $3
+		SET virtue_points = $2, profile_title = $3, timezone = $4
 		WHERE user_id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, u.UserID, u.VirtuePoints, u.ProfileTitle)
+	result, err := conn(ctx, r.db).Exec(ctx, query, u.UserID, u.VirtuePoints, u.ProfileTitle, u.Timezone)
 	if err != nil {
 		return &shared.InternalError{
 			Message: "failed to update user",
@@ -94,13 +94,13 @@ func (r *PostgresUserRepository) Update(ctx context.Context, u *user.AnonymousUs
 // FindTopByVirtuePoints retrieves top users by virtue points for rankings
 func (r *PostgresUserRepository) FindTopByVirtuePoints(ctx context.Context, limit int) ([]*user.AnonymousUser, error) {
 	query := `
-		SELECT user_id, virtue_points, created_at, profile_title
+		SELECT user_id, virtue_points, created_at, profile_title, timezone
 		FROM anonymous_users
 		ORDER BY virtue_points DESC
 		LIMIT $1
 	`
 
-	rows, err := r.db.Query(ctx, query, limit)
+	rows, err := conn(ctx, r.db).Query(ctx, query, limit)
 	if err != nil {
 		return nil, &shared.InternalError{
 			Message: "failed to query top users",
@@ -112,7 +112,7 @@ func (r *PostgresUserRepository) FindTopByVirtuePoints(ctx context.Context, limi
 	var users []*user.AnonymousUser
 	for rows.Next() {
 		var u user.AnonymousUser
-		err := rows.Scan(&u.UserID, &u.VirtuePoints, &u.CreatedAt, &u.ProfileTitle)
+		err := rows.Scan(&u.UserID, &u.VirtuePoints, &u.CreatedAt, &u.ProfileTitle, &u.Timezone)
 		if err != nil {
 			return nil, &shared.InternalError{
 				Message: "failed to scan user",
@@ -136,7 +136,7 @@ func (r *PostgresUserRepository) FindTopByVirtuePoints(ctx context.Context, limi
 func (r *PostgresUserRepository) IncrementVirtuePoints(ctx context.Context, id shared.UserID, points int) error {
 	query := "UPDATE anonymous_users SET virtue_points = virtue_points + $2 WHERE user_id = $1"
 
-	result, err := r.db.Exec(ctx, query, id, points)
+	result, err := conn(ctx, r.db).Exec(ctx, query, id, points)
 	if err != nil {
 		return &shared.InternalError{
 			Message: "failed to increment virtue points",
@@ -125,12 +125,16 @@ func (r *PostgresVibeRepository) Create(ctx context.Context, v *vibe.Vibe) (*vib
 	}, nil
 }
 
-// Exists checks if a user has already given a vibe to the specified grumble.
+// Exists checks if a user has already given a vibe to the specified grumble, including vibes archived with it.
 func (r *PostgresVibeRepository) Exists(ctx context.Context, grumbleID shared.GrumbleID, userID shared.UserID) (bool, error) {
 	query := `
 		SELECT 1
 		FROM vibes
 		WHERE grumble_id = $1 AND user_id = $2
+		UNION ALL
+		SELECT 1
+		FROM vibes_archive
+		WHERE grumble_id = $1 AND user_id = $2
 		LIMIT 1
 	`
 
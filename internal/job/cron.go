@@ -9,33 +9,46 @@ import (
 
 // CronScheduler manages scheduled batch jobs
 type CronScheduler struct {
-	cron            *cron.Cron
-	purgeExpiredJob *PurgeExpiredJob
-	logger          logging.Logger
+	cron               *cron.Cron
+	purgeExpiredJob    *PurgeExpiredJob
+	moderatePendingJob *ModeratePendingJob
+	logger             logging.Logger
 }
 
-// NewCronScheduler creates a new CronScheduler
+// NewCronScheduler creates a new CronScheduler.
+// moderatePendingJob may be nil when moderation runs synchronously.
 func NewCronScheduler(
 	purgeExpiredJob *PurgeExpiredJob,
+	moderatePendingJob *ModeratePendingJob,
 	logger logging.Logger,
 ) *CronScheduler {
 	return &CronScheduler{
-		cron:            cron.New(),
-		purgeExpiredJob: purgeExpiredJob,
-		logger:          logger,
+		cron:               cron.New(),
+		purgeExpiredJob:    purgeExpiredJob,
+		moderatePendingJob: moderatePendingJob,
+		logger:             logger,
 	}
 }
 
 // Start initializes and starts all scheduled jobs
 func (s *CronScheduler) Start() error {
 	ctx := context.Background()
-	// Run purge expired grumbles job every 5 minutes
-	_, err := s.cron.AddFunc("*/5 * * * *", s.purgeExpiredJob.Run)
+	// Run purge expired grumbles job every minute; the timeline lists grumbles until they are archived as expired
+	_, err := s.cron.AddFunc("* * * * *", s.purgeExpiredJob.Run)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "Failed to schedule purge expired job", "error", err)
 		return err
 	}
 
+	// Run moderate pending grumbles job every minute
+	if s.moderatePendingJob != nil {
+		_, err = s.cron.AddFunc("* * * * *", s.moderatePendingJob.Run)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to schedule moderate pending job", "error", err)
+			return err
+		}
+	}
+
 	s.logger.InfoContext(ctx, "Starting cron scheduler")
 	s.cron.Start()
 
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/logging"
+	"github.com/dokkiitech/grumble-back/internal/usecase"
+)
+
+// ModeratePendingJob is a cron job that moderates grumbles posted in async moderation mode
+type ModeratePendingJob struct {
+	moderatePendingUC *usecase.ModeratePendingUseCase
+	logger            logging.Logger
+}
+
+// NewModeratePendingJob creates a new ModeratePendingJob
+func NewModeratePendingJob(
+	moderatePendingUC *usecase.ModeratePendingUseCase,
+	logger logging.Logger,
+) *ModeratePendingJob {
+	return &ModeratePendingJob{
+		moderatePendingUC: moderatePendingUC,
+		logger:            logger,
+	}
+}
+
+// Run executes the moderate pending grumbles job
+func (j *ModeratePendingJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Second)
+	defer cancel()
+
+	count, err := j.moderatePendingUC.ModeratePending(ctx)
+	if err != nil {
+		j.logger.ErrorContext(ctx, "Moderate pending job failed", "error", err)
+		return
+	}
+
+	if count > 0 {
+		j.logger.InfoContext(ctx, "Moderate pending job completed", "moderated_count", count)
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/dokkiitech/grumble-back/internal/usecase"
 )
 
-// PurgeExpiredJob is a cron job that deletes expired grumbles every 5 minutes
+// PurgeExpiredJob is a cron job that deletes expired grumbles every minute
 type PurgeExpiredJob struct {
 	purgeExpiredUC *usecase.PurgeExpiredUseCase
 	logger         logging.Logger
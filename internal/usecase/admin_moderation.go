@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// authorHistoryLimit caps the recent grumbles shown alongside an inspected grumble
+const authorHistoryLimit = 20
+
+// AdminModerationUseCase lets admins take down and restore grumbles, inspect authors and adjust virtue points.
+// Every change is written to the audit log.
+type AdminModerationUseCase struct {
+	grumbleRepo grumble.Repository
+	userRepo    user.Repository
+	auditRepo   audit.Repository
+	transactor  Transactor
+	logger      logging.Logger
+}
+
+// NewAdminModerationUseCase creates a new AdminModerationUseCase
+func NewAdminModerationUseCase(
+	grumbleRepo grumble.Repository,
+	userRepo user.Repository,
+	auditRepo audit.Repository,
+	transactor Transactor,
+	logger logging.Logger,
+) *AdminModerationUseCase {
+	return &AdminModerationUseCase{
+		grumbleRepo: grumbleRepo,
+		userRepo:    userRepo,
+		auditRepo:   auditRepo,
+		transactor:  transactor,
+		logger:      logger,
+	}
+}
+
+// AdminGrumbleActionRequest represents a take-down or restore of a grumble
+type AdminGrumbleActionRequest struct {
+	GrumbleID shared.GrumbleID
+	Reason    string
+	AdminID   shared.UserID
+}
+
+// AdjustVirtueRequest represents a manual change to a user's virtue points
+type AdjustVirtueRequest struct {
+	UserID  shared.UserID
+	Delta   int
+	Reason  string
+	AdminID shared.UserID
+}
+
+// GrumbleInspection is a grumble together with its author's history
+type GrumbleInspection struct {
+	Grumble        *grumble.Grumble
+	Author         *user.AnonymousUser
+	StatusCounts   map[grumble.ModerationStatus]int
+	RecentGrumbles []*grumble.Grumble
+}
+
+// AuditLogResponse represents a page of the audit log
+type AuditLogResponse struct {
+	Entries []*audit.Entry
+	Total   int
+}
+
+// TakeDown removes a grumble from every view, whether live or archived
+func (uc *AdminModerationUseCase) TakeDown(ctx context.Context, req AdminGrumbleActionRequest) (*grumble.Grumble, error) {
+	return uc.changeGrumbleStatus(ctx, req, audit.ActionGrumbleTakedown, (*grumble.Grumble).TakeDown)
+}
+
+// Restore publishes a grumble that was hidden by reports or taken down
+func (uc *AdminModerationUseCase) Restore(ctx context.Context, req AdminGrumbleActionRequest) (*grumble.Grumble, error) {
+	return uc.changeGrumbleStatus(ctx, req, audit.ActionGrumbleRestore, (*grumble.Grumble).Restore)
+}
+
+func (uc *AdminModerationUseCase) changeGrumbleStatus(
+	ctx context.Context,
+	req AdminGrumbleActionRequest,
+	action audit.Action,
+	transition func(*grumble.Grumble) error,
+) (*grumble.Grumble, error) {
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := uc.grumbleRepo.FindByIDIncludingArchive(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := g.ModerationStatus
+	if err := transition(g); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.grumbleRepo.SaveStatus(ctx, g); err != nil {
+			return err
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.AdminID,
+			Action:     action,
+			TargetType: audit.TargetGrumble,
+			TargetID:   string(g.GrumbleID),
+			Reason:     &reason,
+			Detail: map[string]any{
+				"previous_status": string(previous),
+				"status":          string(g.ModerationStatus),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Inspect returns a grumble in any status with its author's profile and recent history
+func (uc *AdminModerationUseCase) Inspect(ctx context.Context, grumbleID shared.GrumbleID) (*GrumbleInspection, error) {
+	g, err := uc.grumbleRepo.FindByIDIncludingArchive(ctx, grumbleID)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := uc.userRepo.FindByID(ctx, g.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.grumbleRepo.CountByAuthorStatus(ctx, g.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	recent, err := uc.grumbleRepo.FindByAuthor(ctx, g.UserID, authorHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrumbleInspection{
+		Grumble:        g,
+		Author:         author,
+		StatusCounts:   counts,
+		RecentGrumbles: recent,
+	}, nil
+}
+
+// AdjustVirtuePoints adds or removes virtue points; the balance cannot go below zero
+func (uc *AdminModerationUseCase) AdjustVirtuePoints(ctx context.Context, req AdjustVirtueRequest) (*user.AnonymousUser, error) {
+	if req.Delta == 0 {
+		return nil, &shared.ValidationError{Field: "delta", Message: "delta must not be zero"}
+	}
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := u.VirtuePoints
+	u.IncrementVirtuePoints(req.Delta)
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.IncrementVirtuePoints(ctx, u.UserID, req.Delta); err != nil {
+			return err
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.AdminID,
+			Action:     audit.ActionVirtueAdjust,
+			TargetType: audit.TargetUser,
+			TargetID:   string(u.UserID),
+			Reason:     &reason,
+			Detail: map[string]any{
+				"delta":  req.Delta,
+				"before": before,
+				"after":  u.VirtuePoints,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// ListAuditLog returns audit log entries, newest first
+func (uc *AdminModerationUseCase) ListAuditLog(ctx context.Context, filter audit.Filter) (*AuditLogResponse, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.Action != nil {
+		if err := filter.Action.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := uc.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.auditRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLogResponse{Entries: entries, Total: total}, nil
+}
+
+func requireReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", &shared.ValidationError{Field: "reason", Message: "reason is required"}
+	}
+	return reason, nil
+}
+
+// appendAudit records an admin action.
+// Callers run it in the same transaction as the action, so an action is never applied without its entry.
+func appendAudit(ctx context.Context, repo audit.Repository, entry *audit.Entry) error {
+	entry.CreatedAt = time.Now()
+	return repo.Append(ctx, entry)
+}
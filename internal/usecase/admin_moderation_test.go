@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+)
+
+// fakeAuditRepo records appended entries, or fails every append when err is set.
+type fakeAuditRepo struct {
+	audit.Repository
+	entries []*audit.Entry
+	err     error
+}
+
+func (r *fakeAuditRepo) Append(_ context.Context, e *audit.Entry) error {
+	if r.err != nil {
+		return r.err
+	}
+	e.EntryID = audit.EntryID(len(r.entries) + 1)
+	r.entries = append(r.entries, e)
+	return nil
+}
+
+// fakeAdminGrumbleRepo serves grumbles from the live or archive table and records status changes.
+type fakeAdminGrumbleRepo struct {
+	fakeReportedGrumbleRepo
+}
+
+// fakeVirtueUserRepo serves a single user and applies point increments.
+type fakeVirtueUserRepo struct {
+	user.Repository
+	user *user.AnonymousUser
+}
+
+func (r *fakeVirtueUserRepo) FindByID(_ context.Context, id shared.UserID) (*user.AnonymousUser, error) {
+	if r.user == nil || r.user.UserID != id {
+		return nil, &shared.NotFoundError{Entity: "User", ID: string(id)}
+	}
+	copied := *r.user
+	return &copied, nil
+}
+
+func (r *fakeVirtueUserRepo) IncrementVirtuePoints(_ context.Context, _ shared.UserID, points int) error {
+	r.user.VirtuePoints += points
+	return nil
+}
+
+const testAdminID shared.UserID = "00000000-0000-0000-0000-0000000000ad"
+
+type adminFixture struct {
+	uc       *AdminModerationUseCase
+	grumble  *grumble.Grumble
+	grumbles *fakeAdminGrumbleRepo
+	users    *fakeVirtueUserRepo
+	audits   *fakeAuditRepo
+}
+
+func newAdminFixture(status grumble.ModerationStatus, virtuePoints int) *adminFixture {
+	postedAt := time.Now().Add(-time.Hour)
+	lifecycle := grumble.StatusActive
+	if status == grumble.ModerationStatusRemoved {
+		lifecycle = grumble.StatusRemoved
+	}
+	g := &grumble.Grumble{
+		GrumbleID:        testGrumbleID,
+		UserID:           testAuthorID,
+		Content:          "締め切りが前倒しになった",
+		PostedAt:         postedAt,
+		ExpiresAt:        postedAt.Add(24 * time.Hour),
+		ModerationStatus: status,
+		Status:           lifecycle,
+	}
+	f := &adminFixture{
+		grumble: g,
+		grumbles: &fakeAdminGrumbleRepo{fakeReportedGrumbleRepo{
+			fakeStoredGrumbleRepo: fakeStoredGrumbleRepo{byID: map[shared.GrumbleID]*grumble.Grumble{g.GrumbleID: g}},
+		}},
+		users:  &fakeVirtueUserRepo{user: &user.AnonymousUser{UserID: testAuthorID, VirtuePoints: virtuePoints}},
+		audits: &fakeAuditRepo{},
+	}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	f.uc = NewAdminModerationUseCase(f.grumbles, f.users, f.audits, &fakeTransactor{}, logger)
+	return f
+}
+
+func TestAdminModerationUseCase_TakeDownAndRestore(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     grumble.ModerationStatus
+		restore    bool
+		reason     string
+		wantStatus grumble.ModerationStatus
+		wantErr    error
+	}{
+		{"公開中の投稿を削除", grumble.ModerationStatusPublished, false, "個人情報を含む", grumble.ModerationStatusRemoved, nil},
+		{"通報で非表示の投稿を再公開", grumble.ModerationStatusHidden, true, "問題なし", grumble.ModerationStatusPublished, nil},
+		{"削除済みの投稿を再公開", grumble.ModerationStatusRemoved, true, "誤操作の取り消し", grumble.ModerationStatusPublished, nil},
+		{"削除済みの投稿は再削除できない", grumble.ModerationStatusRemoved, false, "重複", "", &shared.ConflictError{}},
+		{"公開中の投稿は再公開できない", grumble.ModerationStatusPublished, true, "確認", "", &shared.ConflictError{}},
+		{"理由は必須", grumble.ModerationStatusPublished, false, "  ", "", &shared.ValidationError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAdminFixture(tt.status, 0)
+			req := AdminGrumbleActionRequest{GrumbleID: testGrumbleID, Reason: tt.reason, AdminID: testAdminID}
+
+			action := f.uc.TakeDown
+			wantAction := audit.ActionGrumbleTakedown
+			if tt.restore {
+				action = f.uc.Restore
+				wantAction = audit.ActionGrumbleRestore
+			}
+			g, err := action(context.Background(), req)
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if g.ModerationStatus != tt.wantStatus {
+					t.Errorf("ModerationStatus = %q, want %q", g.ModerationStatus, tt.wantStatus)
+				}
+				if len(f.grumbles.statuses) != 1 || f.grumbles.statuses[0] != tt.wantStatus {
+					t.Errorf("stored statuses = %v, want [%s]", f.grumbles.statuses, tt.wantStatus)
+				}
+				if len(f.audits.entries) != 1 {
+					t.Fatalf("audit entries = %d, want 1", len(f.audits.entries))
+				}
+				entry := f.audits.entries[0]
+				if entry.Action != wantAction || entry.ActorID != testAdminID || entry.TargetID != string(testGrumbleID) {
+					t.Errorf("audit entry = %+v, want %s by the admin on the grumble", entry, wantAction)
+				}
+				if entry.Detail["previous_status"] != string(tt.status) {
+					t.Errorf("previous_status = %v, want %s", entry.Detail["previous_status"], tt.status)
+				}
+			case *shared.ConflictError:
+				if !errors.As(err, &want) {
+					t.Fatalf("error = %v, want ConflictError", err)
+				}
+			case *shared.ValidationError:
+				if !errors.As(err, &want) {
+					t.Fatalf("error = %v, want ValidationError", err)
+				}
+			}
+			if tt.wantErr != nil && (len(f.grumbles.statuses) != 0 || len(f.audits.entries) != 0) {
+				t.Errorf("failed action changed state: statuses %v, audit entries %d", f.grumbles.statuses, len(f.audits.entries))
+			}
+		})
+	}
+}
+
+func TestAdminModerationUseCase_AuditFailureFailsAction(t *testing.T) {
+	f := newAdminFixture(grumble.ModerationStatusPublished, 0)
+	f.audits.err = errors.New("audit log unavailable")
+	transactor := &fakeTransactor{}
+	f.uc.transactor = transactor
+
+	_, err := f.uc.TakeDown(context.Background(), AdminGrumbleActionRequest{GrumbleID: testGrumbleID, Reason: "個人情報を含む", AdminID: testAdminID})
+
+	if !errors.Is(err, f.audits.err) {
+		t.Fatalf("TakeDown() error = %v, want the audit failure", err)
+	}
+	if transactor.calls != 1 {
+		t.Errorf("transactions = %d, want the status change and audit entry in one", transactor.calls)
+	}
+}
+
+func TestAdminModerationUseCase_AdjustVirtuePoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		delta     int
+		reason    string
+		wantAfter int
+		wantErr   bool
+	}{
+		{"加算", 5, "イベント協力のお礼", 15, false},
+		{"減算", -10, "不正な共感の取り消し", 0, false},
+		{"0未満にはできない", -11, "不正な共感の取り消し", 0, true},
+		{"差分0は不可", 0, "調整", 0, true},
+		{"理由は必須", 5, "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAdminFixture(grumble.ModerationStatusPublished, 10)
+
+			u, err := f.uc.AdjustVirtuePoints(context.Background(), AdjustVirtueRequest{
+				UserID:  testAuthorID,
+				Delta:   tt.delta,
+				Reason:  tt.reason,
+				AdminID: testAdminID,
+			})
+
+			if tt.wantErr {
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("error = %v, want ValidationError", err)
+				}
+				if f.users.user.VirtuePoints != 10 || len(f.audits.entries) != 0 {
+					t.Errorf("rejected adjustment changed state: points %d, audit entries %d", f.users.user.VirtuePoints, len(f.audits.entries))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if u.VirtuePoints != tt.wantAfter || f.users.user.VirtuePoints != tt.wantAfter {
+				t.Errorf("VirtuePoints = %d (stored %d), want %d", u.VirtuePoints, f.users.user.VirtuePoints, tt.wantAfter)
+			}
+			if len(f.audits.entries) != 1 || f.audits.entries[0].Action != audit.ActionVirtueAdjust {
+				t.Fatalf("audit entries = %+v, want one virtue adjustment", f.audits.entries)
+			}
+			detail := f.audits.entries[0].Detail
+			if detail["before"] != 10 || detail["after"] != tt.wantAfter || detail["delta"] != tt.delta {
+				t.Errorf("audit detail = %v, want before 10, after %d, delta %d", detail, tt.wantAfter, tt.delta)
+			}
+		})
+	}
+}
@@ -30,33 +30,56 @@ type EventGrumblesRequest struct {
 	ExcludePurified bool
 	Limit           int
 	Offset          int
+	Cursor          *string // Optional next_cursor of the previous page; pages by position instead of Offset
+	IncludeTotal    bool    // Count all grumbles of the event day, which costs a separate query
+	Timezone        string  // Viewer's IANA timezone; empty uses the default zone
 }
 
 // EventGrumblesResponse represents the response
 type EventGrumblesResponse struct {
 	Grumbles      []*grumble.Grumble
-	Total         int
+	Total         *int   // Set only when IncludeTotal was requested
+	NextCursor    string // Cursor for the following page; empty when HasMore is false
+	HasMore       bool
 	IsEventActive bool
 	EventDate     time.Time
 }
 
-// Get retrieves event grumbles if within event time window (00:00-12:00 JST)
+// Get retrieves event grumbles if within event time window (00:00-12:00 in the viewer's timezone).
+// The target day is the viewer's previous day; each grumble is matched by its day in its author's timezone.
 func (uc *EventGrumblesGetUseCase) Get(ctx context.Context, req EventGrumblesRequest) (*EventGrumblesResponse, error) {
+	var after *grumble.TimelineCursor
+	if req.Cursor != nil {
+		if req.Offset > 0 {
+			return nil, &shared.ValidationError{Field: "offset", Message: "offset cannot be combined with cursor"}
+		}
+		cursor, err := grumble.DecodeTimelineCursor(*req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+
 	now := time.Now()
+	eventTimeSvc := uc.eventTimeSvc.In(uc.eventTimeSvc.Location(req.Timezone))
 
 	// イベント期間中かチェック（24:00〜12:00）
-	if !uc.eventTimeSvc.IsEventTimeWindow(now) {
+	if !eventTimeSvc.IsEventTimeWindow(now) {
 		// イベント期間外の場合、空のレスポンスを返す
-		return &EventGrumblesResponse{
+		resp := &EventGrumblesResponse{
 			Grumbles:      []*grumble.Grumble{},
-			Total:         0,
 			IsEventActive: false,
 			EventDate:     time.Time{},
-		}, nil
+		}
+		if req.IncludeTotal {
+			total := 0
+			resp.Total = &total
+		}
+		return resp, nil
 	}
 
 	// イベント対象日を取得（前日）
-	targetDate := uc.eventTimeSvc.GetEventTargetDate(now)
+	targetDate := eventTimeSvc.GetEventTargetDate(now)
 
 	// フィルタ構築
 	var isPurified *bool
@@ -65,29 +88,39 @@ func (uc *EventGrumblesGetUseCase) Get(ctx context.Context, req EventGrumblesReq
 		isPurified = &falseVal
 	}
 	filter := grumble.TimelineFilter{
-		ToxicLevelMin:  req.ToxicLevelMin,
-		ToxicLevelMax:  req.ToxicLevelMax,
-		IsPurified:     isPurified,
-		ExcludeExpired: false, // アーカイブなので期限チェック不要
-		Limit:          req.Limit,
-		Offset:         req.Offset,
+		ToxicLevelMin: req.ToxicLevelMin,
+		ToxicLevelMax: req.ToxicLevelMax,
+		IsPurified:    isPurified,
+		Offset:        req.Offset,
 	}
 
-	// アーカイブテーブルから前日の投稿を取得
-	grumbles, err := uc.grumbleRepo.FindArchivedTimeline(ctx, filter, targetDate)
-	if err != nil {
-		return nil, err
+	// アーカイブテーブルから前日の投稿を取得（次のページの有無を判定するため1件多く取得）
+	page := filter
+	page.After = after
+	if req.Limit > 0 {
+		page.Limit = req.Limit + 1
 	}
-
-	total, err := uc.grumbleRepo.CountArchivedTimeline(ctx, filter, targetDate)
+	grumbles, err := uc.grumbleRepo.FindArchivedTimeline(ctx, page, targetDate)
 	if err != nil {
 		return nil, err
 	}
+	grumbles, nextCursor, hasMore := trimPage(grumbles, req.Limit)
 
-	return &EventGrumblesResponse{
+	resp := &EventGrumblesResponse{
 		Grumbles:      grumbles,
-		Total:         total,
+		NextCursor:    nextCursor,
+		HasMore:       hasMore,
 		IsEventActive: true,
 		EventDate:     targetDate,
-	}, nil
+	}
+
+	if req.IncludeTotal {
+		total, err := uc.grumbleRepo.CountArchivedTimeline(ctx, filter, targetDate)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total = &total
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// GrumbleDeleteUseCase lets authors take back their own grumbles
+type GrumbleDeleteUseCase struct {
+	grumbleRepo grumble.Repository
+	logger      logging.Logger
+}
+
+// NewGrumbleDeleteUseCase creates a new GrumbleDeleteUseCase.
+func NewGrumbleDeleteUseCase(grumbleRepo grumble.Repository, logger logging.Logger) *GrumbleDeleteUseCase {
+	return &GrumbleDeleteUseCase{
+		grumbleRepo: grumbleRepo,
+		logger:      logger,
+	}
+}
+
+// DeleteGrumbleRequest represents an author's request to delete a grumble
+type DeleteGrumbleRequest struct {
+	GrumbleID shared.GrumbleID
+	UserID    shared.UserID
+}
+
+// Delete removes the grumble from every listing and moves it to the archive.
+// Grumbles of other users and grumbles already deleted are reported as not found so their existence is not revealed.
+func (uc *GrumbleDeleteUseCase) Delete(ctx context.Context, req DeleteGrumbleRequest) error {
+	g, err := uc.grumbleRepo.FindByIDIncludingArchive(ctx, req.GrumbleID)
+	if err != nil {
+		return err
+	}
+	if g.UserID != req.UserID || g.IsDeleted() {
+		return &shared.NotFoundError{Entity: "Grumble", ID: string(req.GrumbleID)}
+	}
+	if err := g.Delete(); err != nil {
+		return err
+	}
+
+	if err := uc.grumbleRepo.DeleteByAuthor(ctx, req.GrumbleID); err != nil {
+		return err
+	}
+
+	uc.logger.InfoContext(ctx, "Grumble deleted by author", "grumble_id", req.GrumbleID)
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// fakeDeletableGrumbleRepo serves grumbles by ID, live or archived, and records author deletions.
+type fakeDeletableGrumbleRepo struct {
+	grumble.Repository
+	byID    map[shared.GrumbleID]*grumble.Grumble
+	deleted []shared.GrumbleID
+}
+
+func (r *fakeDeletableGrumbleRepo) FindByIDIncludingArchive(_ context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	if g, ok := r.byID[id]; ok {
+		return g, nil
+	}
+	return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(id)}
+}
+
+func (r *fakeDeletableGrumbleRepo) DeleteByAuthor(_ context.Context, id shared.GrumbleID) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+const testDeletedGrumbleID shared.GrumbleID = "00000000-0000-0000-0000-0000000000cc"
+
+func TestGrumbleDeleteUseCase_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		grumbleID   shared.GrumbleID
+		userID      shared.UserID
+		wantDeleted bool
+	}{
+		{"自分の投稿は削除できる", testGrumbleID, testAuthorID, true},
+		{"他人の投稿は見つからない扱い", testGrumbleID, "00000000-0000-0000-0000-000000000002", false},
+		{"存在しない投稿", "00000000-0000-0000-0000-0000000000bb", testAuthorID, false},
+		{"削除済みの投稿は見つからない扱い", testDeletedGrumbleID, testAuthorID, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDeletableGrumbleRepo{byID: map[shared.GrumbleID]*grumble.Grumble{
+				testGrumbleID:        {GrumbleID: testGrumbleID, UserID: testAuthorID, ModerationStatus: grumble.ModerationStatusPublished, Status: grumble.StatusActive},
+				testDeletedGrumbleID: {GrumbleID: testDeletedGrumbleID, UserID: testAuthorID, ModerationStatus: grumble.ModerationStatusPublished, Status: grumble.StatusDeleted},
+			}}
+			uc := NewGrumbleDeleteUseCase(repo, slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil)))
+
+			err := uc.Delete(context.Background(), DeleteGrumbleRequest{GrumbleID: tt.grumbleID, UserID: tt.userID})
+
+			if tt.wantDeleted {
+				if err != nil {
+					t.Fatalf("Delete() error = %v", err)
+				}
+				if len(repo.deleted) != 1 || repo.deleted[0] != tt.grumbleID {
+					t.Errorf("deleted = %v, want [%s]", repo.deleted, tt.grumbleID)
+				}
+				return
+			}
+			var notFoundErr *shared.NotFoundError
+			if !errors.As(err, &notFoundErr) {
+				t.Fatalf("Delete() error = %v, want NotFoundError", err)
+			}
+			if len(repo.deleted) != 0 {
+				t.Errorf("deleted = %v, want none", repo.deleted)
+			}
+		})
+	}
+}
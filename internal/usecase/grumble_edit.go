@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// EditGrumbleRequest represents an author's correction of a grumble shortly after posting
+type EditGrumbleRequest struct {
+	GrumbleID  shared.GrumbleID
+	UserID     shared.UserID
+	Content    string
+	ToxicLevel shared.ToxicLevel
+}
+
+// Edit replaces the content and toxic level of the author's grumble within the edit window and before any vibe.
+// The new content goes through the spam policy, validation and moderation like a new post,
+// and a rejection counts toward sanctions. A rejected edit is refused and the grumble stays as it was.
+func (uc *GrumblePostUseCase) Edit(ctx context.Context, req EditGrumbleRequest) (*grumble.Grumble, error) {
+	g, err := uc.grumbleRepo.FindByID(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+	if g.UserID != req.UserID {
+		return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(req.GrumbleID)}
+	}
+
+	now := time.Now()
+	if err := g.CheckEditable(uc.editWindow, now); err != nil {
+		return nil, err
+	}
+
+	// Sanctioned users cannot slip new content in through an edit
+	var standing sanction.Standing
+	if uc.postingGuard != nil {
+		standing, err = uc.postingGuard.CheckPosting(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The version being replaced was logged when it was posted or last edited
+	replaced := grumble.PostLogEntry{Fingerprint: grumble.NewFingerprint(g.Content), PostedAt: g.PostedAt}
+	if g.EditedAt != nil {
+		replaced.PostedAt = *g.EditedAt
+	}
+
+	revision, err := g.Edit(grumble.NormalizeContent(req.Content), req.ToxicLevel, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+
+	// An edit cannot turn a grumble into a repeat of another recent one
+	entry := grumble.PostLogEntry{Fingerprint: grumble.NewFingerprint(g.Content), PostedAt: now}
+	if err := uc.reservePost(ctx, req.UserID, entry, &replaced); err != nil {
+		return nil, err
+	}
+
+	verdict, result, prompt, err := uc.moderate(ctx, g)
+	if err != nil {
+		uc.releasePost(ctx, req.UserID, entry)
+		return nil, err
+	}
+	if verdict != nil {
+		verdict.GrumbleID = &g.GrumbleID
+		recordVerdict(ctx, uc.verdictRepo, uc.logger, verdict)
+	}
+
+	if g.IsRejected() {
+		uc.releasePost(ctx, req.UserID, entry)
+		recordRejection(ctx, uc.postingGuard, uc.logger, g.UserID)
+		return nil, &shared.InappropriateContentError{
+			Reason:           result.Reason,
+			SuggestedRewrite: uc.suggestRewrite(ctx, g.Content, prompt, result),
+		}
+	}
+
+	if standing.ShadowBanned {
+		if err := g.Shadow(); err != nil {
+			uc.releasePost(ctx, req.UserID, entry)
+			return nil, err
+		}
+	}
+
+	if err := uc.grumbleRepo.SaveEdit(ctx, g, revision); err != nil {
+		uc.releasePost(ctx, req.UserID, entry)
+		return nil, err
+	}
+	uc.releasePost(ctx, req.UserID, replaced)
+
+	if g.IsHeld() {
+		return nil, uc.holdForCrisisSupport(ctx, g)
+	}
+
+	return g, nil
+}
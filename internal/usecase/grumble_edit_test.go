@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+)
+
+// fakeEditableGrumbleRepo serves a single live grumble and records saved edits.
+type fakeEditableGrumbleRepo struct {
+	grumble.Repository
+	grumble   *grumble.Grumble
+	revisions []*grumble.Revision
+}
+
+func (r *fakeEditableGrumbleRepo) FindByID(_ context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	if r.grumble == nil || r.grumble.GrumbleID != id {
+		return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(id)}
+	}
+	copied := *r.grumble
+	return &copied, nil
+}
+
+func (r *fakeEditableGrumbleRepo) SaveEdit(_ context.Context, g *grumble.Grumble, revision *grumble.Revision) error {
+	r.grumble = g
+	r.revisions = append(r.revisions, revision)
+	return nil
+}
+
+func TestGrumblePostUseCase_Edit(t *testing.T) {
+	const original = "月曜日つらいい"
+	appropriate := &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}
+	filter := &fakeContentFilter{
+		result: appropriate,
+		byContent: map[string]*grumble.ModerationResult{
+			"上司のばか": {IsAppropriate: false, Categories: []grumble.ModerationCategory{grumble.ModerationCategoryHarassment}, Reason: "誹謗中傷"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		userID      shared.UserID
+		postedAgo   time.Duration
+		vibeCount   int
+		content     string
+		wantErr     error
+		wantContent string
+	}{
+		{"編集期限内なら本文と毒レベルを編集できる", testAuthorID, 10 * time.Second, 0, "  月曜日つらい ", nil, "月曜日つらい"},
+		{"他人の投稿は見つからない扱い", "00000000-0000-0000-0000-000000000002", 10 * time.Second, 0, "月曜日つらい", &shared.NotFoundError{}, original},
+		{"編集期限を過ぎたら不可", testAuthorID, 2 * time.Minute, 0, "月曜日つらい", &shared.ConflictError{}, original},
+		{"わかるが付いたら不可", testAuthorID, 10 * time.Second, 1, "月曜日つらい", &shared.ConflictError{}, original},
+		{"空の本文は不可", testAuthorID, 10 * time.Second, 0, " ", &shared.ValidationError{}, original},
+		{"不適切な本文への編集は拒否され元の投稿が残る", testAuthorID, 10 * time.Second, 0, "上司のばか", &shared.InappropriateContentError{}, original},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeEditableGrumbleRepo{grumble: &grumble.Grumble{
+				GrumbleID:         testGrumbleID,
+				UserID:            testAuthorID,
+				Content:           original,
+				ToxicLevel:        shared.ToxicLevel2,
+				VibeCount:         tt.vibeCount,
+				PurifiedThreshold: 10,
+				PostedAt:          time.Now().Add(-tt.postedAgo),
+				ExpiresAt:         time.Now().Add(time.Hour),
+				ModerationStatus:  grumble.ModerationStatusPublished,
+				Status:            grumble.StatusActive,
+			}}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, time.Minute, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			g, err := uc.Edit(context.Background(), EditGrumbleRequest{
+				GrumbleID:  testGrumbleID,
+				UserID:     tt.userID,
+				Content:    tt.content,
+				ToxicLevel: shared.ToxicLevel3,
+			})
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("Edit() error = %v", err)
+				}
+				if !g.IsEdited() || g.ToxicLevel != shared.ToxicLevel3 {
+					t.Errorf("grumble = %+v, want edited with toxic level 3", g)
+				}
+				if len(repo.revisions) != 1 || repo.revisions[0].Content != original {
+					t.Errorf("revisions = %+v, want the original version", repo.revisions)
+				}
+			case *shared.NotFoundError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want NotFoundError", err)
+				}
+			case *shared.ConflictError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want ConflictError", err)
+				}
+			case *shared.ValidationError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want ValidationError", err)
+				}
+			case *shared.InappropriateContentError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want InappropriateContentError", err)
+				}
+			}
+			if tt.wantErr != nil && len(repo.revisions) != 0 {
+				t.Errorf("revisions = %+v, want none", repo.revisions)
+			}
+			if repo.grumble.Content != tt.wantContent {
+				t.Errorf("stored content = %q, want %q", repo.grumble.Content, tt.wantContent)
+			}
+		})
+	}
+}
+
+// fakePostingGuard lets everyone post and counts reported rejections.
+type fakePostingGuard struct {
+	PostingGuard
+	rejections int
+}
+
+func (g *fakePostingGuard) CheckPosting(context.Context, shared.UserID) (sanction.Standing, error) {
+	return sanction.Standing{}, nil
+}
+
+func (g *fakePostingGuard) RecordRejection(context.Context, shared.UserID) error {
+	g.rejections++
+	return nil
+}
+
+func TestGrumblePostUseCase_Edit_SpamAndSanctions(t *testing.T) {
+	const original = "月曜日つらいい"
+	other := grumble.NewFingerprint("残業が終わらない")
+
+	tests := []struct {
+		name           string
+		content        string
+		wantErr        error
+		wantRejections int
+		wantLog        []grumble.Fingerprint
+	}{
+		{"自分の元の投稿とほぼ同じ編集はできる", "月曜日つらい", nil, 0,
+			[]grumble.Fingerprint{other, grumble.NewFingerprint("月曜日つらい")}},
+		{"他の最近の投稿と同じ内容への編集は重複", "残業が終わらない！", &shared.DuplicateGrumbleError{}, 0,
+			[]grumble.Fingerprint{grumble.NewFingerprint(original), other}},
+		{"拒否された編集は制裁の判定に数える", "上司のばか", &shared.InappropriateContentError{}, 1,
+			[]grumble.Fingerprint{grumble.NewFingerprint(original), other}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postedAt := time.Now().Add(-10 * time.Second)
+			repo := &fakeEditableGrumbleRepo{grumble: &grumble.Grumble{
+				GrumbleID:         testGrumbleID,
+				UserID:            testAuthorID,
+				Content:           original,
+				ToxicLevel:        shared.ToxicLevel2,
+				PurifiedThreshold: 10,
+				PostedAt:          postedAt,
+				ExpiresAt:         time.Now().Add(time.Hour),
+				ModerationStatus:  grumble.ModerationStatusPublished,
+				Status:            grumble.StatusActive,
+			}}
+			postLog := &fakePostLog{entries: map[shared.UserID][]grumble.PostLogEntry{testAuthorID: {
+				{Fingerprint: grumble.NewFingerprint(original), PostedAt: postedAt},
+				{Fingerprint: other, PostedAt: postedAt.Add(time.Second)},
+			}}}
+			filter := &fakeContentFilter{
+				result: &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"},
+				byContent: map[string]*grumble.ModerationResult{
+					"上司のばか": {IsAppropriate: false, Reason: "誹謗中傷"},
+				},
+			}
+			guard := &fakePostingGuard{}
+			policy := grumble.SpamPolicy{DuplicateWindow: 10 * time.Minute, DailyQuota: 2}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, guard, postLog, policy, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, time.Minute, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			_, err := uc.Edit(context.Background(), EditGrumbleRequest{
+				GrumbleID:  testGrumbleID,
+				UserID:     testAuthorID,
+				Content:    tt.content,
+				ToxicLevel: shared.ToxicLevel2,
+			})
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("Edit() error = %v", err)
+				}
+			case *shared.DuplicateGrumbleError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want DuplicateGrumbleError", err)
+				}
+			case *shared.InappropriateContentError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Edit() error = %v, want InappropriateContentError", err)
+				}
+			}
+			if guard.rejections != tt.wantRejections {
+				t.Errorf("rejections recorded = %d, want %d", guard.rejections, tt.wantRejections)
+			}
+			// An edit replaces its grumble's entry instead of adding to the quota
+			var got []grumble.Fingerprint
+			for _, e := range postLog.entries[testAuthorID] {
+				got = append(got, e.Fingerprint)
+			}
+			if !slices.Equal(got, tt.wantLog) {
+				t.Errorf("post log = %v, want %v", got, tt.wantLog)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/vibe"
+)
+
+// GrumbleGetUseCase retrieves a single grumble for deep links and notification taps
+type GrumbleGetUseCase struct {
+	grumbleRepo grumble.Repository
+	vibeRepo    vibe.Repository
+}
+
+// NewGrumbleGetUseCase creates a new GrumbleGetUseCase.
+func NewGrumbleGetUseCase(grumbleRepo grumble.Repository, vibeRepo vibe.Repository) *GrumbleGetUseCase {
+	return &GrumbleGetUseCase{
+		grumbleRepo: grumbleRepo,
+		vibeRepo:    vibeRepo,
+	}
+}
+
+// GetGrumbleRequest represents a request for a single grumble
+type GetGrumbleRequest struct {
+	GrumbleID    shared.GrumbleID
+	ViewerUserID *shared.UserID // Authenticated viewer; nil when anonymous
+}
+
+// Get returns the grumble, falling back to the archive once it has expired.
+// Grumbles the viewer may not see, such as those moderated away or deleted by their author, are reported as not found.
+func (uc *GrumbleGetUseCase) Get(ctx context.Context, req GetGrumbleRequest) (*grumble.Grumble, error) {
+	g, err := uc.grumbleRepo.FindByID(ctx, req.GrumbleID)
+	var notFoundErr *shared.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		g, err = uc.grumbleRepo.FindExpiredByID(ctx, req.GrumbleID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !g.IsVisibleTo(req.ViewerUserID) {
+		return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(req.GrumbleID)}
+	}
+
+	if req.ViewerUserID != nil {
+		hasVibed, err := uc.vibeRepo.Exists(ctx, g.GrumbleID, *req.ViewerUserID)
+		if err != nil {
+			return nil, err
+		}
+		g.HasVibed = &hasVibed
+	}
+
+	return g, nil
+}
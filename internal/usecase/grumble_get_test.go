@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/vibe"
+)
+
+// fakeDetailGrumbleRepo serves grumbles from a live and an expired archive map.
+type fakeDetailGrumbleRepo struct {
+	grumble.Repository
+	live    map[shared.GrumbleID]*grumble.Grumble
+	expired map[shared.GrumbleID]*grumble.Grumble
+}
+
+func (r *fakeDetailGrumbleRepo) FindByID(_ context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	if g, ok := r.live[id]; ok {
+		copied := *g
+		return &copied, nil
+	}
+	return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(id)}
+}
+
+func (r *fakeDetailGrumbleRepo) FindExpiredByID(_ context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	if g, ok := r.expired[id]; ok {
+		copied := *g
+		return &copied, nil
+	}
+	return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(id)}
+}
+
+// fakeVibedRepo reports the viewers who vibed each grumble.
+type fakeVibedRepo struct {
+	vibe.Repository
+	vibed map[shared.GrumbleID]shared.UserID
+}
+
+func (r *fakeVibedRepo) Exists(_ context.Context, grumbleID shared.GrumbleID, userID shared.UserID) (bool, error) {
+	return r.vibed[grumbleID] == userID, nil
+}
+
+func TestGrumbleGetUseCase_Get(t *testing.T) {
+	const (
+		viewerID   shared.UserID    = "00000000-0000-0000-0000-000000000002"
+		expiredID  shared.GrumbleID = "00000000-0000-0000-0000-0000000000a2"
+		heldID     shared.GrumbleID = "00000000-0000-0000-0000-0000000000a3"
+		rejectedID shared.GrumbleID = "00000000-0000-0000-0000-0000000000a4"
+		shadowedID shared.GrumbleID = "00000000-0000-0000-0000-0000000000a5"
+		missingID  shared.GrumbleID = "00000000-0000-0000-0000-0000000000bb"
+	)
+	now := time.Now()
+	stored := func(id shared.GrumbleID, lifecycle grumble.Status, status grumble.ModerationStatus, expiresAt time.Time) *grumble.Grumble {
+		return &grumble.Grumble{GrumbleID: id, UserID: testAuthorID, Status: lifecycle, ModerationStatus: status, PostedAt: now.Add(-time.Hour), ExpiresAt: expiresAt}
+	}
+	repo := &fakeDetailGrumbleRepo{
+		live: map[shared.GrumbleID]*grumble.Grumble{
+			testGrumbleID: stored(testGrumbleID, grumble.StatusActive, grumble.ModerationStatusPublished, now.Add(time.Hour)),
+			heldID:        stored(heldID, grumble.StatusActive, grumble.ModerationStatusHeld, now.Add(time.Hour)),
+			rejectedID:    stored(rejectedID, grumble.StatusRemoved, grumble.ModerationStatusRejected, now.Add(time.Hour)),
+			shadowedID:    stored(shadowedID, grumble.StatusActive, grumble.ModerationStatusShadowed, now.Add(time.Hour)),
+		},
+		expired: map[shared.GrumbleID]*grumble.Grumble{
+			expiredID: stored(expiredID, grumble.StatusExpired, grumble.ModerationStatusPublished, now.Add(-time.Minute)),
+		},
+	}
+	vibes := &fakeVibedRepo{vibed: map[shared.GrumbleID]shared.UserID{testGrumbleID: viewerID, expiredID: viewerID}}
+	uc := NewGrumbleGetUseCase(repo, vibes)
+	viewer, author := viewerID, testAuthorID
+	ptr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name         string
+		grumbleID    shared.GrumbleID
+		viewer       *shared.UserID
+		wantHasVibed *bool
+		wantExpired  bool
+		wantNotFound bool
+	}{
+		{"公開中の投稿", testGrumbleID, &viewer, ptr(true), false, false},
+		{"未ログインでは has_vibed なし", testGrumbleID, nil, nil, false, false},
+		{"期限切れはアーカイブから", expiredID, &viewer, ptr(true), true, false},
+		{"保留中は投稿者本人だけ", heldID, &author, ptr(false), false, false},
+		{"保留中は他人には見つからない", heldID, &viewer, nil, false, true},
+		{"シャドウバン中は他人には見つからない", shadowedID, &viewer, nil, false, true},
+		{"却下された投稿は本人にも見つからない", rejectedID, &author, nil, false, true},
+		{"存在しない投稿", missingID, &viewer, nil, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := uc.Get(context.Background(), GetGrumbleRequest{GrumbleID: tt.grumbleID, ViewerUserID: tt.viewer})
+
+			if tt.wantNotFound {
+				var notFoundErr *shared.NotFoundError
+				if !errors.As(err, &notFoundErr) {
+					t.Fatalf("Get() error = %v, want NotFoundError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if g.IsExpired() != tt.wantExpired {
+				t.Errorf("IsExpired() = %v, want %v", g.IsExpired(), tt.wantExpired)
+			}
+			switch {
+			case tt.wantHasVibed == nil && g.HasVibed != nil:
+				t.Errorf("HasVibed = %v, want nil", *g.HasVibed)
+			case tt.wantHasVibed != nil && (g.HasVibed == nil || *g.HasVibed != *tt.wantHasVibed):
+				t.Errorf("HasVibed = %v, want %v", g.HasVibed, *tt.wantHasVibed)
+			}
+		})
+	}
+}
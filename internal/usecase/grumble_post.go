@@ -3,22 +3,40 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
 	"github.com/dokkiitech/grumble-back/internal/domain/shared"
 	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+	"github.com/dokkiitech/grumble-back/internal/logging"
 	"github.com/google/uuid"
 )
 
-// GrumblePostUseCase handles posting new grumbles
+// GrumblePostUseCase handles posting new grumbles and editing them shortly after posting
 type GrumblePostUseCase struct {
 	grumbleRepo              grumble.Repository
 	eventTimeSvc             *sharedservice.EventTimeService
 	contentFilter            grumble.ContentFilterClient
+	promptSelector           *grumble.PromptSelector
+	verdictRepo              moderation.VerdictRepository
+	rewriteSuggester         grumble.RewriteSuggester // nil disables rewrite suggestions
+	topicSuggester           grumble.TopicSuggester   // nil disables topic suggestions
+	postingGuard             PostingGuard             // nil disables sanctions
+	postLog                  grumble.PostLog          // nil disables spam detection
+	spamPolicy               grumble.SpamPolicy
+	lifetimePolicy           grumble.LifetimePolicy
+	categories               grumble.CategoryCatalogue
+	editWindow               time.Duration // How long after posting the author may edit; zero disables editing
+	asyncModeration          bool
+	toxicLevelPolicy         grumble.ToxicLevelPolicy
 	purifiedThresholdDefault int
 	purifiedThresholdMin     int
 	purifiedThresholdMax     int
+	crisisSupport            shared.CrisisSupport
+	logger                   logging.Logger
 }
 
 // NewGrumblePostUseCase creates a new GrumblePostUseCase
@@ -26,17 +44,45 @@ func NewGrumblePostUseCase(
 	grumbleRepo grumble.Repository,
 	eventTimeSvc *sharedservice.EventTimeService,
 	contentFilter grumble.ContentFilterClient,
+	promptSelector *grumble.PromptSelector,
+	verdictRepo moderation.VerdictRepository,
+	rewriteSuggester grumble.RewriteSuggester,
+	topicSuggester grumble.TopicSuggester,
+	postingGuard PostingGuard,
+	postLog grumble.PostLog,
+	spamPolicy grumble.SpamPolicy,
+	lifetimePolicy grumble.LifetimePolicy,
+	categories grumble.CategoryCatalogue,
+	editWindow time.Duration,
+	asyncModeration bool,
+	toxicLevelPolicy grumble.ToxicLevelPolicy,
 	purifiedThresholdDefault int,
 	purifiedThresholdMin int,
 	purifiedThresholdMax int,
+	crisisSupport shared.CrisisSupport,
+	logger logging.Logger,
 ) *GrumblePostUseCase {
 	return &GrumblePostUseCase{
 		grumbleRepo:              grumbleRepo,
 		eventTimeSvc:             eventTimeSvc,
 		contentFilter:            contentFilter,
+		promptSelector:           promptSelector,
+		verdictRepo:              verdictRepo,
+		rewriteSuggester:         rewriteSuggester,
+		topicSuggester:           topicSuggester,
+		postingGuard:             postingGuard,
+		postLog:                  postLog,
+		spamPolicy:               spamPolicy,
+		lifetimePolicy:           lifetimePolicy,
+		categories:               categories,
+		editWindow:               editWindow,
+		asyncModeration:          asyncModeration,
+		toxicLevelPolicy:         toxicLevelPolicy,
 		purifiedThresholdDefault: purifiedThresholdDefault,
 		purifiedThresholdMin:     purifiedThresholdMin,
 		purifiedThresholdMax:     purifiedThresholdMax,
+		crisisSupport:            crisisSupport,
+		logger:                   logger,
 	}
 }
 
@@ -45,26 +91,15 @@ type PostGrumbleRequest struct {
 	UserID            shared.UserID
 	Content           string
 	ToxicLevel        shared.ToxicLevel
-	PurifiedThreshold *int // Optional: if nil, use default
+	PurifiedThreshold *int    // Optional: if nil, use default
+	Lifetime          *string // Optional: one of the configured lifetimes; if nil, use default
+	Timezone          string  // Author's IANA timezone; empty uses the default zone
+	Category          *string // Optional: one of the configured category keys
 	IsEventGrumble    bool
 }
 
 // Post creates and persists a new grumble
 func (uc *GrumblePostUseCase) Post(ctx context.Context, req PostGrumbleRequest) (*grumble.Grumble, error) {
-	// Filter content if content filter is configured
-	if uc.contentFilter != nil {
-		result, err := uc.contentFilter.FilterContent(ctx, req.Content)
-		if err != nil {
-			return nil, err
-		}
-
-		if !result.IsAppropriate {
-			return nil, &shared.InappropriateContentError{
-				Reason: result.Reason,
-			}
-		}
-	}
-
 	// Determine purified threshold: use provided value or default
 	purifiedThreshold := uc.purifiedThresholdDefault
 	if req.PurifiedThreshold != nil {
@@ -79,30 +114,261 @@ func (uc *GrumblePostUseCase) Post(ctx context.Context, req PostGrumbleRequest)
 		}
 	}
 
+	lifetime, err := uc.lifetimePolicy.Resolve(req.Lifetime)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := uc.categories.Resolve(req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	// Midnight expiry follows the author's own timezone
+	loc := uc.eventTimeSvc.Location(req.Timezone)
+
 	// Create grumble entity
 	now := time.Now()
 	g := &grumble.Grumble{
 		GrumbleID:         shared.GrumbleID(uuid.New().String()),
 		UserID:            req.UserID,
-		Content:           req.Content,
+		Content:           grumble.NormalizeContent(req.Content),
 		ToxicLevel:        req.ToxicLevel,
 		VibeCount:         0,
 		PurifiedThreshold: purifiedThreshold,
-		IsPurified:        false,
+		Status:            grumble.StatusActive,
 		PostedAt:          now,
-		ExpiresAt:         uc.eventTimeSvc.CalculateNextMidnight(now), // 翌日の00:00
+		ExpiresAt:         uc.lifetimePolicy.ExpiresAt(lifetime, now, uc.eventTimeSvc.In(loc).CalculateNextMidnight(now)),
+		Lifetime:          lifetime,
 		IsEventGrumble:    req.IsEventGrumble,
+		ModerationStatus:  grumble.ModerationStatusPublished,
+		Timezone:          loc.String(),
+		Category:          category,
 	}
+	g.RefreshHashtags()
 
-	// Validate business rules
+	// Validate business rules before spending a moderation call
 	if err := g.Validate(); err != nil {
 		return nil, err
 	}
 
+	// Banned users and users in a cooldown cannot post; shadow-banned users can, but only they see the result
+	var standing sanction.Standing
+	if uc.postingGuard != nil {
+		var err error
+		standing, err = uc.postingGuard.CheckPosting(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Repeats of a recent grumble and posts beyond the daily quota are refused before moderation.
+	// The post is logged at the same time, so concurrent identical posts cannot all pass.
+	entry := grumble.PostLogEntry{Fingerprint: grumble.NewFingerprint(g.Content), PostedAt: now}
+	if err := uc.reservePost(ctx, req.UserID, entry, nil); err != nil {
+		return nil, err
+	}
+
+	// Rejected drafts are kept privately, so the author can appeal
+	verdict, result, prompt, err := uc.moderate(ctx, g)
+	if err != nil {
+		uc.releasePost(ctx, req.UserID, entry)
+		return nil, err
+	}
+
+	if standing.ShadowBanned {
+		if err := g.Shadow(); err != nil {
+			uc.releasePost(ctx, req.UserID, entry)
+			return nil, err
+		}
+	}
+
+	uc.suggestTopic(ctx, g)
+
 	// Persist to repository
 	if err := uc.grumbleRepo.Create(ctx, g); err != nil {
+		uc.releasePost(ctx, req.UserID, entry)
 		return nil, err
 	}
 
+	if verdict != nil {
+		verdict.GrumbleID = &g.GrumbleID
+		recordVerdict(ctx, uc.verdictRepo, uc.logger, verdict)
+	}
+
+	if g.IsRejected() {
+		recordRejection(ctx, uc.postingGuard, uc.logger, g.UserID)
+		return nil, &shared.InappropriateContentError{
+			Reason:           result.Reason,
+			GrumbleID:        &g.GrumbleID,
+			SuggestedRewrite: uc.suggestRewrite(ctx, g.Content, prompt, result),
+		}
+	}
+
+	if g.IsHeld() {
+		return nil, uc.holdForCrisisSupport(ctx, g)
+	}
+
 	return g, nil
 }
+
+// moderate runs the content filter on g and applies its decision to g's moderation status and toxic level.
+// In async mode g is only marked as pending for the batch worker, and no verdict or result is returned.
+func (uc *GrumblePostUseCase) moderate(ctx context.Context, g *grumble.Grumble) (*moderation.Verdict, *grumble.ModerationResult, grumble.ModerationPrompt, error) {
+	if uc.contentFilter == nil {
+		return nil, nil, grumble.ModerationPrompt{}, nil
+	}
+	if uc.asyncModeration {
+		return nil, nil, grumble.ModerationPrompt{}, g.AwaitModeration()
+	}
+
+	prompt := uc.promptSelector.Select(g.UserID)
+	started := time.Now()
+	result, err := uc.contentFilter.FilterContent(ctx, grumble.ModerationRequest{Content: g.Content, Prompt: prompt})
+	if err != nil {
+		return nil, nil, prompt, err
+	}
+	verdict := newVerdict(g.UserID, g.Content, result, time.Since(started))
+
+	// Self-harm is never rejected outright: the grumble is kept private and support is offered instead
+	if result.IsSelfHarm() {
+		err = g.Hold()
+	} else if !result.IsAppropriate {
+		err = g.Reject()
+	}
+	if err != nil {
+		return nil, nil, prompt, err
+	}
+
+	selfReported := g.ToxicLevel
+	if uc.toxicLevelPolicy.Apply(g, result.EstimatedLevel()) {
+		uc.logger.InfoContext(ctx, "Self-reported toxic level diverges from estimate",
+			"policy", uc.toxicLevelPolicy.Mode,
+			"self_reported", selfReported,
+			"estimated", *g.AIToxicLevel,
+		)
+	}
+
+	return verdict, result, prompt, nil
+}
+
+// holdForCrisisSupport audits a grumble held for self-harm and returns the error offering support to its author
+func (uc *GrumblePostUseCase) holdForCrisisSupport(ctx context.Context, g *grumble.Grumble) error {
+	// Audit entry kept separate from request logs; content itself is never logged
+	uc.logger.WarnContext(ctx, "Grumble held for crisis support",
+		"audit_event", "moderation.self_harm_held",
+		"grumble_id", g.GrumbleID,
+		"user_id", g.UserID,
+		"content_hash", grumble.HashContent(g.Content),
+	)
+	return &shared.SelfHarmContentError{
+		GrumbleID: g.GrumbleID,
+		Support:   uc.crisisSupport,
+	}
+}
+
+// reservePost applies the spam policy to the user's recent posts and logs the post in one step.
+// For an edit, replaced is the entry of the version being replaced; it neither duplicates the edit nor counts twice.
+func (uc *GrumblePostUseCase) reservePost(ctx context.Context, userID shared.UserID, entry grumble.PostLogEntry, replaced *grumble.PostLogEntry) error {
+	if uc.postLog == nil {
+		return nil
+	}
+
+	since := entry.PostedAt.Add(-uc.spamPolicy.Lookback())
+	return uc.postLog.Reserve(ctx, userID, since, entry, func(recent []grumble.PostLogEntry) error {
+		if replaced != nil {
+			recent = slices.DeleteFunc(recent, func(e grumble.PostLogEntry) bool {
+				return e.Fingerprint == replaced.Fingerprint && e.PostedAt.Equal(replaced.PostedAt)
+			})
+		}
+		if err := uc.spamPolicy.Check(entry.Fingerprint, recent, entry.PostedAt); err != nil {
+			uc.logger.InfoContext(ctx, "Grumble refused by spam policy", "user_id", userID, "reason", err.Error())
+			return err
+		}
+		return nil
+	})
+}
+
+// releasePost drops the log entry of a post that was not stored or was replaced by an edit, so it no longer counts
+func (uc *GrumblePostUseCase) releasePost(ctx context.Context, userID shared.UserID, entry grumble.PostLogEntry) {
+	if uc.postLog == nil {
+		return
+	}
+	if err := uc.postLog.Release(ctx, userID, entry); err != nil {
+		uc.logger.ErrorContext(ctx, "Failed to release post log entry", "user_id", userID, "error", err)
+	}
+}
+
+func newVerdict(userID shared.UserID, content string, result *grumble.ModerationResult, latency time.Duration) *moderation.Verdict {
+	return &moderation.Verdict{
+		ContentHash:   grumble.HashContent(content),
+		UserID:        userID,
+		Decision:      moderation.DecisionFromResult(result),
+		Categories:    result.Categories,
+		Reason:        result.Reason,
+		Model:         result.Model,
+		PromptVersion: result.PromptVersion,
+		Latency:       latency,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// recordVerdict appends to the verdict log; a logging failure must not block moderation
+func recordVerdict(ctx context.Context, verdictRepo moderation.VerdictRepository, logger logging.Logger, verdict *moderation.Verdict) {
+	if verdictRepo == nil {
+		return
+	}
+	if err := verdictRepo.Create(ctx, verdict); err != nil {
+		logger.ErrorContext(ctx, "Failed to record moderation verdict", "decision", verdict.Decision, "error", err)
+	}
+}
+
+// suggestRewrite asks for a softened rewrite of rejected content and only returns it if it passes moderation itself.
+// Failures are logged and yield no suggestion; the rejection is still returned to the user.
+func (uc *GrumblePostUseCase) suggestRewrite(ctx context.Context, content string, prompt grumble.ModerationPrompt, result *grumble.ModerationResult) *string {
+	if uc.rewriteSuggester == nil {
+		return nil
+	}
+
+	rewrite, err := uc.rewriteSuggester.SuggestRewrite(ctx, content, result)
+	if err != nil {
+		uc.logger.WarnContext(ctx, "Failed to suggest rewrite", "error", err)
+		return nil
+	}
+
+	// Offer only rewrites that could actually be posted
+	rewrite = grumble.NormalizeContent(rewrite)
+	if length := grumble.ContentLength(rewrite); length == 0 || length > grumble.MaxContentLength {
+		uc.logger.InfoContext(ctx, "Rewrite suggestion has invalid length", "length", length)
+		return nil
+	}
+
+	rewriteResult, err := uc.contentFilter.FilterContent(ctx, grumble.ModerationRequest{Content: rewrite, Prompt: prompt})
+	if err != nil {
+		uc.logger.WarnContext(ctx, "Failed to moderate rewrite suggestion", "error", err)
+		return nil
+	}
+	if !rewriteResult.IsAppropriate || rewriteResult.IsSelfHarm() {
+		uc.logger.InfoContext(ctx, "Rewrite suggestion rejected by moderation", "categories", rewriteResult.Categories)
+		return nil
+	}
+
+	return &rewrite
+}
+
+// suggestTopic tags a grumble its author did not tag with a topic suggested by AI.
+// Only grumbles that passed moderation at post time are sent; failures are logged and leave the grumble untagged.
+func (uc *GrumblePostUseCase) suggestTopic(ctx context.Context, g *grumble.Grumble) {
+	if uc.topicSuggester == nil || g.HasHashtags() || !g.IsPublished() {
+		return
+	}
+
+	topic, err := uc.topicSuggester.SuggestTopic(ctx, g.Content)
+	if err != nil {
+		uc.logger.WarnContext(ctx, "Failed to suggest topic", "error", err)
+		return
+	}
+	if err := g.SetTopic(topic); err != nil {
+		uc.logger.InfoContext(ctx, "Topic suggestion is not a valid tag", "error", err)
+	}
+}
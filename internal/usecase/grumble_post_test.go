@@ -0,0 +1,614 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+)
+
+// fakeContentFilter returns a fixed moderation result, or a per-content one when byContent has an entry.
+type fakeContentFilter struct {
+	result    *grumble.ModerationResult
+	byContent map[string]*grumble.ModerationResult
+	err       error
+}
+
+func (f *fakeContentFilter) FilterContent(_ context.Context, req grumble.ModerationRequest) (*grumble.ModerationResult, error) {
+	if r, ok := f.byContent[req.Content]; ok {
+		return r, nil
+	}
+	return f.result, f.err
+}
+
+// fakeRewriteSuggester returns a fixed rewrite.
+type fakeRewriteSuggester struct {
+	rewrite string
+}
+
+func (f *fakeRewriteSuggester) SuggestRewrite(_ context.Context, _ string, _ *grumble.ModerationResult) (string, error) {
+	return f.rewrite, nil
+}
+
+// fakeTopicSuggester returns a fixed topic and counts calls.
+type fakeTopicSuggester struct {
+	topic string
+	err   error
+	calls int
+}
+
+func (f *fakeTopicSuggester) SuggestTopic(_ context.Context, _ string) (string, error) {
+	f.calls++
+	return f.topic, f.err
+}
+
+// fakeGrumbleRepo records created grumbles; other methods are not used by these tests.
+type fakeGrumbleRepo struct {
+	grumble.Repository
+	created []*grumble.Grumble
+}
+
+func (r *fakeGrumbleRepo) Create(_ context.Context, g *grumble.Grumble) error {
+	r.created = append(r.created, g)
+	return nil
+}
+
+// fakeVerdictRepo records appended verdicts; other methods are not used by these tests.
+type fakeVerdictRepo struct {
+	moderation.VerdictRepository
+	created []*moderation.Verdict
+}
+
+func (r *fakeVerdictRepo) Create(_ context.Context, v *moderation.Verdict) error {
+	r.created = append(r.created, v)
+	return nil
+}
+
+var testCrisisSupport = shared.CrisisSupport{
+	Message: "ひとりで抱えこまないでください",
+	Resources: []shared.SupportResource{
+		{Name: "いのちの電話", Contact: "0570-783-556"},
+	},
+}
+
+// newTestPromptSelector serves a single prompt version to every user.
+func newTestPromptSelector(t *testing.T) *grumble.PromptSelector {
+	t.Helper()
+	prompts := map[string]grumble.ModerationPrompt{
+		"v1": {Version: "v1", Template: "moderate: " + grumble.ModerationPromptPlaceholder},
+	}
+	selector, err := grumble.NewPromptSelector(prompts, "v1", "", 0)
+	if err != nil {
+		t.Fatalf("NewPromptSelector() error = %v", err)
+	}
+	return selector
+}
+
+func newTestPostUseCase(t *testing.T, filter grumble.ContentFilterClient, repo grumble.Repository, verdicts moderation.VerdictRepository, logs *bytes.Buffer) *GrumblePostUseCase {
+	logger := slog.New(slog.NewJSONHandler(logs, nil))
+	return NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), verdicts, nil, nil, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+}
+
+func TestGrumblePostUseCase_Post_Lifetime(t *testing.T) {
+	policy := grumble.LifetimePolicy{Options: []grumble.Lifetime{"1h", "6h", "24h"}, Default: grumble.LifetimeUntilMidnight, Minimum: time.Hour}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name         string
+		lifetime     *string
+		timezone     string
+		wantTTL      time.Duration // Zero means until the next midnight, but no less than the minimum
+		wantTimezone string
+		wantErr      bool
+	}{
+		{"6時間", str("6h"), "", 6 * time.Hour, "Asia/Tokyo", false},
+		{"24時間", str("24h"), "", 24 * time.Hour, "Asia/Tokyo", false},
+		{"未指定なら深夜0時まで", nil, "", 0, "Asia/Tokyo", false},
+		{"投稿者のタイムゾーンの深夜0時まで", nil, "America/New_York", 0, "America/New_York", false},
+		{"未知のタイムゾーンは既定のタイムゾーン", nil, "Mars/Olympus_Mons", 0, "Asia/Tokyo", false},
+		{"許可されていない寿命", str("2h"), "", 0, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			eventTimeSvc := sharedservice.NewEventTimeService()
+			uc := NewGrumblePostUseCase(repo, eventTimeSvc, nil, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, nil, nil, grumble.SpamPolicy{}, policy, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			g, err := uc.Post(context.Background(), PostGrumbleRequest{UserID: testAuthorID, Content: "月曜日つらい", ToxicLevel: shared.ToxicLevel2, Lifetime: tt.lifetime, Timezone: tt.timezone})
+			if tt.wantErr {
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Post() error = %v, want ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+
+			want := g.PostedAt.Add(tt.wantTTL)
+			if tt.wantTTL == 0 {
+				want = eventTimeSvc.In(eventTimeSvc.Location(tt.wantTimezone)).CalculateNextMidnight(g.PostedAt)
+				if earliest := g.PostedAt.Add(time.Hour); want.Before(earliest) {
+					want = earliest
+				}
+			}
+			if !g.ExpiresAt.Equal(want) {
+				t.Errorf("ExpiresAt = %s, want %s", g.ExpiresAt, want)
+			}
+			if g.Timezone != tt.wantTimezone {
+				t.Errorf("Timezone = %q, want %q", g.Timezone, tt.wantTimezone)
+			}
+		})
+	}
+}
+
+func TestGrumblePostUseCase_Post_Category(t *testing.T) {
+	categories, err := grumble.NewCategoryCatalogue([]string{"work|仕事", "commute|通勤"})
+	if err != nil {
+		t.Fatalf("NewCategoryCatalogue() error = %v", err)
+	}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		category *string
+		want     *grumble.Category
+		wantErr  bool
+	}{
+		{"カテゴリ付きの投稿", str("commute"), (*grumble.Category)(str("commute")), false},
+		{"未指定ならカテゴリなし", nil, nil, false},
+		{"一覧にないカテゴリ", str("hobby"), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), nil, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, categories, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			g, err := uc.Post(context.Background(), PostGrumbleRequest{UserID: testAuthorID, Content: "満員電車つらい", ToxicLevel: shared.ToxicLevel2, Category: tt.category})
+			if tt.wantErr {
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Post() error = %v, want ValidationError", err)
+				}
+				if len(repo.created) != 0 {
+					t.Errorf("created %d grumbles, want 0", len(repo.created))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			if (g.Category == nil) != (tt.want == nil) || (g.Category != nil && *g.Category != *tt.want) {
+				t.Errorf("Category = %v, want %v", g.Category, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrumblePostUseCase_Post_Appropriate(t *testing.T) {
+	repo := &fakeGrumbleRepo{}
+	verdicts := &fakeVerdictRepo{}
+	filter := &fakeContentFilter{result: &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}}
+	uc := newTestPostUseCase(t, filter, repo, verdicts, &bytes.Buffer{})
+
+	g, err := uc.Post(context.Background(), PostGrumbleRequest{
+		UserID:     "00000000-0000-0000-0000-000000000001",
+		Content:    "月曜日つらい",
+		ToxicLevel: shared.ToxicLevel2,
+	})
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if !g.IsPublished() {
+		t.Errorf("ModerationStatus = %q, want %q", g.ModerationStatus, grumble.ModerationStatusPublished)
+	}
+	if len(repo.created) != 1 {
+		t.Errorf("created %d grumbles, want 1", len(repo.created))
+	}
+	assertVerdict(t, verdicts, moderation.DecisionApproved, true)
+}
+
+func TestGrumblePostUseCase_Post_Inappropriate(t *testing.T) {
+	repo := &fakeGrumbleRepo{}
+	verdicts := &fakeVerdictRepo{}
+	filter := &fakeContentFilter{result: &grumble.ModerationResult{
+		IsAppropriate: false,
+		Categories:    []grumble.ModerationCategory{grumble.ModerationCategoryHarassment},
+		Reason:        "誹謗中傷",
+	}}
+	uc := newTestPostUseCase(t, filter, repo, verdicts, &bytes.Buffer{})
+
+	_, err := uc.Post(context.Background(), PostGrumbleRequest{
+		UserID:     "00000000-0000-0000-0000-000000000001",
+		Content:    "あいつは最低だ",
+		ToxicLevel: shared.ToxicLevel3,
+	})
+
+	var inappropriateErr *shared.InappropriateContentError
+	if !errors.As(err, &inappropriateErr) {
+		t.Fatalf("Post() error = %v, want InappropriateContentError", err)
+	}
+	// The rejected draft is kept privately so the author can appeal
+	if len(repo.created) != 1 || !repo.created[0].IsRejected() {
+		t.Fatalf("created = %+v, want one rejected draft", repo.created)
+	}
+	if inappropriateErr.GrumbleID == nil || *inappropriateErr.GrumbleID != repo.created[0].GrumbleID {
+		t.Errorf("GrumbleID = %v, want %s", inappropriateErr.GrumbleID, repo.created[0].GrumbleID)
+	}
+	assertVerdict(t, verdicts, moderation.DecisionRejected, true)
+}
+
+func TestGrumblePostUseCase_Post_InappropriateSuggestsRewrite(t *testing.T) {
+	const rewrite = "あの人の言い方には本当に傷ついた"
+	rejected := &grumble.ModerationResult{
+		Categories: []grumble.ModerationCategory{grumble.ModerationCategoryHarassment},
+		Reason:     "誹謗中傷",
+	}
+
+	tests := []struct {
+		name          string
+		rewriteResult *grumble.ModerationResult
+		wantRewrite   bool
+	}{
+		{"書き換え案がモデレーションを通過すれば提示", &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}, true},
+		{"書き換え案も不適切なら提示しない", rejected, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &fakeContentFilter{
+				result:    rejected,
+				byContent: map[string]*grumble.ModerationResult{rewrite: tt.rewriteResult},
+			}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(&fakeGrumbleRepo{}, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{},
+				&fakeRewriteSuggester{rewrite: rewrite}, nil, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			_, err := uc.Post(context.Background(), PostGrumbleRequest{
+				UserID:     "00000000-0000-0000-0000-000000000001",
+				Content:    "あいつは最低だ",
+				ToxicLevel: shared.ToxicLevel3,
+			})
+
+			var inappropriateErr *shared.InappropriateContentError
+			if !errors.As(err, &inappropriateErr) {
+				t.Fatalf("Post() error = %v, want InappropriateContentError", err)
+			}
+			if got := inappropriateErr.SuggestedRewrite != nil; got != tt.wantRewrite {
+				t.Fatalf("SuggestedRewrite = %v, want present = %v", inappropriateErr.SuggestedRewrite, tt.wantRewrite)
+			}
+			if tt.wantRewrite && *inappropriateErr.SuggestedRewrite != rewrite {
+				t.Errorf("SuggestedRewrite = %q, want %q", *inappropriateErr.SuggestedRewrite, rewrite)
+			}
+		})
+	}
+}
+
+func TestGrumblePostUseCase_Post_SelfHarmIsHeld(t *testing.T) {
+	tests := []struct {
+		name          string
+		isAppropriate bool
+	}{
+		{"不適切判定の自傷表現", false},
+		{"適切判定でも自傷カテゴリ付き", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			verdicts := &fakeVerdictRepo{}
+			logs := &bytes.Buffer{}
+			filter := &fakeContentFilter{result: &grumble.ModerationResult{
+				IsAppropriate: tt.isAppropriate,
+				Categories:    []grumble.ModerationCategory{grumble.ModerationCategorySelfHarm},
+				Reason:        "自傷をほのめかす表現",
+			}}
+			uc := newTestPostUseCase(t, filter, repo, verdicts, logs)
+
+			content := "もう消えてしまいたい"
+			g, err := uc.Post(context.Background(), PostGrumbleRequest{
+				UserID:     "00000000-0000-0000-0000-000000000001",
+				Content:    content,
+				ToxicLevel: shared.ToxicLevel5,
+			})
+			if g != nil {
+				t.Errorf("Post() returned grumble %v, want nil", g)
+			}
+
+			var selfHarmErr *shared.SelfHarmContentError
+			if !errors.As(err, &selfHarmErr) {
+				t.Fatalf("Post() error = %v, want SelfHarmContentError", err)
+			}
+			if selfHarmErr.Support.Message != testCrisisSupport.Message || len(selfHarmErr.Support.Resources) != 1 {
+				t.Errorf("Support = %+v, want %+v", selfHarmErr.Support, testCrisisSupport)
+			}
+
+			if len(repo.created) != 1 {
+				t.Fatalf("created %d grumbles, want 1", len(repo.created))
+			}
+			held := repo.created[0]
+			if held.ModerationStatus != grumble.ModerationStatusHeld {
+				t.Errorf("ModerationStatus = %q, want %q", held.ModerationStatus, grumble.ModerationStatusHeld)
+			}
+			if held.GrumbleID != selfHarmErr.GrumbleID {
+				t.Errorf("GrumbleID = %q, want %q", selfHarmErr.GrumbleID, held.GrumbleID)
+			}
+
+			assertVerdict(t, verdicts, moderation.DecisionHeld, true)
+
+			if !strings.Contains(logs.String(), "moderation.self_harm_held") {
+				t.Errorf("audit log entry missing: %s", logs.String())
+			}
+			if strings.Contains(logs.String(), content) {
+				t.Errorf("audit log must not contain the content: %s", logs.String())
+			}
+		})
+	}
+}
+
+func TestGrumblePostUseCase_Post_AsyncModerationIsPending(t *testing.T) {
+	repo := &fakeGrumbleRepo{}
+	verdicts := &fakeVerdictRepo{}
+	filter := &fakeContentFilter{err: errors.New("must not be called")}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), verdicts, nil, nil, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, true, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+	g, err := uc.Post(context.Background(), PostGrumbleRequest{
+		UserID:     "00000000-0000-0000-0000-000000000001",
+		Content:    "会議が長い",
+		ToxicLevel: shared.ToxicLevel2,
+	})
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if !g.IsPendingModeration() {
+		t.Errorf("Status = %q, want %q", g.Status, grumble.StatusPending)
+	}
+	if len(repo.created) != 1 {
+		t.Errorf("created %d grumbles, want 1", len(repo.created))
+	}
+	if len(verdicts.created) != 0 {
+		t.Errorf("recorded %d verdicts, want 0", len(verdicts.created))
+	}
+}
+
+func TestGrumblePostUseCase_Post_FilterError(t *testing.T) {
+	repo := &fakeGrumbleRepo{}
+	verdicts := &fakeVerdictRepo{}
+	filter := &fakeContentFilter{err: &shared.InternalError{Message: "gemini unavailable"}}
+	uc := newTestPostUseCase(t, filter, repo, verdicts, &bytes.Buffer{})
+
+	_, err := uc.Post(context.Background(), PostGrumbleRequest{
+		UserID:     "00000000-0000-0000-0000-000000000001",
+		Content:    "電車が遅れた",
+		ToxicLevel: shared.ToxicLevel1,
+	})
+
+	var internalErr *shared.InternalError
+	if !errors.As(err, &internalErr) {
+		t.Fatalf("Post() error = %v, want InternalError", err)
+	}
+	if len(repo.created) != 0 {
+		t.Errorf("created %d grumbles, want 0", len(repo.created))
+	}
+	if len(verdicts.created) != 0 {
+		t.Errorf("recorded %d verdicts, want 0", len(verdicts.created))
+	}
+}
+
+// fakePostLog is an in-memory post log.
+type fakePostLog struct {
+	entries map[shared.UserID][]grumble.PostLogEntry
+}
+
+func (l *fakePostLog) Recent(_ context.Context, userID shared.UserID, since time.Time) ([]grumble.PostLogEntry, error) {
+	var recent []grumble.PostLogEntry
+	for _, e := range l.entries[userID] {
+		if !e.PostedAt.Before(since) {
+			recent = append(recent, e)
+		}
+	}
+	return recent, nil
+}
+
+func (l *fakePostLog) Record(_ context.Context, userID shared.UserID, entry grumble.PostLogEntry) error {
+	if l.entries == nil {
+		l.entries = map[shared.UserID][]grumble.PostLogEntry{}
+	}
+	l.entries[userID] = append(l.entries[userID], entry)
+	return nil
+}
+
+func (l *fakePostLog) Reserve(ctx context.Context, userID shared.UserID, since time.Time, entry grumble.PostLogEntry, check func([]grumble.PostLogEntry) error) error {
+	recent, err := l.Recent(ctx, userID, since)
+	if err != nil {
+		return err
+	}
+	if err := check(recent); err != nil {
+		return err
+	}
+	return l.Record(ctx, userID, entry)
+}
+
+func (l *fakePostLog) Release(_ context.Context, userID shared.UserID, entry grumble.PostLogEntry) error {
+	kept := l.entries[userID][:0]
+	for _, e := range l.entries[userID] {
+		if e != entry {
+			kept = append(kept, e)
+		}
+	}
+	l.entries[userID] = kept
+	return nil
+}
+
+func (l *fakePostLog) DeleteBefore(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestGrumblePostUseCase_Post_Spam(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents []string
+		quota    int
+		wantErr  error
+	}{
+		{"違う内容は投稿できる", []string{"月曜日つらい", "残業が終わらない"}, 0, nil},
+		{"同じ内容の連投は重複", []string{"月曜日つらい", "月曜日つらい"}, 0, &shared.DuplicateGrumbleError{}},
+		{"空白や句読点を変えても重複", []string{"月曜日つらい", " 月曜日、つらい！"}, 0, &shared.DuplicateGrumbleError{}},
+		{"上限を超えると投稿できない", []string{"月曜日つらい", "残業が終わらない", "眠い"}, 2, &shared.QuotaExceededError{}},
+		{"他のユーザーの投稿は数えない", []string{"月曜日つらい"}, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			postLog := &fakePostLog{}
+			// Another user's identical post must not count against this user
+			if err := postLog.Record(context.Background(), reporterID(1), grumble.PostLogEntry{
+				Fingerprint: grumble.NewFingerprint("月曜日つらい"),
+				PostedAt:    time.Now(),
+			}); err != nil {
+				t.Fatal(err)
+			}
+			filter := &fakeContentFilter{result: &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}}
+			policy := grumble.SpamPolicy{DuplicateWindow: 10 * time.Minute, DailyQuota: tt.quota}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, nil, postLog, policy, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			var err error
+			for _, content := range tt.contents {
+				_, err = uc.Post(context.Background(), PostGrumbleRequest{UserID: testAuthorID, Content: content, ToxicLevel: shared.ToxicLevel2})
+				if err != nil {
+					break
+				}
+			}
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("Post() error = %v", err)
+				}
+				if len(postLog.entries[testAuthorID]) != len(tt.contents) {
+					t.Errorf("post log has %d entries, want %d", len(postLog.entries[testAuthorID]), len(tt.contents))
+				}
+			case *shared.DuplicateGrumbleError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Post() error = %v, want DuplicateGrumbleError", err)
+				}
+			case *shared.QuotaExceededError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Post() error = %v, want QuotaExceededError", err)
+				}
+				if want.RetryAfter <= 0 || want.RetryAfter > grumble.QuotaWindow {
+					t.Errorf("RetryAfter = %s, want within the quota window", want.RetryAfter)
+				}
+			}
+			if tt.wantErr != nil && len(repo.created) != len(tt.contents)-1 {
+				t.Errorf("created %d grumbles, want the refused post not stored", len(repo.created))
+			}
+		})
+	}
+}
+
+func TestGrumblePostUseCase_Post_FailedPostReleasesSpamLog(t *testing.T) {
+	repo := &fakeGrumbleRepo{}
+	postLog := &fakePostLog{}
+	filter := &fakeContentFilter{err: errors.New("moderation unavailable")}
+	policy := grumble.SpamPolicy{DuplicateWindow: 10 * time.Minute}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, nil, postLog, policy, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+	req := PostGrumbleRequest{UserID: testAuthorID, Content: "月曜日つらい", ToxicLevel: shared.ToxicLevel2}
+
+	if _, err := uc.Post(context.Background(), req); err == nil {
+		t.Fatal("Post() error = nil, want the moderation failure")
+	}
+	if len(postLog.entries[testAuthorID]) != 0 {
+		t.Fatalf("post log has %d entries, want the failed post released", len(postLog.entries[testAuthorID]))
+	}
+
+	// Retrying the same grumble once moderation is back is not a duplicate
+	filter.err = nil
+	filter.result = &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}
+	if _, err := uc.Post(context.Background(), req); err != nil {
+		t.Fatalf("retried Post() error = %v", err)
+	}
+}
+
+func TestGrumblePostUseCase_Post_Tags(t *testing.T) {
+	appropriate := &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}
+	tests := []struct {
+		name      string
+		content   string
+		result    *grumble.ModerationResult
+		suggester *fakeTopicSuggester
+		wantTags  []grumble.Tag
+		wantCalls int
+	}{
+		{
+			"本文のハッシュタグをタグにする", "また会議が延びた #今週の理不尽上司 #会議", appropriate, &fakeTopicSuggester{topic: "仕事"},
+			[]grumble.Tag{{Name: "今週の理不尽上司", Source: grumble.TagSourceHashtag}, {Name: "会議", Source: grumble.TagSourceHashtag}}, 0,
+		},
+		{
+			"ハッシュタグがなければAIのテーマを付ける", "また会議が延びた", appropriate, &fakeTopicSuggester{topic: "#会議"},
+			[]grumble.Tag{{Name: "会議", Source: grumble.TagSourceTopic}}, 1,
+		},
+		{"テーマの提案に失敗してもタグなしで投稿できる", "また会議が延びた", appropriate, &fakeTopicSuggester{err: errors.New("timeout")}, nil, 1},
+		{"タグとして使えないテーマは付けない", "また会議が延びた", appropriate, &fakeTopicSuggester{topic: "会議 延長"}, nil, 1},
+		{
+			"保留した投稿はAIに送らない", "もう消えたい", &grumble.ModerationResult{IsAppropriate: false, Categories: []grumble.ModerationCategory{grumble.ModerationCategorySelfHarm}, Reason: "自傷"},
+			&fakeTopicSuggester{topic: "つらい"}, nil, 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			filter := &fakeContentFilter{result: tt.result}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, tt.suggester, nil, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			_, _ = uc.Post(context.Background(), PostGrumbleRequest{UserID: testAuthorID, Content: tt.content, ToxicLevel: shared.ToxicLevel2})
+
+			if len(repo.created) != 1 {
+				t.Fatalf("created %d grumbles, want 1", len(repo.created))
+			}
+			if got := repo.created[0].Tags; !reflect.DeepEqual(got, tt.wantTags) {
+				t.Errorf("Tags = %v, want %v", got, tt.wantTags)
+			}
+			if tt.suggester.calls != tt.wantCalls {
+				t.Errorf("SuggestTopic called %d times, want %d", tt.suggester.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func assertVerdict(t *testing.T, verdicts *fakeVerdictRepo, decision moderation.Decision, wantGrumbleID bool) {
+	t.Helper()
+	if len(verdicts.created) != 1 {
+		t.Fatalf("recorded %d verdicts, want 1", len(verdicts.created))
+	}
+	v := verdicts.created[0]
+	if v.Decision != decision {
+		t.Errorf("Decision = %q, want %q", v.Decision, decision)
+	}
+	if (v.GrumbleID != nil) != wantGrumbleID {
+		t.Errorf("GrumbleID = %v, want set = %v", v.GrumbleID, wantGrumbleID)
+	}
+	if len(v.ContentHash) != 64 {
+		t.Errorf("ContentHash = %q, want sha256 hex", v.ContentHash)
+	}
+}
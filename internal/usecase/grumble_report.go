@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// GrumbleReportUseCase lets users report published grumbles and moderators work through the report queue
+type GrumbleReportUseCase struct {
+	grumbleRepo   grumble.Repository
+	reportRepo    moderation.ReportRepository
+	auditRepo     audit.Repository
+	transactor    Transactor
+	hideThreshold int
+	logger        logging.Logger
+}
+
+// NewGrumbleReportUseCase creates a new GrumbleReportUseCase.
+// A grumble is hidden from the timeline once it has hideThreshold unresolved reports.
+func NewGrumbleReportUseCase(
+	grumbleRepo grumble.Repository,
+	reportRepo moderation.ReportRepository,
+	auditRepo audit.Repository,
+	transactor Transactor,
+	hideThreshold int,
+	logger logging.Logger,
+) *GrumbleReportUseCase {
+	return &GrumbleReportUseCase{
+		grumbleRepo:   grumbleRepo,
+		reportRepo:    reportRepo,
+		auditRepo:     auditRepo,
+		transactor:    transactor,
+		hideThreshold: hideThreshold,
+		logger:        logger,
+	}
+}
+
+// ReportGrumbleRequest represents a user's report of a grumble
+type ReportGrumbleRequest struct {
+	GrumbleID  shared.GrumbleID
+	ReporterID shared.UserID
+	Reason     moderation.ReportReason
+	Comment    *string
+}
+
+// ReportQueueResponse represents a page of the report queue
+type ReportQueueResponse struct {
+	Items []*moderation.ReportedGrumble
+	Total int
+}
+
+// ResolveReportsRequest represents a moderator's action on a reported grumble
+type ResolveReportsRequest struct {
+	GrumbleID   shared.GrumbleID
+	Resolution  moderation.ReportResolution
+	Note        *string
+	ModeratorID shared.UserID
+}
+
+// ResolveReportsResponse represents the outcome of a moderator's action
+type ResolveReportsResponse struct {
+	GrumbleID        shared.GrumbleID
+	ModerationStatus grumble.ModerationStatus
+	ResolvedReports  int
+}
+
+// Report stores a report and hides the grumble once the threshold is reached
+func (uc *GrumbleReportUseCase) Report(ctx context.Context, req ReportGrumbleRequest) (*moderation.Report, error) {
+	g, err := uc.grumbleRepo.FindByID(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := moderation.NewReport(g, req.ReporterID, req.Reason, req.Comment, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.reportRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.reportRepo.CountUnresolved(ctx, g.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= uc.hideThreshold {
+		if err := g.HideForReview(); err != nil {
+			return nil, err
+		}
+		if err := uc.grumbleRepo.SaveStatus(ctx, g); err != nil {
+			return nil, err
+		}
+		uc.logger.InfoContext(ctx, "Grumble hidden for report review",
+			"grumble_id", g.GrumbleID,
+			"report_count", count,
+		)
+	}
+
+	return report, nil
+}
+
+// ListQueue returns grumbles with unresolved reports
+func (uc *GrumbleReportUseCase) ListQueue(ctx context.Context, limit, offset int) (*ReportQueueResponse, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	items, err := uc.reportRepo.ListQueue(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.reportRepo.CountQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportQueueResponse{Items: items, Total: total}, nil
+}
+
+// Resolve restores or removes a reported grumble and closes its open reports
+func (uc *GrumbleReportUseCase) Resolve(ctx context.Context, req ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	if err := req.Resolution.Validate(); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.reportRepo.CountUnresolved(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, &shared.NotFoundError{Entity: "ReportedGrumble", ID: string(req.GrumbleID)}
+	}
+
+	// Reports survive the grumble's move to the archive
+	g, err := uc.grumbleRepo.FindByIDIncludingArchive(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Resolution.Apply(g); err != nil {
+		return nil, err
+	}
+	var resolved int
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.grumbleRepo.SaveStatus(ctx, g); err != nil {
+			return err
+		}
+		var err error
+		resolved, err = uc.reportRepo.Resolve(ctx, req.GrumbleID, req.Resolution, req.Note, req.ModeratorID, time.Now())
+		if err != nil {
+			return err
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.ModeratorID,
+			Action:     audit.ActionReportsResolve,
+			TargetType: audit.TargetGrumble,
+			TargetID:   string(req.GrumbleID),
+			Reason:     req.Note,
+			Detail: map[string]any{
+				"resolution":       string(req.Resolution),
+				"resolved_reports": resolved,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolveReportsResponse{
+		GrumbleID:        req.GrumbleID,
+		ModerationStatus: g.ModerationStatus,
+		ResolvedReports:  resolved,
+	}, nil
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// fakeReportedGrumbleRepo serves grumbles by ID, live or archived, and records status changes.
+type fakeReportedGrumbleRepo struct {
+	fakeStoredGrumbleRepo
+	statuses []grumble.ModerationStatus
+}
+
+func (r *fakeReportedGrumbleRepo) FindByIDIncludingArchive(ctx context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	return r.FindByID(ctx, id)
+}
+
+func (r *fakeReportedGrumbleRepo) SaveStatus(_ context.Context, g *grumble.Grumble) error {
+	r.statuses = append(r.statuses, g.ModerationStatus)
+	return nil
+}
+
+// fakeReportRepo keeps reports in memory.
+type fakeReportRepo struct {
+	moderation.ReportRepository
+	reports  []*moderation.Report
+	resolved int
+}
+
+func (r *fakeReportRepo) Create(_ context.Context, report *moderation.Report) error {
+	for _, existing := range r.reports {
+		if existing.GrumbleID == report.GrumbleID && existing.ReporterID == report.ReporterID {
+			return &shared.ConflictError{Message: "already reported"}
+		}
+	}
+	report.ReportID = moderation.ReportID(len(r.reports) + 1)
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+func (r *fakeReportRepo) CountUnresolved(_ context.Context, grumbleID shared.GrumbleID) (int, error) {
+	count := 0
+	for _, report := range r.reports {
+		if report.GrumbleID == grumbleID {
+			count++
+		}
+	}
+	return count - r.resolved, nil
+}
+
+func (r *fakeReportRepo) Resolve(ctx context.Context, grumbleID shared.GrumbleID, _ moderation.ReportResolution, _ *string, _ shared.UserID, _ time.Time) (int, error) {
+	count, _ := r.CountUnresolved(ctx, grumbleID)
+	r.resolved += count
+	return count, nil
+}
+
+func newTestReportUseCase(threshold int) (*GrumbleReportUseCase, *fakeReportedGrumbleRepo, *fakeReportRepo) {
+	postedAt := time.Now().Add(-time.Hour)
+	g := &grumble.Grumble{
+		GrumbleID:        testGrumbleID,
+		UserID:           testAuthorID,
+		Content:          "満員電車で足を踏まれた",
+		PostedAt:         postedAt,
+		ExpiresAt:        postedAt.Add(24 * time.Hour),
+		ModerationStatus: grumble.ModerationStatusPublished,
+		Status:           grumble.StatusActive,
+	}
+	grumbles := &fakeReportedGrumbleRepo{
+		fakeStoredGrumbleRepo: fakeStoredGrumbleRepo{byID: map[shared.GrumbleID]*grumble.Grumble{g.GrumbleID: g}},
+	}
+	reports := &fakeReportRepo{}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	return NewGrumbleReportUseCase(grumbles, reports, &fakeAuditRepo{}, &fakeTransactor{}, threshold, logger), grumbles, reports
+}
+
+func reporterID(i int) shared.UserID {
+	return shared.UserID(fmt.Sprintf("00000000-0000-0000-0000-1000000000%02d", i))
+}
+
+func TestGrumbleReportUseCase_Report(t *testing.T) {
+	tests := []struct {
+		name       string
+		reporters  int
+		wantHidden bool
+	}{
+		{"閾値未満は公開のまま", 2, false},
+		{"閾値に達すると非表示", 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, grumbles, _ := newTestReportUseCase(3)
+
+			for i := 0; i < tt.reporters; i++ {
+				if _, err := uc.Report(context.Background(), ReportGrumbleRequest{
+					GrumbleID:  testGrumbleID,
+					ReporterID: reporterID(i),
+					Reason:     moderation.ReportReasonHarassment,
+				}); err != nil {
+					t.Fatalf("Report() error = %v", err)
+				}
+			}
+
+			g := grumbles.byID[testGrumbleID]
+			if hidden := g.ModerationStatus == grumble.ModerationStatusHidden; hidden != tt.wantHidden {
+				t.Errorf("ModerationStatus = %q, want hidden = %v", g.ModerationStatus, tt.wantHidden)
+			}
+			if tt.wantHidden && (len(grumbles.statuses) != 1 || grumbles.statuses[0] != grumble.ModerationStatusHidden) {
+				t.Errorf("stored statuses = %v, want [hidden]", grumbles.statuses)
+			}
+		})
+	}
+}
+
+func TestGrumbleReportUseCase_ReportErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		reporterID shared.UserID
+		reason     moderation.ReportReason
+		wantErr    error
+	}{
+		{"自分の投稿は通報できない", testAuthorID, moderation.ReportReasonSpam, &shared.ValidationError{}},
+		{"未知の理由", reporterID(1), "boring", &shared.ValidationError{}},
+		{"同じユーザーの二重通報", reporterID(0), moderation.ReportReasonSpam, &shared.ConflictError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _ := newTestReportUseCase(3)
+			if _, err := uc.Report(context.Background(), ReportGrumbleRequest{
+				GrumbleID:  testGrumbleID,
+				ReporterID: reporterID(0),
+				Reason:     moderation.ReportReasonOther,
+			}); err != nil {
+				t.Fatalf("Report() error = %v", err)
+			}
+
+			_, err := uc.Report(context.Background(), ReportGrumbleRequest{
+				GrumbleID:  testGrumbleID,
+				ReporterID: tt.reporterID,
+				Reason:     tt.reason,
+			})
+
+			switch want := tt.wantErr.(type) {
+			case *shared.ValidationError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Report() error = %v, want ValidationError", err)
+				}
+			case *shared.ConflictError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Report() error = %v, want ConflictError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestGrumbleReportUseCase_Resolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution moderation.ReportResolution
+		wantStatus grumble.ModerationStatus
+	}{
+		{"再公開", moderation.ReportResolutionRestored, grumble.ModerationStatusPublished},
+		{"削除", moderation.ReportResolutionRemoved, grumble.ModerationStatusRemoved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, grumbles, _ := newTestReportUseCase(1)
+			if _, err := uc.Report(context.Background(), ReportGrumbleRequest{
+				GrumbleID:  testGrumbleID,
+				ReporterID: reporterID(0),
+				Reason:     moderation.ReportReasonSpam,
+			}); err != nil {
+				t.Fatalf("Report() error = %v", err)
+			}
+
+			req := ResolveReportsRequest{GrumbleID: testGrumbleID, Resolution: tt.resolution, ModeratorID: reporterID(99)}
+			result, err := uc.Resolve(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if result.ModerationStatus != tt.wantStatus || result.ResolvedReports != 1 {
+				t.Errorf("result = %+v, want status %q with 1 resolved report", result, tt.wantStatus)
+			}
+			if last := grumbles.statuses[len(grumbles.statuses)-1]; last != tt.wantStatus {
+				t.Errorf("stored status = %q, want %q", last, tt.wantStatus)
+			}
+
+			// Nothing is left to resolve once the reports are closed
+			_, err = uc.Resolve(context.Background(), req)
+			var notFoundErr *shared.NotFoundError
+			if !errors.As(err, &notFoundErr) {
+				t.Errorf("second Resolve() error = %v, want NotFoundError", err)
+			}
+		})
+	}
+}
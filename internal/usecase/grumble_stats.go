@@ -11,13 +11,14 @@ import (
 // GrumbleStatsUseCase handles stats retrieval with default period computation.
 type GrumbleStatsUseCase struct {
 	repo               grumble.Repository
+	categories         grumble.CategoryCatalogue
 	defaultTZ          string
 	weekStartsOnSunday bool
 }
 
 // NewGrumbleStatsUseCase creates a new instance.
-func NewGrumbleStatsUseCase(repo grumble.Repository, defaultTZ string, weekStartsOnSunday bool) *GrumbleStatsUseCase {
-	return &GrumbleStatsUseCase{repo: repo, defaultTZ: defaultTZ, weekStartsOnSunday: weekStartsOnSunday}
+func NewGrumbleStatsUseCase(repo grumble.Repository, categories grumble.CategoryCatalogue, defaultTZ string, weekStartsOnSunday bool) *GrumbleStatsUseCase {
+	return &GrumbleStatsUseCase{repo: repo, categories: categories, defaultTZ: defaultTZ, weekStartsOnSunday: weekStartsOnSunday}
 }
 
 // StatsRequest holds parameters for stats retrieval.
@@ -26,6 +27,7 @@ type StatsRequest struct {
 	From        *time.Time
 	To          *time.Time
 	TZ          string
+	Category    *string // Optional category key; restricts the stats to that category
 }
 
 // StatsByToxicRequest holds parameters for toxic-level stats retrieval.
@@ -41,7 +43,12 @@ func (uc *GrumbleStatsUseCase) Get(ctx context.Context, req StatsRequest) ([]gru
 		return nil, err
 	}
 
-	return uc.repo.Stats(ctx, req.Granularity, fromUTC, toUTC)
+	category, err := uc.categories.Resolve(req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Stats(ctx, req.Granularity, fromUTC, toUTC, category)
 }
 
 // GetByToxic returns aggregated stats with toxic-level breakdown.
@@ -57,7 +64,27 @@ func (uc *GrumbleStatsUseCase) GetByToxic(ctx context.Context, req StatsByToxicR
 		return nil, err
 	}
 
-	return uc.repo.StatsByToxic(ctx, req.Granularity, fromUTC, toUTC, req.ToxicLevel)
+	category, err := uc.categories.Resolve(req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.StatsByToxic(ctx, req.Granularity, fromUTC, toUTC, req.ToxicLevel, category)
+}
+
+// GetByCategory returns aggregated stats with category breakdown.
+func (uc *GrumbleStatsUseCase) GetByCategory(ctx context.Context, req StatsRequest) ([]grumble.StatsRow, error) {
+	fromUTC, toUTC, err := uc.resolveRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := uc.categories.Resolve(req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.StatsByCategory(ctx, req.Granularity, fromUTC, toUTC, category)
 }
 
 func (uc *GrumbleStatsUseCase) resolveRange(req StatsRequest) (time.Time, time.Time, error) {
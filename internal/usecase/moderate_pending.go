@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// ModeratePendingUseCase moderates grumbles that were stored as pending by asynchronous posting
+type ModeratePendingUseCase struct {
+	grumbleRepo      grumble.Repository
+	contentFilter    grumble.ContentFilterClient
+	promptSelector   *grumble.PromptSelector
+	verdictRepo      moderation.VerdictRepository
+	notificationRepo notification.Repository
+	postingGuard     PostingGuard // nil disables sanctions
+	crisisSupport    shared.CrisisSupport
+	toxicLevelPolicy grumble.ToxicLevelPolicy
+	batchSize        int
+	logger           logging.Logger
+}
+
+// NewModeratePendingUseCase creates a new ModeratePendingUseCase
+func NewModeratePendingUseCase(
+	grumbleRepo grumble.Repository,
+	contentFilter grumble.ContentFilterClient,
+	promptSelector *grumble.PromptSelector,
+	verdictRepo moderation.VerdictRepository,
+	notificationRepo notification.Repository,
+	postingGuard PostingGuard,
+	crisisSupport shared.CrisisSupport,
+	toxicLevelPolicy grumble.ToxicLevelPolicy,
+	batchSize int,
+	logger logging.Logger,
+) *ModeratePendingUseCase {
+	return &ModeratePendingUseCase{
+		grumbleRepo:      grumbleRepo,
+		contentFilter:    contentFilter,
+		promptSelector:   promptSelector,
+		verdictRepo:      verdictRepo,
+		notificationRepo: notificationRepo,
+		postingGuard:     postingGuard,
+		crisisSupport:    crisisSupport,
+		toxicLevelPolicy: toxicLevelPolicy,
+		batchSize:        batchSize,
+		logger:           logger,
+	}
+}
+
+// pendingClaimLease is how long a claimed grumble is kept from other workers.
+// A grumble whose worker stopped or failed mid-moderation is claimed again after it.
+const pendingClaimLease = 5 * time.Minute
+
+// rejectedNotificationMessage is sent to the author when a pending grumble is rejected
+const rejectedNotificationMessage = "投稿した愚痴は公開されませんでした。理由: %s（判定に納得できない場合は異議を申し立てできます）"
+
+// ModeratePending moderates one batch of pending grumbles and returns how many were decided.
+// Grumbles are claimed so that overlapping runs do not moderate them twice.
+// A grumble whose moderation call fails stays pending and is retried once its claim lapses.
+func (uc *ModeratePendingUseCase) ModeratePending(ctx context.Context) (int, error) {
+	pending, err := uc.grumbleRepo.ClaimPendingModeration(ctx, uc.batchSize, pendingClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	decided := 0
+	for _, g := range pending {
+		if err := uc.moderate(ctx, g); err != nil {
+			var conflictErr *shared.ConflictError
+			if errors.As(err, &conflictErr) {
+				// Edited by its author meanwhile; the new content is moderated on a later run
+				uc.logger.InfoContext(ctx, "Pending grumble changed during moderation", "grumble_id", g.GrumbleID)
+				continue
+			}
+			uc.logger.ErrorContext(ctx, "Failed to moderate pending grumble", "grumble_id", g.GrumbleID, "error", err)
+			continue
+		}
+		decided++
+	}
+
+	return decided, nil
+}
+
+func (uc *ModeratePendingUseCase) moderate(ctx context.Context, g *grumble.Grumble) error {
+	// Grumbles stored before normalization was introduced are moderated in their normalized form
+	content := grumble.NormalizeContent(g.Content)
+
+	started := time.Now()
+	result, err := uc.contentFilter.FilterContent(ctx, grumble.ModerationRequest{
+		Content: content,
+		Prompt:  uc.promptSelector.Select(g.UserID),
+	})
+	if err != nil {
+		return err
+	}
+	verdict := newVerdict(g.UserID, content, result, time.Since(started))
+	verdict.GrumbleID = &g.GrumbleID
+
+	var message string
+	var kind notification.Kind
+	switch {
+	case result.IsSelfHarm():
+		if err := g.Hold(); err != nil {
+			return err
+		}
+		kind = notification.KindGrumbleHeld
+		message = formatCrisisSupport(uc.crisisSupport)
+	case !result.IsAppropriate:
+		if err := g.Reject(); err != nil {
+			return err
+		}
+		kind = notification.KindGrumbleRejected
+		message = fmt.Sprintf(rejectedNotificationMessage, result.Reason)
+	default:
+		if err := g.Publish(); err != nil {
+			return err
+		}
+		if err := uc.shadowIfBanned(ctx, g); err != nil {
+			return err
+		}
+	}
+	uc.toxicLevelPolicy.Apply(g, result.EstimatedLevel())
+
+	if err := uc.grumbleRepo.SaveModeration(ctx, g); err != nil {
+		return err
+	}
+	recordVerdict(ctx, uc.verdictRepo, uc.logger, verdict)
+	if g.IsRejected() {
+		recordRejection(ctx, uc.postingGuard, uc.logger, g.UserID)
+	}
+
+	if g.IsHeld() {
+		// Audit entry kept separate from request logs; content itself is never logged
+		uc.logger.WarnContext(ctx, "Grumble held for crisis support",
+			"audit_event", "moderation.self_harm_held",
+			"grumble_id", g.GrumbleID,
+			"user_id", g.UserID,
+			"content_hash", verdict.ContentHash,
+		)
+	}
+
+	if kind == "" {
+		return nil
+	}
+	return uc.notificationRepo.Create(ctx, &notification.Notification{
+		UserID:    g.UserID,
+		Kind:      kind,
+		GrumbleID: &g.GrumbleID,
+		Message:   message,
+		CreatedAt: time.Now(),
+	})
+}
+
+// shadowIfBanned keeps a grumble that passed moderation private when its author is shadow-banned
+func (uc *ModeratePendingUseCase) shadowIfBanned(ctx context.Context, g *grumble.Grumble) error {
+	if uc.postingGuard == nil {
+		return nil
+	}
+	standing, err := uc.postingGuard.Standing(ctx, g.UserID)
+	if err != nil {
+		return err
+	}
+	if standing.ShadowBanned {
+		return g.Shadow()
+	}
+	return nil
+}
+
+// formatCrisisSupport renders the crisis support message and contacts as notification text
+func formatCrisisSupport(support shared.CrisisSupport) string {
+	lines := []string{support.Message}
+	for _, r := range support.Resources {
+		line := fmt.Sprintf("%s: %s", r.Name, r.Contact)
+		if r.URL != "" {
+			line += " (" + r.URL + ")"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// fakePendingGrumbleRepo serves a fixed pending batch and records saved moderation results.
+// Grumbles listed in edited were changed by their author after being claimed.
+type fakePendingGrumbleRepo struct {
+	grumble.Repository
+	pending []*grumble.Grumble
+	edited  map[shared.GrumbleID]bool
+	updated []*grumble.Grumble
+}
+
+func (r *fakePendingGrumbleRepo) ClaimPendingModeration(_ context.Context, _ int, _ time.Duration) ([]*grumble.Grumble, error) {
+	return r.pending, nil
+}
+
+func (r *fakePendingGrumbleRepo) SaveModeration(_ context.Context, g *grumble.Grumble) error {
+	if r.edited[g.GrumbleID] {
+		return &shared.ConflictError{Message: "grumble changed while it was being moderated"}
+	}
+	r.updated = append(r.updated, g)
+	return nil
+}
+
+// fakeNotificationRepo records created notifications.
+type fakeNotificationRepo struct {
+	notification.Repository
+	created []*notification.Notification
+}
+
+func (r *fakeNotificationRepo) Create(_ context.Context, n *notification.Notification) error {
+	r.created = append(r.created, n)
+	return nil
+}
+
+func TestModeratePendingUseCase_ModeratePending(t *testing.T) {
+	tests := []struct {
+		name             string
+		result           *grumble.ModerationResult
+		wantStatus       grumble.ModerationStatus
+		wantDecision     moderation.Decision
+		wantNotification notification.Kind
+	}{
+		{
+			name:         "適切なら公開",
+			result:       &grumble.ModerationResult{IsAppropriate: true},
+			wantStatus:   grumble.ModerationStatusPublished,
+			wantDecision: moderation.DecisionApproved,
+		},
+		{
+			name: "不適切なら非公開にして通知",
+			result: &grumble.ModerationResult{
+				Categories: []grumble.ModerationCategory{grumble.ModerationCategoryHarassment},
+				Reason:     "誹謗中傷",
+			},
+			wantStatus:       grumble.ModerationStatusRejected,
+			wantDecision:     moderation.DecisionRejected,
+			wantNotification: notification.KindGrumbleRejected,
+		},
+		{
+			name: "自傷表現なら保留して相談窓口を通知",
+			result: &grumble.ModerationResult{
+				Categories: []grumble.ModerationCategory{grumble.ModerationCategorySelfHarm},
+			},
+			wantStatus:       grumble.ModerationStatusHeld,
+			wantDecision:     moderation.DecisionHeld,
+			wantNotification: notification.KindGrumbleHeld,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &grumble.Grumble{
+				GrumbleID:        "00000000-0000-0000-0000-0000000000aa",
+				UserID:           "00000000-0000-0000-0000-000000000001",
+				Content:          "pending content",
+				ModerationStatus: grumble.ModerationStatusPublished,
+				Status:           grumble.StatusPending,
+			}
+			repo := &fakePendingGrumbleRepo{pending: []*grumble.Grumble{g}}
+			verdicts := &fakeVerdictRepo{}
+			notifications := &fakeNotificationRepo{}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewModeratePendingUseCase(repo, &fakeContentFilter{result: tt.result}, newTestPromptSelector(t), verdicts, notifications, nil, testCrisisSupport, grumble.ToxicLevelPolicy{}, 10, logger)
+
+			count, err := uc.ModeratePending(context.Background())
+			if err != nil {
+				t.Fatalf("ModeratePending() error = %v", err)
+			}
+			if count != 1 {
+				t.Errorf("count = %d, want 1", count)
+			}
+			if len(repo.updated) != 1 || repo.updated[0].ModerationStatus != tt.wantStatus {
+				t.Errorf("ModerationStatus = %q, want %q", g.ModerationStatus, tt.wantStatus)
+			}
+			assertVerdict(t, verdicts, tt.wantDecision, true)
+
+			if tt.wantNotification == "" {
+				if len(notifications.created) != 0 {
+					t.Errorf("created %d notifications, want 0", len(notifications.created))
+				}
+				return
+			}
+			if len(notifications.created) != 1 {
+				t.Fatalf("created %d notifications, want 1", len(notifications.created))
+			}
+			n := notifications.created[0]
+			if n.Kind != tt.wantNotification || n.UserID != g.UserID {
+				t.Errorf("notification = %+v, want kind %q for %q", n, tt.wantNotification, g.UserID)
+			}
+			if tt.wantNotification == notification.KindGrumbleHeld && !strings.Contains(n.Message, testCrisisSupport.Resources[0].Contact) {
+				t.Errorf("held notification must list crisis contacts: %q", n.Message)
+			}
+		})
+	}
+}
+
+func TestModeratePendingUseCase_FilterErrorKeepsPending(t *testing.T) {
+	g := &grumble.Grumble{GrumbleID: "00000000-0000-0000-0000-0000000000aa", ModerationStatus: grumble.ModerationStatusPublished, Status: grumble.StatusPending}
+	repo := &fakePendingGrumbleRepo{pending: []*grumble.Grumble{g}}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	filter := &fakeContentFilter{err: &shared.InternalError{Message: "gemini unavailable"}}
+	uc := NewModeratePendingUseCase(repo, filter, newTestPromptSelector(t), &fakeVerdictRepo{}, &fakeNotificationRepo{}, nil, testCrisisSupport, grumble.ToxicLevelPolicy{}, 10, logger)
+
+	count, err := uc.ModeratePending(context.Background())
+	if err != nil {
+		t.Fatalf("ModeratePending() error = %v", err)
+	}
+	if count != 0 || len(repo.updated) != 0 {
+		t.Errorf("count = %d, updated = %d, want 0 and 0", count, len(repo.updated))
+	}
+	if !g.IsPendingModeration() {
+		t.Errorf("Status = %q, want pending", g.Status)
+	}
+}
+
+func TestModeratePendingUseCase_EditedDuringModeration(t *testing.T) {
+	g := &grumble.Grumble{
+		GrumbleID:        "00000000-0000-0000-0000-0000000000aa",
+		UserID:           "00000000-0000-0000-0000-000000000001",
+		Content:          "pending content",
+		ModerationStatus: grumble.ModerationStatusPublished,
+		Status:           grumble.StatusPending,
+	}
+	repo := &fakePendingGrumbleRepo{pending: []*grumble.Grumble{g}, edited: map[shared.GrumbleID]bool{g.GrumbleID: true}}
+	verdicts := &fakeVerdictRepo{}
+	notifications := &fakeNotificationRepo{}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	filter := &fakeContentFilter{result: &grumble.ModerationResult{Categories: []grumble.ModerationCategory{grumble.ModerationCategoryHarassment}}}
+	uc := NewModeratePendingUseCase(repo, filter, newTestPromptSelector(t), verdicts, notifications, nil, testCrisisSupport, grumble.ToxicLevelPolicy{}, 10, logger)
+
+	count, err := uc.ModeratePending(context.Background())
+	if err != nil {
+		t.Fatalf("ModeratePending() error = %v", err)
+	}
+	if count != 0 || len(repo.updated) != 0 {
+		t.Errorf("count = %d, updated = %d, want 0 and 0", count, len(repo.updated))
+	}
+	if len(verdicts.created) != 0 || len(notifications.created) != 0 {
+		t.Errorf("verdicts = %d, notifications = %d, want none for superseded content", len(verdicts.created), len(notifications.created))
+	}
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// ModerationAppealUseCase lets authors appeal rejected grumbles and moderators resolve the appeals
+type ModerationAppealUseCase struct {
+	grumbleRepo      grumble.Repository
+	verdictRepo      moderation.VerdictRepository
+	appealRepo       moderation.AppealRepository
+	notificationRepo notification.Repository
+	auditRepo        audit.Repository
+	transactor       Transactor
+	eventTimeSvc     *sharedservice.EventTimeService
+	lifetimePolicy   grumble.LifetimePolicy
+	logger           logging.Logger
+}
+
+// NewModerationAppealUseCase creates a new ModerationAppealUseCase
+func NewModerationAppealUseCase(
+	grumbleRepo grumble.Repository,
+	verdictRepo moderation.VerdictRepository,
+	appealRepo moderation.AppealRepository,
+	notificationRepo notification.Repository,
+	auditRepo audit.Repository,
+	transactor Transactor,
+	eventTimeSvc *sharedservice.EventTimeService,
+	lifetimePolicy grumble.LifetimePolicy,
+	logger logging.Logger,
+) *ModerationAppealUseCase {
+	return &ModerationAppealUseCase{
+		grumbleRepo:      grumbleRepo,
+		verdictRepo:      verdictRepo,
+		appealRepo:       appealRepo,
+		notificationRepo: notificationRepo,
+		auditRepo:        auditRepo,
+		transactor:       transactor,
+		eventTimeSvc:     eventTimeSvc,
+		lifetimePolicy:   lifetimePolicy,
+		logger:           logger,
+	}
+}
+
+// Notification messages sent to the author when an appeal is resolved
+const (
+	appealApprovedNotificationMessage = "異議申し立てが認められ、愚痴が公開されました。"
+	appealDeniedNotificationMessage   = "異議申し立てを確認しましたが、判定は変わりませんでした。"
+)
+
+// SubmitAppealRequest represents an author's appeal of a rejected grumble
+type SubmitAppealRequest struct {
+	UserID    shared.UserID
+	GrumbleID shared.GrumbleID
+}
+
+// ListAppealsResponse represents a page of the appeal queue
+type ListAppealsResponse struct {
+	Appeals []*moderation.Appeal
+	Total   int
+}
+
+// ResolveAppealRequest represents a moderator's decision on an appeal
+type ResolveAppealRequest struct {
+	AppealID    moderation.AppealID
+	Decision    moderation.AppealStatus
+	Note        *string
+	ModeratorID shared.UserID
+}
+
+// Submit files an appeal for the author's rejected grumble
+func (uc *ModerationAppealUseCase) Submit(ctx context.Context, req SubmitAppealRequest) (*moderation.Appeal, error) {
+	g, err := uc.grumbleRepo.FindByID(ctx, req.GrumbleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The rejecting verdict is optional: verdict recording never blocks posting
+	verdict, err := uc.verdictRepo.FindLatestByGrumble(ctx, req.GrumbleID)
+	var notFoundErr *shared.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		verdict = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	appeal, err := moderation.NewAppeal(g, req.UserID, verdict, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.appealRepo.Create(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	return appeal, nil
+}
+
+// List returns the appeal queue, oldest first
+func (uc *ModerationAppealUseCase) List(ctx context.Context, filter moderation.AppealFilter) (*ListAppealsResponse, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.Status != nil {
+		if err := filter.Status.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	appeals, err := uc.appealRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.appealRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAppealsResponse{Appeals: appeals, Total: total}, nil
+}
+
+// Resolve approves or denies an appeal.
+// An approved appeal publishes the grumble with its original posted_at and a fresh expiry,
+// counting the author's chosen lifetime from the approval.
+func (uc *ModerationAppealUseCase) Resolve(ctx context.Context, req ResolveAppealRequest) (*moderation.Appeal, error) {
+	appeal, err := uc.appealRepo.FindByID(ctx, req.AppealID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := appeal.Resolve(req.Decision, req.Note, req.ModeratorID, now); err != nil {
+		return nil, err
+	}
+
+	kind := notification.KindAppealDenied
+	message := appealDeniedNotificationMessage
+	label := moderation.ReviewLabelCorrect
+	var g *grumble.Grumble
+	if appeal.Status == moderation.AppealStatusApproved {
+		g, err = uc.grumbleRepo.FindByID(ctx, appeal.GrumbleID)
+		if err != nil {
+			return nil, err
+		}
+		// Publish before anything is stored so a grumble that can no longer be published leaves the appeal pending
+		if err := g.Publish(); err != nil {
+			return nil, err
+		}
+		loc := uc.eventTimeSvc.Location(g.Timezone)
+		g.ExpiresAt = uc.lifetimePolicy.ExpiresAt(g.Lifetime, now, uc.eventTimeSvc.In(loc).CalculateNextMidnight(now))
+		kind = notification.KindAppealApproved
+		message = appealApprovedNotificationMessage
+		label = moderation.ReviewLabelFalsePositive
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.appealRepo.UpdateResolution(ctx, appeal); err != nil {
+			return err
+		}
+		if g != nil {
+			if err := uc.grumbleRepo.Update(ctx, g); err != nil {
+				return err
+			}
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.ModeratorID,
+			Action:     audit.ActionAppealResolve,
+			TargetType: audit.TargetAppeal,
+			TargetID:   strconv.FormatInt(int64(appeal.AppealID), 10),
+			Reason:     req.Note,
+			Detail: map[string]any{
+				"grumble_id": string(appeal.GrumbleID),
+				"decision":   string(appeal.Status),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.labelVerdict(ctx, appeal, label, req.ModeratorID, now)
+
+	if err := uc.notificationRepo.Create(ctx, &notification.Notification{
+		UserID:    appeal.UserID,
+		Kind:      kind,
+		GrumbleID: &appeal.GrumbleID,
+		Message:   message,
+		CreatedAt: now,
+	}); err != nil {
+		// The decision is already applied; a lost notification must not undo it
+		uc.logger.ErrorContext(ctx, "Failed to notify appeal outcome", "appeal_id", appeal.AppealID, "error", err)
+	}
+
+	return appeal, nil
+}
+
+// labelVerdict feeds the appeal outcome back into the verdict log unless a moderator already reviewed it
+func (uc *ModerationAppealUseCase) labelVerdict(ctx context.Context, appeal *moderation.Appeal, label moderation.ReviewLabel, moderator shared.UserID, at time.Time) {
+	if appeal.VerdictID == nil {
+		return
+	}
+
+	verdict, err := uc.verdictRepo.FindByID(ctx, *appeal.VerdictID)
+	if err != nil {
+		uc.logger.WarnContext(ctx, "Failed to load appealed verdict", "verdict_id", *appeal.VerdictID, "error", err)
+		return
+	}
+	if verdict.ReviewLabel != nil {
+		return
+	}
+
+	note := fmt.Sprintf("appeal %d %s", appeal.AppealID, appeal.Status)
+	if err := verdict.Review(label, &note, moderator, at); err != nil {
+		uc.logger.WarnContext(ctx, "Failed to label appealed verdict", "verdict_id", verdict.VerdictID, "error", err)
+		return
+	}
+	if err := uc.verdictRepo.UpdateReview(ctx, verdict); err != nil {
+		uc.logger.WarnContext(ctx, "Failed to label appealed verdict", "verdict_id", verdict.VerdictID, "error", err)
+	}
+}
@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+)
+
+// fakeStoredGrumbleRepo serves grumbles by ID and records updates.
+type fakeStoredGrumbleRepo struct {
+	grumble.Repository
+	byID    map[shared.GrumbleID]*grumble.Grumble
+	updated []*grumble.Grumble
+}
+
+func (r *fakeStoredGrumbleRepo) FindByID(_ context.Context, id shared.GrumbleID) (*grumble.Grumble, error) {
+	if g, ok := r.byID[id]; ok {
+		return g, nil
+	}
+	return nil, &shared.NotFoundError{Entity: "Grumble", ID: string(id)}
+}
+
+func (r *fakeStoredGrumbleRepo) Update(_ context.Context, g *grumble.Grumble) error {
+	r.updated = append(r.updated, g)
+	return nil
+}
+
+// fakeAppealVerdictRepo serves a single verdict and records review updates.
+type fakeAppealVerdictRepo struct {
+	moderation.VerdictRepository
+	verdict  *moderation.Verdict
+	reviewed []*moderation.Verdict
+}
+
+func (r *fakeAppealVerdictRepo) FindLatestByGrumble(_ context.Context, id shared.GrumbleID) (*moderation.Verdict, error) {
+	if r.verdict == nil {
+		return nil, &shared.NotFoundError{Entity: "ModerationVerdict", ID: string(id)}
+	}
+	return r.verdict, nil
+}
+
+func (r *fakeAppealVerdictRepo) FindByID(_ context.Context, _ moderation.VerdictID) (*moderation.Verdict, error) {
+	return r.verdict, nil
+}
+
+func (r *fakeAppealVerdictRepo) UpdateReview(_ context.Context, v *moderation.Verdict) error {
+	r.reviewed = append(r.reviewed, v)
+	return nil
+}
+
+// fakeAppealRepo keeps appeals in memory.
+type fakeAppealRepo struct {
+	moderation.AppealRepository
+	appeals  []*moderation.Appeal
+	resolved int
+}
+
+func (r *fakeAppealRepo) Create(_ context.Context, a *moderation.Appeal) error {
+	for _, existing := range r.appeals {
+		if existing.GrumbleID == a.GrumbleID {
+			return &shared.ConflictError{Message: "already appealed"}
+		}
+	}
+	a.AppealID = moderation.AppealID(len(r.appeals) + 1)
+	r.appeals = append(r.appeals, a)
+	return nil
+}
+
+func (r *fakeAppealRepo) FindByID(_ context.Context, id moderation.AppealID) (*moderation.Appeal, error) {
+	for _, a := range r.appeals {
+		if a.AppealID == id {
+			return a, nil
+		}
+	}
+	return nil, &shared.NotFoundError{Entity: "ModerationAppeal", ID: "?"}
+}
+
+func (r *fakeAppealRepo) UpdateResolution(_ context.Context, _ *moderation.Appeal) error {
+	r.resolved++
+	return nil
+}
+
+// fakeTransactor runs the unit of work directly and counts how often it was asked to.
+type fakeTransactor struct {
+	calls int
+}
+
+func (t *fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.calls++
+	return fn(ctx)
+}
+
+const (
+	testAuthorID  shared.UserID    = "00000000-0000-0000-0000-000000000001"
+	testGrumbleID shared.GrumbleID = "00000000-0000-0000-0000-0000000000aa"
+)
+
+type appealFixture struct {
+	uc            *ModerationAppealUseCase
+	grumble       *grumble.Grumble
+	grumbles      *fakeStoredGrumbleRepo
+	verdicts      *fakeAppealVerdictRepo
+	appeals       *fakeAppealRepo
+	notifications *fakeNotificationRepo
+}
+
+func newAppealFixture(status grumble.ModerationStatus) *appealFixture {
+	postedAt := time.Now().Add(-30 * time.Hour)
+	lifecycle := grumble.StatusActive
+	if status == grumble.ModerationStatusRejected {
+		lifecycle = grumble.StatusRemoved
+	}
+	g := &grumble.Grumble{
+		GrumbleID:        testGrumbleID,
+		UserID:           testAuthorID,
+		Content:          "上司の指示がころころ変わる",
+		PostedAt:         postedAt,
+		ExpiresAt:        postedAt.Add(time.Hour),
+		ModerationStatus: status,
+		Status:           lifecycle,
+	}
+	grumbleID := testGrumbleID
+	f := &appealFixture{
+		grumble:  g,
+		grumbles: &fakeStoredGrumbleRepo{byID: map[shared.GrumbleID]*grumble.Grumble{g.GrumbleID: g}},
+		verdicts: &fakeAppealVerdictRepo{verdict: &moderation.Verdict{
+			VerdictID: 7,
+			UserID:    testAuthorID,
+			GrumbleID: &grumbleID,
+			Decision:  moderation.DecisionRejected,
+			Reason:    "特定の人物への攻撃",
+		}},
+		appeals:       &fakeAppealRepo{},
+		notifications: &fakeNotificationRepo{},
+	}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	f.uc = NewModerationAppealUseCase(f.grumbles, f.verdicts, f.appeals, f.notifications, &fakeAuditRepo{}, &fakeTransactor{}, sharedservice.NewEventTimeService(), grumble.LifetimePolicy{Options: []grumble.Lifetime{"6h"}, Minimum: time.Hour}, logger)
+	return f
+}
+
+func TestModerationAppealUseCase_Submit(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  grumble.ModerationStatus
+		userID  shared.UserID
+		wantErr error
+	}{
+		{"拒否された自分の投稿は申し立てできる", grumble.ModerationStatusRejected, testAuthorID, nil},
+		{"公開済みの投稿は申し立てできない", grumble.ModerationStatusPublished, testAuthorID, &shared.ValidationError{}},
+		{"他人の投稿は見つからない扱い", grumble.ModerationStatusRejected, "00000000-0000-0000-0000-000000000002", &shared.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAppealFixture(tt.status)
+
+			appeal, err := f.uc.Submit(context.Background(), SubmitAppealRequest{UserID: tt.userID, GrumbleID: testGrumbleID})
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("Submit() error = %v", err)
+				}
+				if !appeal.IsPending() || appeal.RejectionReason != "特定の人物への攻撃" || appeal.VerdictID == nil {
+					t.Errorf("appeal = %+v, want pending appeal linked to the verdict", appeal)
+				}
+			case *shared.ValidationError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Submit() error = %v, want ValidationError", err)
+				}
+			case *shared.NotFoundError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Submit() error = %v, want NotFoundError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestModerationAppealUseCase_SubmitTwiceConflicts(t *testing.T) {
+	f := newAppealFixture(grumble.ModerationStatusRejected)
+	req := SubmitAppealRequest{UserID: testAuthorID, GrumbleID: testGrumbleID}
+
+	if _, err := f.uc.Submit(context.Background(), req); err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	_, err := f.uc.Submit(context.Background(), req)
+
+	var conflictErr *shared.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("Submit() error = %v, want ConflictError", err)
+	}
+}
+
+func TestModerationAppealUseCase_Resolve(t *testing.T) {
+	tests := []struct {
+		name          string
+		decision      moderation.AppealStatus
+		wantPublished bool
+		wantKind      notification.Kind
+		wantLabel     moderation.ReviewLabel
+	}{
+		{"承認で公開し期限を更新", moderation.AppealStatusApproved, true, notification.KindAppealApproved, moderation.ReviewLabelFalsePositive},
+		{"却下は非公開のまま", moderation.AppealStatusDenied, false, notification.KindAppealDenied, moderation.ReviewLabelCorrect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAppealFixture(grumble.ModerationStatusRejected)
+			originalPostedAt := f.grumble.PostedAt
+			appeal, err := f.uc.Submit(context.Background(), SubmitAppealRequest{UserID: testAuthorID, GrumbleID: testGrumbleID})
+			if err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+
+			resolved, err := f.uc.Resolve(context.Background(), ResolveAppealRequest{
+				AppealID:    appeal.AppealID,
+				Decision:    tt.decision,
+				ModeratorID: "00000000-0000-0000-0000-0000000000ff",
+			})
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if resolved.Status != tt.decision || resolved.ResolvedAt == nil {
+				t.Errorf("appeal = %+v, want resolved as %s", resolved, tt.decision)
+			}
+
+			if tt.wantPublished {
+				if len(f.grumbles.updated) != 1 || !f.grumble.IsPublished() {
+					t.Fatalf("grumble status = %q, want published", f.grumble.ModerationStatus)
+				}
+				if !f.grumble.PostedAt.Equal(originalPostedAt) {
+					t.Errorf("PostedAt changed to %v, want %v", f.grumble.PostedAt, originalPostedAt)
+				}
+				if !f.grumble.ExpiresAt.After(time.Now()) {
+					t.Errorf("ExpiresAt = %v, want a fresh expiry", f.grumble.ExpiresAt)
+				}
+			} else if len(f.grumbles.updated) != 0 || !f.grumble.IsRejected() {
+				t.Errorf("grumble status = %q, want rejected and untouched", f.grumble.ModerationStatus)
+			}
+
+			if len(f.notifications.created) != 1 || f.notifications.created[0].Kind != tt.wantKind {
+				t.Errorf("notifications = %+v, want one %s", f.notifications.created, tt.wantKind)
+			}
+			if len(f.verdicts.reviewed) != 1 || *f.verdicts.reviewed[0].ReviewLabel != tt.wantLabel {
+				t.Errorf("verdict reviews = %+v, want label %s", f.verdicts.reviewed, tt.wantLabel)
+			}
+
+			// A resolved appeal cannot be decided again
+			_, err = f.uc.Resolve(context.Background(), ResolveAppealRequest{AppealID: appeal.AppealID, Decision: tt.decision})
+			var conflictErr *shared.ConflictError
+			if !errors.As(err, &conflictErr) {
+				t.Errorf("second Resolve() error = %v, want ConflictError", err)
+			}
+		})
+	}
+}
+
+func TestModerationAppealUseCase_ResolveUnpublishableLeavesAppealPending(t *testing.T) {
+	f := newAppealFixture(grumble.ModerationStatusRejected)
+	appeal, err := f.uc.Submit(context.Background(), SubmitAppealRequest{UserID: testAuthorID, GrumbleID: testGrumbleID})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+	// The author deleted the grumble while the appeal was queued
+	f.grumble.Status = grumble.StatusDeleted
+
+	_, err = f.uc.Resolve(context.Background(), ResolveAppealRequest{
+		AppealID:    appeal.AppealID,
+		Decision:    moderation.AppealStatusApproved,
+		ModeratorID: "00000000-0000-0000-0000-0000000000ff",
+	})
+
+	var conflictErr *shared.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("Resolve() error = %v, want ConflictError", err)
+	}
+	if f.appeals.resolved != 0 || len(f.grumbles.updated) != 0 {
+		t.Errorf("stored %d resolutions and %d grumbles, want nothing stored", f.appeals.resolved, len(f.grumbles.updated))
+	}
+}
+
+func TestModerationAppealUseCase_ResolveKeepsChosenLifetime(t *testing.T) {
+	f := newAppealFixture(grumble.ModerationStatusRejected)
+	// Posted with the 6h lifetime, whose expiry no longer tells it apart
+	f.grumble.Lifetime = "6h"
+	f.grumble.ExpiresAt = f.grumble.PostedAt.Add(time.Hour)
+	appeal, err := f.uc.Submit(context.Background(), SubmitAppealRequest{UserID: testAuthorID, GrumbleID: testGrumbleID})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	before := time.Now()
+	if _, err := f.uc.Resolve(context.Background(), ResolveAppealRequest{
+		AppealID:    appeal.AppealID,
+		Decision:    moderation.AppealStatusApproved,
+		ModeratorID: "00000000-0000-0000-0000-0000000000ff",
+	}); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	if got := f.grumble.ExpiresAt.Sub(before); got < 6*time.Hour || got > 6*time.Hour+time.Minute {
+		t.Errorf("ExpiresAt = %v after approval, want the 6h lifetime again", got)
+	}
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// ModerationReviewUseCase lets moderators browse and label the verdict log
+type ModerationReviewUseCase struct {
+	verdictRepo moderation.VerdictRepository
+	auditRepo   audit.Repository
+	transactor  Transactor
+	logger      logging.Logger
+}
+
+// NewModerationReviewUseCase creates a new ModerationReviewUseCase
+func NewModerationReviewUseCase(verdictRepo moderation.VerdictRepository, auditRepo audit.Repository, transactor Transactor, logger logging.Logger) *ModerationReviewUseCase {
+	return &ModerationReviewUseCase{
+		verdictRepo: verdictRepo,
+		auditRepo:   auditRepo,
+		transactor:  transactor,
+		logger:      logger,
+	}
+}
+
+// ListVerdictsResponse represents a page of the verdict log
+type ListVerdictsResponse struct {
+	Verdicts []*moderation.Verdict
+	Total    int
+}
+
+// ReviewVerdictRequest represents a moderator's label for a verdict
+type ReviewVerdictRequest struct {
+	VerdictID  moderation.VerdictID
+	Label      moderation.ReviewLabel
+	Note       *string
+	ReviewerID shared.UserID
+}
+
+// List returns verdicts matching the filter
+func (uc *ModerationReviewUseCase) List(ctx context.Context, filter moderation.VerdictFilter) (*ListVerdictsResponse, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return nil, &shared.ValidationError{Field: "to", Message: "to must be after from"}
+	}
+
+	verdicts, err := uc.verdictRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.verdictRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListVerdictsResponse{Verdicts: verdicts, Total: total}, nil
+}
+
+// Review labels a verdict as correct, a false positive or a false negative
+func (uc *ModerationReviewUseCase) Review(ctx context.Context, req ReviewVerdictRequest) (*moderation.Verdict, error) {
+	verdict, err := uc.verdictRepo.FindByID(ctx, req.VerdictID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verdict.Review(req.Label, req.Note, req.ReviewerID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.verdictRepo.UpdateReview(ctx, verdict); err != nil {
+			return err
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.ReviewerID,
+			Action:     audit.ActionVerdictReview,
+			TargetType: audit.TargetVerdict,
+			TargetID:   strconv.FormatInt(int64(verdict.VerdictID), 10),
+			Reason:     req.Note,
+			Detail:     map[string]any{"label": string(req.Label)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return verdict, nil
+}
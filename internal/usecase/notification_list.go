@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// NotificationListUseCase returns a user's notifications
+type NotificationListUseCase struct {
+	notificationRepo notification.Repository
+}
+
+// NewNotificationListUseCase creates a new NotificationListUseCase
+func NewNotificationListUseCase(notificationRepo notification.Repository) *NotificationListUseCase {
+	return &NotificationListUseCase{notificationRepo: notificationRepo}
+}
+
+// List returns the most recent notifications for the user
+func (uc *NotificationListUseCase) List(ctx context.Context, userID shared.UserID, limit int) ([]*notification.Notification, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		return nil, &shared.ValidationError{Field: "limit", Message: "limit must be 100 or less"}
+	}
+	return uc.notificationRepo.ListByUser(ctx, userID, limit)
+}
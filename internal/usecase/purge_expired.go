@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
 	"github.com/dokkiitech/grumble-back/internal/logging"
@@ -9,15 +10,28 @@ import (
 
 // PurgeExpiredUseCase handles deletion of expired grumbles
 type PurgeExpiredUseCase struct {
-	grumbleRepo grumble.Repository
-	logger      logging.Logger
+	grumbleRepo      grumble.Repository
+	postLog          grumble.PostLog // nil skips pruning the spam post log
+	postLogRetention time.Duration
+	moderationCache  grumble.ModerationCacheStore // nil skips pruning the moderation cache
+	logger           logging.Logger
 }
 
-// NewPurgeExpiredUseCase creates a new PurgeExpiredUseCase
-func NewPurgeExpiredUseCase(grumbleRepo grumble.Repository, logger logging.Logger) *PurgeExpiredUseCase {
+// NewPurgeExpiredUseCase creates a new PurgeExpiredUseCase.
+// Post log entries older than postLogRetention and expired moderation cache entries are pruned on each purge.
+func NewPurgeExpiredUseCase(
+	grumbleRepo grumble.Repository,
+	postLog grumble.PostLog,
+	postLogRetention time.Duration,
+	moderationCache grumble.ModerationCacheStore,
+	logger logging.Logger,
+) *PurgeExpiredUseCase {
 	return &PurgeExpiredUseCase{
-		grumbleRepo: grumbleRepo,
-		logger:      logger,
+		grumbleRepo:      grumbleRepo,
+		postLog:          postLog,
+		postLogRetention: postLogRetention,
+		moderationCache:  moderationCache,
+		logger:           logger,
 	}
 }
 
@@ -33,5 +47,24 @@ func (uc *PurgeExpiredUseCase) Purge(ctx context.Context) (int, error) {
 		uc.logger.InfoContext(ctx, "Purged expired grumbles", "count", count)
 	}
 
+	if uc.postLog != nil {
+		pruned, err := uc.postLog.DeleteBefore(ctx, time.Now().Add(-uc.postLogRetention))
+		if err != nil {
+			// The grumbles are already purged; stale log entries are retried next run
+			uc.logger.ErrorContext(ctx, "Failed to prune post log", "error", err)
+		} else if pruned > 0 {
+			uc.logger.InfoContext(ctx, "Pruned post log", "count", pruned)
+		}
+	}
+
+	if uc.moderationCache != nil {
+		pruned, err := uc.moderationCache.DeleteExpired(ctx)
+		if err != nil {
+			uc.logger.ErrorContext(ctx, "Failed to prune moderation cache", "error", err)
+		} else if pruned > 0 {
+			uc.logger.InfoContext(ctx, "Pruned moderation cache", "count", pruned)
+		}
+	}
+
 	return count, nil
 }
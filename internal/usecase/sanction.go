@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/audit"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// PostingGuard enforces user sanctions when grumbles are posted and moderated
+type PostingGuard interface {
+	// Standing returns the combined effect of the user's active sanctions
+	Standing(ctx context.Context, userID shared.UserID) (sanction.Standing, error)
+
+	// CheckPosting refuses banned users and users in a cooldown
+	CheckPosting(ctx context.Context, userID shared.UserID) (sanction.Standing, error)
+
+	// RecordRejection issues an automatic sanction when the user keeps getting rejected
+	RecordRejection(ctx context.Context, userID shared.UserID) error
+}
+
+// recordRejection feeds a rejection into the sanction policy; a failure must not block moderation
+func recordRejection(ctx context.Context, guard PostingGuard, logger logging.Logger, userID shared.UserID) {
+	if guard == nil {
+		return
+	}
+	if err := guard.RecordRejection(ctx, userID); err != nil {
+		logger.ErrorContext(ctx, "Failed to evaluate sanctions after rejection", "user_id", userID, "error", err)
+	}
+}
+
+// SanctionUseCase tracks repeated moderation rejections, issues and lifts sanctions, and reports a user's standing
+type SanctionUseCase struct {
+	sanctionRepo     sanction.Repository
+	verdictRepo      moderation.VerdictRepository
+	notificationRepo notification.Repository
+	auditRepo        audit.Repository
+	transactor       Transactor
+	policy           sanction.Policy
+	logger           logging.Logger
+}
+
+// NewSanctionUseCase creates a new SanctionUseCase
+func NewSanctionUseCase(
+	sanctionRepo sanction.Repository,
+	verdictRepo moderation.VerdictRepository,
+	notificationRepo notification.Repository,
+	auditRepo audit.Repository,
+	transactor Transactor,
+	policy sanction.Policy,
+	logger logging.Logger,
+) *SanctionUseCase {
+	return &SanctionUseCase{
+		sanctionRepo:     sanctionRepo,
+		verdictRepo:      verdictRepo,
+		notificationRepo: notificationRepo,
+		auditRepo:        auditRepo,
+		transactor:       transactor,
+		policy:           policy,
+		logger:           logger,
+	}
+}
+
+// Notification messages sent when an automatic sanction is issued.
+// Shadow-bans are deliberately not announced.
+const (
+	sanctionWarningNotificationMessage  = "不適切と判定された投稿が続いています。このまま続くと投稿が制限されます。"
+	sanctionCooldownNotificationMessage = "不適切と判定された投稿が続いたため、%d分間は投稿できません。"
+)
+
+// IssueSanctionRequest represents an admin's manual sanction
+type IssueSanctionRequest struct {
+	UserID   shared.UserID
+	Level    sanction.Level
+	Reason   string
+	Duration time.Duration // Zero means until revoked; required for a cooldown
+	AdminID  shared.UserID
+}
+
+// RevokeSanctionRequest represents an admin lifting a sanction
+type RevokeSanctionRequest struct {
+	UserID     shared.UserID
+	SanctionID sanction.SanctionID
+	Reason     string
+	AdminID    shared.UserID
+}
+
+// Standing returns the combined effect of the user's active sanctions
+func (uc *SanctionUseCase) Standing(ctx context.Context, userID shared.UserID) (sanction.Standing, error) {
+	now := time.Now()
+	active, err := uc.sanctionRepo.FindActiveByUser(ctx, userID, now)
+	if err != nil {
+		return sanction.Standing{}, err
+	}
+	return sanction.StandingOf(active, now), nil
+}
+
+// CheckPosting refuses banned users and users in a cooldown, and returns the standing otherwise
+func (uc *SanctionUseCase) CheckPosting(ctx context.Context, userID shared.UserID) (sanction.Standing, error) {
+	standing, err := uc.Standing(ctx, userID)
+	if err != nil {
+		return sanction.Standing{}, err
+	}
+	if standing.Banned {
+		return standing, &shared.ForbiddenError{Message: "account is banned"}
+	}
+	if standing.CooldownUntil != nil {
+		return standing, &shared.CooldownError{RetryAfter: time.Until(*standing.CooldownUntil)}
+	}
+	return standing, nil
+}
+
+// RecordRejection issues the next automatic sanction once moderation has rejected the user's posts and edits
+// often enough in the window. Rejections a moderator found to be false positives do not count.
+// Rejections already answered by an earlier automatic sanction are not counted again,
+// and only automatic sanctions within the escalation lookback raise the level.
+func (uc *SanctionUseCase) RecordRejection(ctx context.Context, userID shared.UserID) error {
+	if uc.policy.RejectionThreshold <= 0 {
+		return nil
+	}
+
+	// Concurrent rejections of the same user are decided one after another, so they cannot both issue a sanction
+	var (
+		s          *sanction.Sanction
+		rejections int
+	)
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.sanctionRepo.LockUser(ctx, userID); err != nil {
+			return err
+		}
+
+		history, err := uc.sanctionRepo.ListByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		since := now.Add(-uc.policy.Window)
+		lookback := now.Add(-uc.policy.EscalationLookback)
+		previous := 0
+		for _, h := range history {
+			if h.Source != sanction.SourceAutomatic || h.RevokedAt != nil {
+				continue
+			}
+			if h.CreatedAt.After(lookback) {
+				previous++
+			}
+			if h.CreatedAt.After(since) {
+				since = h.CreatedAt
+			}
+		}
+
+		rejections, err = uc.verdictRepo.CountRejectedByUserSince(ctx, userID, since)
+		if err != nil {
+			return err
+		}
+		if rejections < uc.policy.RejectionThreshold {
+			return nil
+		}
+
+		level, duration := uc.policy.Next(previous)
+		reason := fmt.Sprintf("%d moderation rejections within %s", rejections, uc.policy.Window)
+		s, err = sanction.New(userID, level, sanction.SourceAutomatic, reason, nil, duration, now)
+		if err != nil {
+			return err
+		}
+		return uc.sanctionRepo.Create(ctx, s)
+	})
+	if err != nil || s == nil {
+		return err
+	}
+
+	uc.logger.WarnContext(ctx, "Automatic sanction issued",
+		"user_id", userID,
+		"sanction_id", s.SanctionID,
+		"level", s.Level,
+		"rejections", rejections,
+	)
+	uc.notify(ctx, s)
+
+	return nil
+}
+
+// Issue records a manual sanction
+func (uc *SanctionUseCase) Issue(ctx context.Context, req IssueSanctionRequest) (*sanction.Sanction, error) {
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := sanction.New(req.UserID, req.Level, sanction.SourceManual, reason, &req.AdminID, req.Duration, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.sanctionRepo.Create(ctx, s); err != nil {
+			return err
+		}
+
+		detail := map[string]any{
+			"sanction_id": int64(s.SanctionID),
+			"level":       string(s.Level),
+		}
+		if s.ExpiresAt != nil {
+			detail["expires_at"] = s.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.AdminID,
+			Action:     audit.ActionSanctionIssue,
+			TargetType: audit.TargetUser,
+			TargetID:   string(s.UserID),
+			Reason:     &reason,
+			Detail:     detail,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Revoke lifts a sanction before it expires
+func (uc *SanctionUseCase) Revoke(ctx context.Context, req RevokeSanctionRequest) (*sanction.Sanction, error) {
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := uc.sanctionRepo.FindByID(ctx, req.SanctionID)
+	if err != nil {
+		return nil, err
+	}
+	if s.UserID != req.UserID {
+		return nil, &shared.NotFoundError{Entity: "Sanction", ID: strconv.FormatInt(int64(req.SanctionID), 10)}
+	}
+	if err := s.Revoke(req.AdminID, time.Now()); err != nil {
+		return nil, err
+	}
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.sanctionRepo.UpdateRevocation(ctx, s); err != nil {
+			return err
+		}
+		return appendAudit(ctx, uc.auditRepo, &audit.Entry{
+			ActorID:    req.AdminID,
+			Action:     audit.ActionSanctionRevoke,
+			TargetType: audit.TargetSanction,
+			TargetID:   strconv.FormatInt(int64(s.SanctionID), 10),
+			Reason:     &reason,
+			Detail: map[string]any{
+				"user_id": string(s.UserID),
+				"level":   string(s.Level),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ListByUser returns every sanction issued to the user, newest first
+func (uc *SanctionUseCase) ListByUser(ctx context.Context, userID shared.UserID) ([]*sanction.Sanction, error) {
+	return uc.sanctionRepo.ListByUser(ctx, userID)
+}
+
+// notify tells the user about warnings and cooldowns; a lost notification does not undo the sanction
+func (uc *SanctionUseCase) notify(ctx context.Context, s *sanction.Sanction) {
+	var n *notification.Notification
+	switch s.Level {
+	case sanction.LevelWarning:
+		n = &notification.Notification{Kind: notification.KindSanctionWarning, Message: sanctionWarningNotificationMessage}
+	case sanction.LevelCooldown:
+		minutes := int(s.ExpiresAt.Sub(s.CreatedAt).Minutes())
+		n = &notification.Notification{Kind: notification.KindSanctionCooldown, Message: fmt.Sprintf(sanctionCooldownNotificationMessage, minutes)}
+	default:
+		return
+	}
+
+	n.UserID = s.UserID
+	n.CreatedAt = s.CreatedAt
+	if err := uc.notificationRepo.Create(ctx, n); err != nil {
+		uc.logger.ErrorContext(ctx, "Failed to notify sanction", "sanction_id", s.SanctionID, "error", err)
+	}
+}
@@ -0,0 +1,416 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/moderation"
+	"github.com/dokkiitech/grumble-back/internal/domain/notification"
+	"github.com/dokkiitech/grumble-back/internal/domain/sanction"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	sharedservice "github.com/dokkiitech/grumble-back/internal/domain/shared/service"
+)
+
+// fakeSanctionRepo keeps sanctions in memory, newest last.
+// LockUser holds userLock until the transaction opened by a lockReleasingTransactor ends.
+type fakeSanctionRepo struct {
+	sanction.Repository
+	sanctions []*sanction.Sanction
+	userLock  sync.Mutex
+}
+
+func (r *fakeSanctionRepo) Create(_ context.Context, s *sanction.Sanction) error {
+	s.SanctionID = sanction.SanctionID(len(r.sanctions) + 1)
+	r.sanctions = append(r.sanctions, s)
+	return nil
+}
+
+func (r *fakeSanctionRepo) FindByID(_ context.Context, id sanction.SanctionID) (*sanction.Sanction, error) {
+	for _, s := range r.sanctions {
+		if s.SanctionID == id {
+			return s, nil
+		}
+	}
+	return nil, &shared.NotFoundError{Entity: "Sanction"}
+}
+
+func (r *fakeSanctionRepo) FindActiveByUser(_ context.Context, userID shared.UserID, at time.Time) ([]*sanction.Sanction, error) {
+	var active []*sanction.Sanction
+	for _, s := range r.sanctions {
+		if s.UserID == userID && s.IsActive(at) {
+			active = append(active, s)
+		}
+	}
+	return active, nil
+}
+
+func (r *fakeSanctionRepo) ListByUser(_ context.Context, userID shared.UserID) ([]*sanction.Sanction, error) {
+	var list []*sanction.Sanction
+	for i := len(r.sanctions) - 1; i >= 0; i-- {
+		if r.sanctions[i].UserID == userID {
+			list = append(list, r.sanctions[i])
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeSanctionRepo) UpdateRevocation(context.Context, *sanction.Sanction) error {
+	return nil
+}
+
+func (r *fakeSanctionRepo) LockUser(ctx context.Context, _ shared.UserID) error {
+	r.userLock.Lock()
+	if release, ok := ctx.Value(txReleaseKey{}).(*[]func()); ok {
+		*release = append(*release, r.userLock.Unlock)
+	} else {
+		r.userLock.Unlock()
+	}
+	return nil
+}
+
+// txReleaseKey carries the locks to release when a lockReleasingTransactor's transaction ends
+type txReleaseKey struct{}
+
+// lockReleasingTransactor releases the locks taken inside the unit of work once it returns, as a transaction would.
+type lockReleasingTransactor struct{}
+
+func (lockReleasingTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var release []func()
+	err := fn(context.WithValue(ctx, txReleaseKey{}, &release))
+	for _, unlock := range release {
+		unlock()
+	}
+	return err
+}
+
+func (r *fakeSanctionRepo) levels() []sanction.Level {
+	levels := make([]sanction.Level, len(r.sanctions))
+	for i, s := range r.sanctions {
+		levels[i] = s.Level
+	}
+	return levels
+}
+
+// fakeRejectionVerdictRepo remembers when moderation rejected the author's posts.
+type fakeRejectionVerdictRepo struct {
+	fakeVerdictRepo
+	rejectedAt []time.Time
+}
+
+func (r *fakeRejectionVerdictRepo) CountRejectedByUserSince(_ context.Context, _ shared.UserID, since time.Time) (int, error) {
+	count := 0
+	for _, at := range r.rejectedAt {
+		if at.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+var testSanctionPolicy = sanction.Policy{
+	RejectionThreshold: 2,
+	Window:             24 * time.Hour,
+	EscalationLookback: 30 * 24 * time.Hour,
+	CooldownDuration:   time.Hour,
+	ShadowBanDuration:  7 * 24 * time.Hour,
+}
+
+func newTestSanctionUseCase(verdicts moderation.VerdictRepository) (*SanctionUseCase, *fakeSanctionRepo, *fakeNotificationRepo, *fakeAuditRepo) {
+	sanctions := &fakeSanctionRepo{}
+	notifications := &fakeNotificationRepo{}
+	audits := &fakeAuditRepo{}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	return NewSanctionUseCase(sanctions, verdicts, notifications, audits, &fakeTransactor{}, testSanctionPolicy, logger), sanctions, notifications, audits
+}
+
+func TestSanctionUseCase_RecordRejection(t *testing.T) {
+	tests := []struct {
+		name       string
+		rejections int
+		wantLevels []sanction.Level
+		wantKinds  []notification.Kind
+	}{
+		{"閾値未満は制裁なし", 1, nil, nil},
+		{"閾値で警告", 2, []sanction.Level{sanction.LevelWarning}, []notification.Kind{notification.KindSanctionWarning}},
+		{"警告前の拒否は数え直さない", 3, []sanction.Level{sanction.LevelWarning}, []notification.Kind{notification.KindSanctionWarning}},
+		{"次はクールダウン", 4,
+			[]sanction.Level{sanction.LevelWarning, sanction.LevelCooldown},
+			[]notification.Kind{notification.KindSanctionWarning, notification.KindSanctionCooldown}},
+		{"シャドウBANは通知しない", 6,
+			[]sanction.Level{sanction.LevelWarning, sanction.LevelCooldown, sanction.LevelShadowBan},
+			[]notification.Kind{notification.KindSanctionWarning, notification.KindSanctionCooldown}},
+		{"自動ではシャドウBANより重くしない", 8,
+			[]sanction.Level{sanction.LevelWarning, sanction.LevelCooldown, sanction.LevelShadowBan, sanction.LevelShadowBan},
+			[]notification.Kind{notification.KindSanctionWarning, notification.KindSanctionCooldown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdicts := &fakeRejectionVerdictRepo{}
+			uc, sanctions, notifications, _ := newTestSanctionUseCase(verdicts)
+
+			for i := 0; i < tt.rejections; i++ {
+				verdicts.rejectedAt = append(verdicts.rejectedAt, time.Now())
+				if err := uc.RecordRejection(context.Background(), testAuthorID); err != nil {
+					t.Fatalf("RecordRejection() error = %v", err)
+				}
+			}
+
+			if got := sanctions.levels(); !slices.Equal(got, tt.wantLevels) {
+				t.Errorf("sanction levels = %v, want %v", got, tt.wantLevels)
+			}
+			for _, s := range sanctions.sanctions {
+				if s.Source != sanction.SourceAutomatic || s.IssuedBy != nil {
+					t.Errorf("sanction %+v, want an automatic sanction without an issuer", s)
+				}
+			}
+			kinds := make([]notification.Kind, len(notifications.created))
+			for i, n := range notifications.created {
+				kinds[i] = n.Kind
+			}
+			if !slices.Equal(kinds, tt.wantKinds) {
+				t.Errorf("notification kinds = %v, want %v", kinds, tt.wantKinds)
+			}
+		})
+	}
+}
+
+func TestSanctionUseCase_RecordRejection_RevokedSanctionIsNotCounted(t *testing.T) {
+	verdicts := &fakeRejectionVerdictRepo{}
+	uc, sanctions, _, _ := newTestSanctionUseCase(verdicts)
+
+	for i := 0; i < 2; i++ {
+		verdicts.rejectedAt = append(verdicts.rejectedAt, time.Now())
+		if err := uc.RecordRejection(context.Background(), testAuthorID); err != nil {
+			t.Fatalf("RecordRejection() error = %v", err)
+		}
+	}
+	if _, err := uc.Revoke(context.Background(), RevokeSanctionRequest{
+		UserID:     testAuthorID,
+		SanctionID: sanctions.sanctions[0].SanctionID,
+		Reason:     "誤判定だった",
+		AdminID:    testAdminID,
+	}); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+
+	// With the warning revoked, the same rejections count again and start over at a warning
+	verdicts.rejectedAt = append(verdicts.rejectedAt, time.Now())
+	if err := uc.RecordRejection(context.Background(), testAuthorID); err != nil {
+		t.Fatalf("RecordRejection() error = %v", err)
+	}
+	want := []sanction.Level{sanction.LevelWarning, sanction.LevelWarning}
+	if got := sanctions.levels(); !slices.Equal(got, want) {
+		t.Errorf("sanction levels = %v, want %v", got, want)
+	}
+}
+
+func TestSanctionUseCase_RecordRejection_OldSanctionsDoNotEscalate(t *testing.T) {
+	verdicts := &fakeRejectionVerdictRepo{}
+	uc, sanctions, _, _ := newTestSanctionUseCase(verdicts)
+	longAgo := time.Now().Add(-testSanctionPolicy.EscalationLookback - time.Hour)
+	sanctions.sanctions = []*sanction.Sanction{
+		{SanctionID: 1, UserID: testAuthorID, Level: sanction.LevelWarning, Source: sanction.SourceAutomatic, CreatedAt: longAgo},
+		{SanctionID: 2, UserID: testAuthorID, Level: sanction.LevelCooldown, Source: sanction.SourceAutomatic, CreatedAt: longAgo},
+	}
+
+	for i := 0; i < 2; i++ {
+		verdicts.rejectedAt = append(verdicts.rejectedAt, time.Now())
+		if err := uc.RecordRejection(context.Background(), testAuthorID); err != nil {
+			t.Fatalf("RecordRejection() error = %v", err)
+		}
+	}
+
+	// Sanctions older than the lookback no longer count, so escalation starts over at a warning
+	want := []sanction.Level{sanction.LevelWarning, sanction.LevelCooldown, sanction.LevelWarning}
+	if got := sanctions.levels(); !slices.Equal(got, want) {
+		t.Errorf("sanction levels = %v, want %v", got, want)
+	}
+}
+
+func TestSanctionUseCase_RecordRejection_ConcurrentRejectionsIssueOneSanction(t *testing.T) {
+	verdicts := &fakeRejectionVerdictRepo{}
+	for i := 0; i < testSanctionPolicy.RejectionThreshold; i++ {
+		verdicts.rejectedAt = append(verdicts.rejectedAt, time.Now())
+	}
+	sanctions := &fakeSanctionRepo{}
+	logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+	uc := NewSanctionUseCase(sanctions, verdicts, &fakeNotificationRepo{}, &fakeAuditRepo{}, lockReleasingTransactor{}, testSanctionPolicy, logger)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- uc.RecordRejection(context.Background(), testAuthorID)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("RecordRejection() error = %v", err)
+		}
+	}
+
+	// The second rejection sees the warning issued for the first and does not count the same rejections again
+	if got, want := sanctions.levels(), []sanction.Level{sanction.LevelWarning}; !slices.Equal(got, want) {
+		t.Errorf("sanction levels = %v, want %v", got, want)
+	}
+}
+
+func TestSanctionUseCase_CheckPosting(t *testing.T) {
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+	expired := now.Add(-time.Minute)
+	inAnHour := now.Add(time.Hour)
+	admin := testAdminID
+
+	tests := []struct {
+		name     string
+		existing *sanction.Sanction
+		wantErr  error
+	}{
+		{"制裁なし", nil, nil},
+		{"警告だけなら投稿できる", &sanction.Sanction{Level: sanction.LevelWarning}, nil},
+		{"クールダウン中", &sanction.Sanction{Level: sanction.LevelCooldown, ExpiresAt: &inAnHour}, &shared.CooldownError{}},
+		{"期限切れのクールダウン", &sanction.Sanction{Level: sanction.LevelCooldown, ExpiresAt: &expired}, nil},
+		{"BAN", &sanction.Sanction{Level: sanction.LevelBan}, &shared.ForbiddenError{}},
+		{"解除済みのBAN", &sanction.Sanction{Level: sanction.LevelBan, RevokedBy: &admin, RevokedAt: &expired}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, sanctions, _, _ := newTestSanctionUseCase(&fakeRejectionVerdictRepo{})
+			if tt.existing != nil {
+				tt.existing.UserID = testAuthorID
+				tt.existing.CreatedAt = hourAgo
+				sanctions.sanctions = append(sanctions.sanctions, tt.existing)
+			}
+
+			_, err := uc.CheckPosting(context.Background(), testAuthorID)
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("CheckPosting() error = %v", err)
+				}
+			case *shared.CooldownError:
+				if !errors.As(err, &want) {
+					t.Fatalf("CheckPosting() error = %v, want CooldownError", err)
+				}
+				if want.RetryAfter <= 0 || want.RetryAfter > time.Hour {
+					t.Errorf("RetryAfter = %s, want within the remaining hour", want.RetryAfter)
+				}
+			case *shared.ForbiddenError:
+				if !errors.As(err, &want) {
+					t.Fatalf("CheckPosting() error = %v, want ForbiddenError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSanctionUseCase_IssueAndRevoke(t *testing.T) {
+	uc, _, _, audits := newTestSanctionUseCase(&fakeRejectionVerdictRepo{})
+
+	issued, err := uc.Issue(context.Background(), IssueSanctionRequest{
+		UserID:  testAuthorID,
+		Level:   sanction.LevelShadowBan,
+		Reason:  "スパムの連投",
+		AdminID: testAdminID,
+	})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if issued.Source != sanction.SourceManual || issued.ExpiresAt != nil {
+		t.Errorf("issued = %+v, want a manual sanction without expiry", issued)
+	}
+
+	if _, err := uc.Issue(context.Background(), IssueSanctionRequest{
+		UserID:  testAuthorID,
+		Level:   sanction.LevelCooldown,
+		Reason:  "期間の指定漏れ",
+		AdminID: testAdminID,
+	}); err == nil {
+		t.Error("Issue() of a cooldown without duration succeeded, want ValidationError")
+	}
+
+	revokeReq := RevokeSanctionRequest{UserID: reporterID(1), SanctionID: issued.SanctionID, Reason: "別人", AdminID: testAdminID}
+	var notFoundErr *shared.NotFoundError
+	if _, err := uc.Revoke(context.Background(), revokeReq); !errors.As(err, &notFoundErr) {
+		t.Errorf("Revoke() for another user error = %v, want NotFoundError", err)
+	}
+
+	revokeReq.UserID = testAuthorID
+	if _, err := uc.Revoke(context.Background(), revokeReq); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	var conflictErr *shared.ConflictError
+	if _, err := uc.Revoke(context.Background(), revokeReq); !errors.As(err, &conflictErr) {
+		t.Errorf("second Revoke() error = %v, want ConflictError", err)
+	}
+
+	if len(audits.entries) != 2 {
+		t.Fatalf("audit entries = %d, want issue and revoke", len(audits.entries))
+	}
+}
+
+func TestGrumblePostUseCase_Post_Sanctioned(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       sanction.Level
+		wantStatus  grumble.ModerationStatus
+		wantErr     bool
+		wantCreated int
+	}{
+		{"シャドウBAN中は本人にだけ見える", sanction.LevelShadowBan, grumble.ModerationStatusShadowed, false, 1},
+		{"BAN中は投稿できない", sanction.LevelBan, "", true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeGrumbleRepo{}
+			guard, sanctions, _, _ := newTestSanctionUseCase(&fakeRejectionVerdictRepo{})
+			sanctions.sanctions = append(sanctions.sanctions, &sanction.Sanction{
+				UserID:    testAuthorID,
+				Level:     tt.level,
+				CreatedAt: time.Now().Add(-time.Minute),
+			})
+			filter := &fakeContentFilter{result: &grumble.ModerationResult{IsAppropriate: true, Reason: "問題なし"}}
+			logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+			uc := NewGrumblePostUseCase(repo, sharedservice.NewEventTimeService(), filter, newTestPromptSelector(t), &fakeVerdictRepo{}, nil, nil, guard, nil, grumble.SpamPolicy{}, grumble.LifetimePolicy{}, grumble.CategoryCatalogue{}, 0, false, grumble.ToxicLevelPolicy{}, 10, 1, 1000, testCrisisSupport, logger)
+
+			g, err := uc.Post(context.Background(), PostGrumbleRequest{
+				UserID:     testAuthorID,
+				Content:    "月曜日つらい",
+				ToxicLevel: shared.ToxicLevel2,
+			})
+
+			if tt.wantErr {
+				var forbiddenErr *shared.ForbiddenError
+				if !errors.As(err, &forbiddenErr) {
+					t.Fatalf("Post() error = %v, want ForbiddenError", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Post() error = %v", err)
+				}
+				if g.ModerationStatus != tt.wantStatus {
+					t.Errorf("ModerationStatus = %q, want %q", g.ModerationStatus, tt.wantStatus)
+				}
+			}
+			if len(repo.created) != tt.wantCreated {
+				t.Errorf("created %d grumbles, want %d", len(repo.created), tt.wantCreated)
+			}
+		})
+	}
+}
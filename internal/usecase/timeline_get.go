@@ -10,12 +10,14 @@ import (
 // TimelineGetUseCase handles retrieving the timeline of grumbles
 type TimelineGetUseCase struct {
 	grumbleRepo grumble.Repository
+	categories  grumble.CategoryCatalogue
 }
 
 // NewTimelineGetUseCase creates a new TimelineGetUseCase
-func NewTimelineGetUseCase(grumbleRepo grumble.Repository) *TimelineGetUseCase {
+func NewTimelineGetUseCase(grumbleRepo grumble.Repository, categories grumble.CategoryCatalogue) *TimelineGetUseCase {
 	return &TimelineGetUseCase{
 		grumbleRepo: grumbleRepo,
+		categories:  categories,
 	}
 }
 
@@ -24,17 +26,25 @@ type TimelineRequest struct {
 	ToxicLevelMin *shared.ToxicLevel // Optional minimum toxic level (inclusive)
 	ToxicLevelMax *shared.ToxicLevel // Optional maximum toxic level (inclusive)
 	IsPurified    *bool              // Optional flag to restrict to specific purification state
+	Tag           *string            // Optional normalized tag name
+	Category      *string            // Optional category key
 	UserID        *shared.UserID     // Optional filter for author
 	ViewerUserID  *shared.UserID     // Authenticated viewer requesting the timeline
 	Page          int                // Page number (1-indexed)
 	PageSize      int                // Number of items per page
 	Offset        int                // Number of items to skip before starting results
+	Cursor        *string            // Optional next_cursor of the previous page; pages by position instead of Offset
+	IncludeTotal  bool               // Count all matching grumbles, which costs a separate query
+
+	ToxicLevelSource grumble.ToxicLevelSource // Which level the toxic filters compare against
 }
 
 // TimelineResponse represents the timeline result
 type TimelineResponse struct {
 	Grumbles   []*grumble.Grumble
-	TotalCount int
+	TotalCount *int   // Set only when IncludeTotal was requested
+	NextCursor string // Cursor for the following page; empty when HasMore is false
+	HasMore    bool
 	Page       int
 	PageSize   int
 }
@@ -59,34 +69,71 @@ func (uc *TimelineGetUseCase) Get(ctx context.Context, req TimelineRequest) (*Ti
 		offset = (req.Page - 1) * req.PageSize
 	}
 
-	// Build filter
-	filter := grumble.TimelineFilter{
-		ToxicLevelMin:  req.ToxicLevelMin,
-		ToxicLevelMax:  req.ToxicLevelMax,
-		IsPurified:     req.IsPurified,
-		ExcludeExpired: true, // Always exclude expired grumbles
-		UserID:         req.UserID,
-		ViewerUserID:   req.ViewerUserID,
-		Limit:          req.PageSize,
-		Offset:         offset,
+	var after *grumble.TimelineCursor
+	if req.Cursor != nil {
+		if req.Offset > 0 {
+			return nil, &shared.ValidationError{Field: "offset", Message: "offset cannot be combined with cursor"}
+		}
+		cursor, err := grumble.DecodeTimelineCursor(*req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+		offset = 0
 	}
 
-	// Get grumbles
-	grumbles, err := uc.grumbleRepo.FindTimeline(ctx, filter)
+	category, err := uc.categories.Resolve(req.Category)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get total count for pagination
-	totalCount, err := uc.grumbleRepo.CountTimeline(ctx, filter)
+	// Build filter
+	filter := grumble.TimelineFilter{
+		ToxicLevelMin:    req.ToxicLevelMin,
+		ToxicLevelMax:    req.ToxicLevelMax,
+		IsPurified:       req.IsPurified,
+		Tag:              req.Tag,
+		Category:         category,
+		UserID:           req.UserID,
+		ViewerUserID:     req.ViewerUserID,
+		ToxicLevelSource: req.ToxicLevelSource,
+		Offset:           offset,
+	}
+
+	// Fetch one extra grumble to tell whether another page follows
+	page := filter
+	page.After = after
+	page.Limit = req.PageSize + 1
+	grumbles, err := uc.grumbleRepo.FindTimeline(ctx, page)
 	if err != nil {
 		return nil, err
 	}
+	grumbles, nextCursor, hasMore := trimPage(grumbles, req.PageSize)
 
-	return &TimelineResponse{
+	resp := &TimelineResponse{
 		Grumbles:   grumbles,
-		TotalCount: totalCount,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
-	}, nil
+	}
+
+	if req.IncludeTotal {
+		totalCount, err := uc.grumbleRepo.CountTimeline(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		resp.TotalCount = &totalCount
+	}
+
+	return resp, nil
+}
+
+// trimPage drops the extra grumble fetched beyond limit and returns the cursor for the page after it
+func trimPage(grumbles []*grumble.Grumble, limit int) ([]*grumble.Grumble, string, bool) {
+	if limit <= 0 || len(grumbles) <= limit {
+		return grumbles, "", false
+	}
+	grumbles = grumbles[:limit]
+	return grumbles, grumble.CursorAfter(grumbles[limit-1]).Encode(), true
 }
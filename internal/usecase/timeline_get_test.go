@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/grumble"
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+)
+
+// fakeTimelineRepo serves a fixed timeline, already in timeline order, honouring After, Offset and Limit.
+type fakeTimelineRepo struct {
+	grumble.Repository
+	timeline []*grumble.Grumble
+	counted  bool
+}
+
+func (r *fakeTimelineRepo) FindTimeline(_ context.Context, filter grumble.TimelineFilter) ([]*grumble.Grumble, error) {
+	start := filter.Offset
+	if filter.After != nil {
+		start = len(r.timeline)
+		for i, g := range r.timeline {
+			if g.GrumbleID == filter.After.GrumbleID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	page := r.timeline[min(start, len(r.timeline)):]
+	if filter.Limit > 0 && len(page) > filter.Limit {
+		page = page[:filter.Limit]
+	}
+	return page, nil
+}
+
+func (r *fakeTimelineRepo) CountTimeline(_ context.Context, _ grumble.TimelineFilter) (int, error) {
+	r.counted = true
+	return len(r.timeline), nil
+}
+
+func TestTimelineGetUseCase_Get_Cursor(t *testing.T) {
+	ids := []shared.GrumbleID{
+		"00000000-0000-0000-0000-0000000000a1",
+		"00000000-0000-0000-0000-0000000000a2",
+		"00000000-0000-0000-0000-0000000000a3",
+	}
+	postedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	repo := &fakeTimelineRepo{}
+	for _, id := range ids {
+		// Posted at the same time, so only the grumble ID tells the pages apart
+		repo.timeline = append(repo.timeline, &grumble.Grumble{GrumbleID: id, PostedAt: postedAt})
+	}
+	uc := NewTimelineGetUseCase(repo, grumble.CategoryCatalogue{})
+
+	first, err := uc.Get(context.Background(), TimelineRequest{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(first.Grumbles) != 2 || !first.HasMore || first.NextCursor == "" {
+		t.Fatalf("first page = %d grumbles, has_more %v, next_cursor %q; want 2, true and a cursor", len(first.Grumbles), first.HasMore, first.NextCursor)
+	}
+	if first.TotalCount != nil || repo.counted {
+		t.Errorf("TotalCount = %v, want no count unless requested", first.TotalCount)
+	}
+
+	second, err := uc.Get(context.Background(), TimelineRequest{PageSize: 2, Cursor: &first.NextCursor, IncludeTotal: true})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(second.Grumbles) != 1 || second.Grumbles[0].GrumbleID != ids[2] {
+		t.Errorf("second page = %v, want only %s", second.Grumbles, ids[2])
+	}
+	if second.HasMore || second.NextCursor != "" {
+		t.Errorf("has_more = %v, next_cursor = %q, want the last page", second.HasMore, second.NextCursor)
+	}
+	if second.TotalCount == nil || *second.TotalCount != len(ids) {
+		t.Errorf("TotalCount = %v, want %d", second.TotalCount, len(ids))
+	}
+}
+
+func TestTimelineGetUseCase_Get_InvalidCursor(t *testing.T) {
+	valid := grumble.TimelineCursor{PostedAt: time.Now(), GrumbleID: "00000000-0000-0000-0000-0000000000a1"}.Encode()
+	invalid := "not a cursor"
+
+	tests := []struct {
+		name string
+		req  TimelineRequest
+	}{
+		{"不正なカーソル", TimelineRequest{Cursor: &invalid}},
+		{"カーソルとオフセットの併用", TimelineRequest{Cursor: &valid, Offset: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewTimelineGetUseCase(&fakeTimelineRepo{}, grumble.CategoryCatalogue{})
+			_, err := uc.Get(context.Background(), tt.req)
+			var validationErr *shared.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("Get() error = %v, want ValidationError", err)
+			}
+		})
+	}
+}
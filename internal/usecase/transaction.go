@@ -0,0 +1,9 @@
+package usecase
+
+import "context"
+
+// Transactor runs a unit of work spanning several repositories atomically.
+// Repositories called with the context passed to fn take part in the transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
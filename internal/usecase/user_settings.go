@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+	"github.com/dokkiitech/grumble-back/internal/logging"
+)
+
+// UserSettingsUseCase lets users change their own preferences
+type UserSettingsUseCase struct {
+	userRepo user.Repository
+	logger   logging.Logger
+}
+
+// NewUserSettingsUseCase creates a new UserSettingsUseCase.
+func NewUserSettingsUseCase(userRepo user.Repository, logger logging.Logger) *UserSettingsUseCase {
+	return &UserSettingsUseCase{
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// UpdateTimezone stores the user's timezone preference.
+// Grumbles posted afterwards expire at midnight and count toward the event day in this zone.
+func (uc *UserSettingsUseCase) UpdateTimezone(ctx context.Context, id shared.UserID, timezone string) (*user.AnonymousUser, error) {
+	u, err := uc.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.SetTimezone(timezone); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.Update(ctx, u); err != nil {
+		return nil, err
+	}
+
+	uc.logger.InfoContext(ctx, "User timezone updated", "user_id", id, "timezone", timezone)
+	return u, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/dokkiitech/grumble-back/internal/domain/shared"
+	"github.com/dokkiitech/grumble-back/internal/domain/user"
+)
+
+// fakeSettingsUserRepo serves a single user and records updates.
+type fakeSettingsUserRepo struct {
+	user.Repository
+	user    *user.AnonymousUser
+	updated int
+}
+
+func (r *fakeSettingsUserRepo) FindByID(_ context.Context, id shared.UserID) (*user.AnonymousUser, error) {
+	if r.user != nil && r.user.UserID == id {
+		return r.user, nil
+	}
+	return nil, &shared.NotFoundError{Entity: "User", ID: string(id)}
+}
+
+func (r *fakeSettingsUserRepo) Update(_ context.Context, _ *user.AnonymousUser) error {
+	r.updated++
+	return nil
+}
+
+func TestUserSettingsUseCase_UpdateTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   shared.UserID
+		timezone string
+		wantErr  error
+	}{
+		{"IANA名なら保存できる", testAuthorID, "America/New_York", nil},
+		{"未知のタイムゾーン", testAuthorID, "Mars/Olympus_Mons", &shared.ValidationError{}},
+		{"空は不可", testAuthorID, "", &shared.ValidationError{}},
+		{"存在しないユーザー", "00000000-0000-0000-0000-000000000002", "UTC", &shared.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSettingsUserRepo{user: &user.AnonymousUser{UserID: testAuthorID}}
+			uc := NewUserSettingsUseCase(repo, slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil)))
+
+			got, err := uc.UpdateTimezone(context.Background(), tt.userID, tt.timezone)
+
+			switch tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("UpdateTimezone() error = %v", err)
+				}
+				if got.Timezone == nil || *got.Timezone != tt.timezone {
+					t.Errorf("Timezone = %v, want %s", got.Timezone, tt.timezone)
+				}
+				if repo.updated != 1 {
+					t.Errorf("updated = %d, want 1", repo.updated)
+				}
+				return
+			case *shared.ValidationError:
+				var validationErr *shared.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "timezone" {
+					t.Fatalf("UpdateTimezone() error = %v, want ValidationError on timezone", err)
+				}
+			case *shared.NotFoundError:
+				var notFoundErr *shared.NotFoundError
+				if !errors.As(err, &notFoundErr) {
+					t.Fatalf("UpdateTimezone() error = %v, want NotFoundError", err)
+				}
+			}
+			if repo.updated != 0 {
+				t.Errorf("updated = %d, want 0", repo.updated)
+			}
+		})
+	}
+}
@@ -64,7 +64,15 @@ func (uc *VibeAddUseCase) Add(ctx context.Context, req AddVibeRequest) (*AddVibe
 		return nil, err
 	}
 
-	if grumbleEntity.IsPurified {
+	// Grumbles that are not published are invisible to everyone but their author
+	if !grumbleEntity.IsPublished() {
+		return nil, &shared.NotFoundError{
+			Entity: "Grumble",
+			ID:     string(req.GrumbleID),
+		}
+	}
+
+	if grumbleEntity.IsPurified() {
 		return nil, &shared.ValidationError{
 			Field:   "grumble",
 			Message: "grumble already purified",
@@ -135,6 +143,6 @@ func (uc *VibeAddUseCase) Add(ctx context.Context, req AddVibeRequest) (*AddVibe
 		Vibe:         createResult.Vibe,
 		VibeCount:    grumbleEntity.VibeCount,
 		VirtuePoints: userEntity.VirtuePoints,
-		IsPurified:   grumbleEntity.IsPurified,
+		IsPurified:   grumbleEntity.IsPurified(),
 	}, nil
 }
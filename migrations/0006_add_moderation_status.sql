@@ -0,0 +1,11 @@
+-- Add moderation_status to grumbles
+-- 'published': タイムラインに公開
+-- 'held': 自傷表現などで非公開保留（投稿者本人のみ閲覧可）
+
+ALTER TABLE grumbles ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (moderation_status IN ('published', 'held'));
+
+ALTER TABLE grumbles_archive ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (moderation_status IN ('published', 'held'));
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_moderation_status ON grumbles(moderation_status) WHERE moderation_status <> 'published';
@@ -0,0 +1,26 @@
+-- モデレーション判定ログ
+-- 全ての判定結果を保存し、プロンプト/モデル変更の評価に使う（本文は保存せずハッシュのみ）
+
+CREATE TABLE IF NOT EXISTS moderation_verdicts (
+    verdict_id BIGSERIAL PRIMARY KEY,
+    content_hash CHAR(64) NOT NULL,
+    user_id UUID NOT NULL,
+    grumble_id UUID,
+    decision VARCHAR(20) NOT NULL CHECK (decision IN ('approved', 'rejected', 'held')),
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    reason TEXT NOT NULL DEFAULT '',
+    model VARCHAR(100) NOT NULL,
+    prompt_version VARCHAR(50) NOT NULL,
+    latency_ms INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- モデレーターによるレビュー
+    review_label VARCHAR(20) CHECK (review_label IN ('correct', 'false_positive', 'false_negative')),
+    review_note TEXT,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_verdicts_created_at ON moderation_verdicts(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_moderation_verdicts_categories ON moderation_verdicts USING GIN (categories);
+CREATE INDEX IF NOT EXISTS idx_moderation_verdicts_user_id ON moderation_verdicts(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_moderation_verdicts_prompt_version ON moderation_verdicts(prompt_version, model);
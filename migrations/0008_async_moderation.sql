@@ -0,0 +1,25 @@
+-- 非同期モデレーション
+-- 'pending': モデレーション待ち（投稿者本人のみ閲覧可）
+-- 'rejected': 非同期モデレーションで不適切と判定（誰にも表示しない）
+
+ALTER TABLE grumbles DROP CONSTRAINT IF EXISTS grumbles_moderation_status_check;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected'));
+
+ALTER TABLE grumbles_archive DROP CONSTRAINT IF EXISTS grumbles_archive_moderation_status_check;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected'));
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_pending_moderation ON grumbles(posted_at) WHERE moderation_status = 'pending';
+
+-- ユーザー通知（モデレーション結果など）
+CREATE TABLE IF NOT EXISTS notifications (
+    notification_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES anonymous_users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    grumble_id UUID,
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications(user_id, created_at DESC);
@@ -0,0 +1,15 @@
+-- モデレーション結果キャッシュ
+-- 正規化した本文とプロンプトバージョンのハッシュをキーに判定結果を保存（本文は保存しない）
+
+CREATE TABLE IF NOT EXISTS moderation_cache (
+    cache_key CHAR(64) PRIMARY KEY,
+    is_appropriate BOOLEAN NOT NULL,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    reason TEXT NOT NULL DEFAULT '',
+    model VARCHAR(100) NOT NULL,
+    prompt_version VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_cache_expires_at ON moderation_cache(expires_at);
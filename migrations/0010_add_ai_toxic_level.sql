@@ -0,0 +1,9 @@
+-- モデレーションで推定した毒レベル（自己申告の toxic_level とは別に保持）
+
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS ai_toxic_level INTEGER CHECK (ai_toxic_level BETWEEN 1 AND 5);
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS ai_toxic_level INTEGER CHECK (ai_toxic_level BETWEEN 1 AND 5);
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_ai_toxic_level ON grumbles(ai_toxic_level);
+
+-- 推定毒レベルもキャッシュする（0 は推定なし）
+ALTER TABLE moderation_cache ADD COLUMN IF NOT EXISTS estimated_toxic_level INTEGER NOT NULL DEFAULT 0;
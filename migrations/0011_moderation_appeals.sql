@@ -0,0 +1,19 @@
+-- モデレーション異議申し立て
+-- 拒否された投稿（moderation_status = 'rejected'）の投稿者が1回だけ申し立てでき、モデレーターが承認/却下する
+-- content / rejection_reason は申し立て時点のスナップショット
+
+CREATE TABLE IF NOT EXISTS moderation_appeals (
+    appeal_id BIGSERIAL PRIMARY KEY,
+    grumble_id UUID NOT NULL UNIQUE,
+    user_id UUID NOT NULL,
+    verdict_id BIGINT REFERENCES moderation_verdicts(verdict_id),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    content TEXT NOT NULL,
+    rejection_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_by UUID,
+    resolved_at TIMESTAMPTZ,
+    resolution_note TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_appeals_status ON moderation_appeals(status, created_at);
@@ -0,0 +1,29 @@
+-- ユーザーによる通報
+-- 'hidden': 通報が閾値に達して自動非表示（モデレーターの確認待ち）
+-- 'removed': モデレーターが確認のうえ削除
+
+ALTER TABLE grumbles DROP CONSTRAINT IF EXISTS grumbles_moderation_status_check;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected', 'hidden', 'removed'));
+
+ALTER TABLE grumbles_archive DROP CONSTRAINT IF EXISTS grumbles_archive_moderation_status_check;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected', 'hidden', 'removed'));
+
+-- grumble_id は外部キーにしない（grumbles_archive へ移動しても通報を残すため）
+CREATE TABLE IF NOT EXISTS grumble_reports (
+    report_id BIGSERIAL PRIMARY KEY,
+    grumble_id UUID NOT NULL,
+    reporter_id UUID NOT NULL REFERENCES anonymous_users(user_id) ON DELETE CASCADE,
+    reason VARCHAR(30) NOT NULL CHECK (reason IN ('spam', 'harassment', 'discrimination', 'personal_info', 'illegal', 'self_harm', 'other')),
+    comment TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- モデレーターによる対応（未対応は NULL）
+    resolution VARCHAR(20) CHECK (resolution IN ('restored', 'removed')),
+    resolution_note TEXT,
+    resolved_by UUID,
+    resolved_at TIMESTAMPTZ,
+    UNIQUE (grumble_id, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_grumble_reports_unresolved ON grumble_reports(grumble_id) WHERE resolution IS NULL;
@@ -0,0 +1,29 @@
+-- 管理操作の監査ログ
+-- 投稿の削除/再公開、徳ポイントの調整、判定レビュー、異議申し立て・通報の処理をすべて記録する
+-- 追記専用：UPDATE / DELETE はトリガーで拒否する
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    entry_id BIGSERIAL PRIMARY KEY,
+    actor_id UUID NOT NULL,
+    action VARCHAR(40) NOT NULL,
+    target_type VARCHAR(20) NOT NULL,
+    target_id TEXT NOT NULL,
+    reason TEXT,
+    detail JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_created_at ON admin_audit_log(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_actor ON admin_audit_log(actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_target ON admin_audit_log(target_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION reject_admin_audit_log_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS admin_audit_log_immutable ON admin_audit_log;
+CREATE TRIGGER admin_audit_log_immutable
+    BEFORE UPDATE OR DELETE ON admin_audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_admin_audit_log_change();
@@ -0,0 +1,28 @@
+-- ユーザー制裁（警告 / 投稿クールダウン / シャドウBAN / BAN）
+-- 一定期間内の拒否回数に応じて自動で段階的に付与されるほか、管理者が手動で付与・解除する
+-- 'shadowed': シャドウBAN中の投稿（投稿者本人にだけ公開済みとして見える）
+
+ALTER TABLE grumbles DROP CONSTRAINT IF EXISTS grumbles_moderation_status_check;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected', 'hidden', 'removed', 'shadowed'));
+
+ALTER TABLE grumbles_archive DROP CONSTRAINT IF EXISTS grumbles_archive_moderation_status_check;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'pending', 'rejected', 'hidden', 'removed', 'shadowed'));
+
+CREATE TABLE IF NOT EXISTS user_sanctions (
+    sanction_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES anonymous_users(user_id) ON DELETE CASCADE,
+    level VARCHAR(20) NOT NULL CHECK (level IN ('warning', 'cooldown', 'shadow_ban', 'ban')),
+    source VARCHAR(20) NOT NULL CHECK (source IN ('automatic', 'manual')),
+    reason TEXT NOT NULL,
+    issued_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- NULL は解除されるまで無期限
+    expires_at TIMESTAMPTZ,
+    revoked_by UUID,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_sanctions_user ON user_sanctions(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_sanctions_active ON user_sanctions(user_id) WHERE revoked_at IS NULL;
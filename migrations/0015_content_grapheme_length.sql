@@ -0,0 +1,12 @@
+-- 本文の長さはアプリケーション側で正規化後の書記素クラスタ数（最大280）として検証する
+-- 絵文字の結合列などは1文字でも複数のコードポイントになるため、
+-- length()（コードポイント数）による280の制約では正当な投稿が拒否されてしまう
+-- DB側は異常に長い入力を防ぐ上限だけを残す
+
+ALTER TABLE grumbles DROP CONSTRAINT IF EXISTS grumbles_content_check;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_content_check
+    CHECK (length(content) BETWEEN 1 AND 2800);
+
+ALTER TABLE grumbles_archive DROP CONSTRAINT IF EXISTS grumbles_archive_content_check;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_content_check
+    CHECK (length(content) BETWEEN 1 AND 2800);
@@ -0,0 +1,14 @@
+-- 投稿ログ（スパム・重複投稿の検出用）
+-- 本文は保持せず、正規化した本文のハッシュとSimHashだけを記録する
+-- 投稿が期限切れで削除されても、1日の投稿上限と重複判定の期間中は残す
+CREATE TABLE IF NOT EXISTS grumble_post_log (
+    post_log_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES anonymous_users(user_id) ON DELETE CASCADE,
+    content_hash CHAR(64) NOT NULL,
+    simhash BIGINT NOT NULL,
+    content_length INTEGER NOT NULL,
+    posted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_grumble_post_log_user ON grumble_post_log(user_id, posted_at);
+CREATE INDEX IF NOT EXISTS idx_grumble_post_log_posted_at ON grumble_post_log(posted_at);
@@ -0,0 +1,20 @@
+-- 投稿者による削除
+-- 削除した投稿は物理削除せず grumbles_archive へ移動し、理由を記録する
+-- 'expired': 期限切れでアーカイブバッチが移動
+-- 'deleted_by_author': 投稿者が削除（イベントアーカイブには表示しないが、統計とモデレーション履歴には残す）
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS archive_reason VARCHAR(30) NOT NULL DEFAULT 'expired'
+    CHECK (archive_reason IN ('expired', 'deleted_by_author'));
+
+-- アーカイブした投稿への「わかる…」
+-- grumbles からの削除で ON DELETE CASCADE により失われないよう、移動前に退避する
+-- grumble_id は外部キーにしない（grumbles_archive と同様に元の投稿が消えても残すため）
+CREATE TABLE IF NOT EXISTS vibes_archive (
+    vibe_id BIGINT PRIMARY KEY,
+    grumble_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES anonymous_users(user_id) ON DELETE CASCADE,
+    vibe_type VARCHAR(20) NOT NULL,
+    voted_at TIMESTAMPTZ NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_vibes_archive_grumble_id ON vibes_archive(grumble_id);
@@ -0,0 +1,17 @@
+-- 投稿直後の編集
+-- 投稿者は投稿から一定時間内かつ「わかる…」が付く前に限り、本文と毒レベルを編集できる
+-- edited_at: 最後に編集された日時（未編集は NULL）
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+
+-- 編集履歴（編集で置き換えられる前の本文と毒レベル）
+-- grumble_id は外部キーにしない（grumbles_archive へ移動しても履歴を残すため）
+CREATE TABLE IF NOT EXISTS grumble_revisions (
+    revision_id BIGSERIAL PRIMARY KEY,
+    grumble_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    toxic_level INTEGER NOT NULL CHECK (toxic_level BETWEEN 1 AND 5),
+    edited_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_grumble_revisions_grumble_id ON grumble_revisions(grumble_id, edited_at);
@@ -0,0 +1,19 @@
+-- ユーザーごとのタイムゾーン
+-- anonymous_users.timezone: IANAタイムゾーン名（例: America/New_York）。NULL は既定の Asia/Tokyo
+ALTER TABLE anonymous_users ADD COLUMN IF NOT EXISTS timezone VARCHAR(64);
+
+-- 投稿時の投稿者のタイムゾーンと、そのタイムゾーンでの投稿日
+-- 深夜0時の期限とイベントアーカイブの日付はこのタイムゾーンで決まる
+-- expires_at は絶対時刻なので、アーカイブバッチはタイムゾーンが混在しても変更不要
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo';
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS posted_on DATE;
+UPDATE grumbles SET posted_on = (posted_at AT TIME ZONE timezone)::date WHERE posted_on IS NULL;
+ALTER TABLE grumbles ALTER COLUMN posted_on SET NOT NULL;
+
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo';
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS posted_on DATE;
+UPDATE grumbles_archive SET posted_on = (posted_at AT TIME ZONE timezone)::date WHERE posted_on IS NULL;
+ALTER TABLE grumbles_archive ALTER COLUMN posted_on SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_posted_on ON grumbles(posted_on);
+CREATE INDEX IF NOT EXISTS idx_grumbles_archive_posted_on ON grumbles_archive(posted_on);
@@ -0,0 +1,97 @@
+-- 投稿のライフサイクル状態
+-- is_purified・archive_reason・テーブルの所在に分散していた状態を status 1列にまとめる
+-- 'pending':  非同期モデレーション待ち
+-- 'active':   タイムラインに載っている（公開範囲は moderation_status で決まる）
+-- 'purified': 成仏した（アーカイブ後も purified のまま）
+-- 'expired':  寿命を過ぎてアーカイブバッチが移動した
+-- 'removed':  モデレーションで却下・削除された（異議申し立てや復元で active、寿命を過ぎていれば expired に戻る）
+-- 'deleted':  投稿者が削除した（イベントアーカイブには表示しないが、統計とモデレーション履歴には残す）
+-- 許可される遷移は grumble ドメインパッケージで管理する
+
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS status VARCHAR(20);
+UPDATE grumbles SET status = CASE
+    WHEN moderation_status IN ('rejected', 'removed') THEN 'removed'
+    WHEN is_purified THEN 'purified'
+    WHEN moderation_status = 'pending' THEN 'pending'
+    ELSE 'active'
+END
+WHERE status IS NULL;
+ALTER TABLE grumbles ALTER COLUMN status SET NOT NULL;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_status_check
+    CHECK (status IN ('pending', 'active', 'purified', 'expired', 'removed', 'deleted'));
+
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS status VARCHAR(20);
+UPDATE grumbles_archive SET status = CASE
+    WHEN archive_reason = 'deleted_by_author' THEN 'deleted'
+    WHEN moderation_status IN ('rejected', 'removed') THEN 'removed'
+    WHEN is_purified THEN 'purified'
+    ELSE 'expired'
+END
+WHERE status IS NULL;
+ALTER TABLE grumbles_archive ALTER COLUMN status SET NOT NULL;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_status_check
+    CHECK (status IN ('pending', 'active', 'purified', 'expired', 'removed', 'deleted'));
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_status ON grumbles(status);
+CREATE INDEX IF NOT EXISTS idx_grumbles_archive_status ON grumbles_archive(status);
+
+-- 統計ビューを status で再作成（is_purified を削除するため先に落とす）
+DROP VIEW IF EXISTS grumble_stats;
+DROP VIEW IF EXISTS grumble_stats_toxic;
+
+ALTER TABLE grumbles DROP COLUMN IF EXISTS is_purified;
+ALTER TABLE grumbles_archive DROP COLUMN IF EXISTS is_purified;
+ALTER TABLE grumbles_archive DROP COLUMN IF EXISTS archive_reason;
+
+CREATE OR REPLACE VIEW grumble_stats AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumbles
+ GROUP BY 1,2
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2;
+
+CREATE OR REPLACE VIEW grumble_stats_toxic AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumbles
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3;
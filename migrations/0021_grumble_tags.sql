@@ -0,0 +1,36 @@
+-- 投稿のタグ（ハッシュタグとAIが提案したテーマ）
+-- 投稿時に本文の #タグ を解析し、ハッシュタグがなければAIにテーマを1つ提案させる
+-- タグ名は NFKC 正規化・小文字化した値で一意に管理する
+-- 既存の投稿にはタグを付けない
+CREATE TABLE IF NOT EXISTS tags (
+    tag_id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 投稿とタグの対応
+-- source: 'hashtag' は本文に書かれたタグ、'topic' はAIが提案したテーマ
+-- position: 本文での出現順
+CREATE TABLE IF NOT EXISTS grumble_tags (
+    grumble_id UUID NOT NULL REFERENCES grumbles(grumble_id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(tag_id),
+    source VARCHAR(10) NOT NULL CHECK (source IN ('hashtag', 'topic')),
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (grumble_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_grumble_tags_tag_id ON grumble_tags(tag_id);
+
+-- アーカイブした投稿のタグ
+-- grumbles からの削除で ON DELETE CASCADE により失われないよう、移動前に退避する
+-- grumble_id は外部キーにしない（vibes_archive と同様）
+CREATE TABLE IF NOT EXISTS grumble_tags_archive (
+    grumble_id UUID NOT NULL,
+    tag_id INTEGER NOT NULL REFERENCES tags(tag_id),
+    source VARCHAR(10) NOT NULL CHECK (source IN ('hashtag', 'topic')),
+    position SMALLINT NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (grumble_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_grumble_tags_archive_tag_id ON grumble_tags_archive(tag_id);
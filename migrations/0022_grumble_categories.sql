@@ -0,0 +1,72 @@
+-- 投稿のカテゴリ（仕事・家庭・通勤・学校…）
+-- カテゴリ一覧は設定（GRUMBLE_CATEGORIES）で管理するため、キーだけを保存し外部キーにはしない
+-- 未選択の投稿は NULL
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS category VARCHAR(30);
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS category VARCHAR(30);
+
+CREATE INDEX IF NOT EXISTS idx_grumbles_category ON grumbles(category, posted_at DESC);
+CREATE INDEX IF NOT EXISTS idx_grumbles_archive_category ON grumbles_archive(category);
+
+-- 統計ビューにカテゴリ別の内訳を追加（列が増えるため作り直す）
+-- カテゴリ横断の集計は category を SUM でまとめて求める
+DROP VIEW IF EXISTS grumble_stats;
+DROP VIEW IF EXISTS grumble_stats_toxic;
+
+CREATE VIEW grumble_stats AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumbles
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3;
+
+CREATE VIEW grumble_stats_toxic AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumbles
+ GROUP BY 1,2,3,4
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3,4
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumbles
+ GROUP BY 1,2,3,4;
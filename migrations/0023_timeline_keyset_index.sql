@@ -0,0 +1,8 @@
+-- タイムラインのカーソルページネーション用インデックス
+-- (posted_at, grumble_id) の降順で次のページを辿るため、同時刻の投稿も grumble_id で順序を固定する
+CREATE INDEX IF NOT EXISTS idx_grumbles_posted_at_grumble_id ON grumbles(posted_at DESC, grumble_id DESC);
+CREATE INDEX IF NOT EXISTS idx_grumbles_archive_posted_at_grumble_id ON grumbles_archive(posted_at DESC, grumble_id DESC);
+
+-- 上記のインデックスで代替できるため削除
+DROP INDEX IF EXISTS idx_grumbles_posted_at;
+DROP INDEX IF EXISTS idx_grumbles_archive_posted_at;
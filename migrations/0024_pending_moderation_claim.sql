@@ -0,0 +1,4 @@
+-- 非同期モデレーションの処理中の印
+-- ワーカーが同じ投稿を二重に判定・通知しないよう、取得時に時刻を記録して他のワーカーからは除外する
+-- ワーカーが途中で止まった場合は一定時間（リース）後に再び取得できる
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS moderation_claimed_at TIMESTAMPTZ;
@@ -0,0 +1,29 @@
+-- 状態ごとに持ち主を1つにする
+-- モデレーション待ちと非公開化はライフサイクル（status）が持ち、moderation_status は公開範囲と非公開の理由だけを持つ
+-- 'pending' は status だけで表し、モデレーション待ちの投稿の moderation_status は通過後の公開範囲（published）にする
+UPDATE grumbles SET moderation_status = 'published' WHERE moderation_status = 'pending';
+
+-- モデレーション待ちのままアーカイブされた投稿は一度も判定されていないので、公開せず removed にする
+-- （0020 で expired にしていたものも含む。投稿者が削除したものは deleted のまま）
+UPDATE grumbles_archive
+SET status = CASE WHEN status = 'deleted' THEN status ELSE 'removed' END,
+    moderation_status = 'published'
+WHERE moderation_status = 'pending';
+
+-- 通報で非表示になった投稿もタイムラインから外れているので removed にする
+UPDATE grumbles SET status = 'removed'
+WHERE moderation_status IN ('rejected', 'hidden', 'removed') AND status NOT IN ('removed', 'deleted');
+UPDATE grumbles_archive SET status = 'removed'
+WHERE moderation_status IN ('rejected', 'hidden', 'removed') AND status NOT IN ('removed', 'deleted');
+
+ALTER TABLE grumbles DROP CONSTRAINT IF EXISTS grumbles_moderation_status_check;
+ALTER TABLE grumbles ADD CONSTRAINT grumbles_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'rejected', 'hidden', 'removed', 'shadowed'));
+
+ALTER TABLE grumbles_archive DROP CONSTRAINT IF EXISTS grumbles_archive_moderation_status_check;
+ALTER TABLE grumbles_archive ADD CONSTRAINT grumbles_archive_moderation_status_check
+    CHECK (moderation_status IN ('published', 'held', 'rejected', 'hidden', 'removed', 'shadowed'));
+
+-- モデレーションワーカーは status で待ちの投稿を取得する
+DROP INDEX IF EXISTS idx_grumbles_pending_moderation;
+CREATE INDEX IF NOT EXISTS idx_grumbles_pending_moderation ON grumbles(posted_at) WHERE status = 'pending';
@@ -0,0 +1,75 @@
+-- 統計ビューをアーカイブ済みの投稿も含めて作り直す
+-- 寿命を過ぎた投稿はアーカイブテーブルへ移るため、稼働中のテーブルだけでは過去のバケットが消えてしまう
+-- 集計対象は公開された投稿のみ（モデレーション待ち・非公開・削除された投稿は含めない）
+DROP VIEW IF EXISTS grumble_stats;
+DROP VIEW IF EXISTS grumble_stats_toxic;
+DROP VIEW IF EXISTS grumble_stats_source;
+
+CREATE VIEW grumble_stats_source AS
+SELECT posted_at, category, toxic_level, status, vibe_count
+  FROM (
+        SELECT posted_at, category, toxic_level, status, vibe_count, moderation_status FROM grumbles
+        UNION ALL
+        SELECT posted_at, category, toxic_level, status, vibe_count, moderation_status FROM grumbles_archive
+       ) g
+ WHERE status IN ('active', 'purified', 'expired')
+   AND moderation_status = 'published';
+
+CREATE VIEW grumble_stats AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumble_stats_source
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumble_stats_source
+ GROUP BY 1,2,3
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       category,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumble_stats_source
+ GROUP BY 1,2,3;
+
+CREATE VIEW grumble_stats_toxic AS
+SELECT 'day' AS granularity,
+       date_trunc('day', posted_at AT TIME ZONE 'UTC') AS bucket,
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified')     AS purified_count,
+       COUNT(*) FILTER (WHERE status <> 'purified')    AS unpurified_count,
+       SUM(vibe_count)                                 AS total_vibes
+  FROM grumble_stats_source
+ GROUP BY 1,2,3,4
+UNION ALL
+SELECT 'week',
+       date_trunc('week', posted_at AT TIME ZONE 'UTC'),
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumble_stats_source
+ GROUP BY 1,2,3,4
+UNION ALL
+SELECT 'month',
+       date_trunc('month', posted_at AT TIME ZONE 'UTC'),
+       category,
+       toxic_level,
+       COUNT(*) FILTER (WHERE status = 'purified'),
+       COUNT(*) FILTER (WHERE status <> 'purified'),
+       SUM(vibe_count)
+  FROM grumble_stats_source
+ GROUP BY 1,2,3,4;
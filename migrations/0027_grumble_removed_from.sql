@@ -0,0 +1,5 @@
+-- 通報で非表示・管理者が削除する前のライフサイクル状態
+-- 復元時にこの状態へ戻すため、成仏済みの投稿は成仏済みのまま復元される（再び成仏して徳が二重に付与されない）
+-- removed でない投稿は NULL
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS removed_from VARCHAR(20);
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS removed_from VARCHAR(20);
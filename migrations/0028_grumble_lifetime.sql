@@ -0,0 +1,14 @@
+-- 投稿時に投稿者が選んだ寿命（'6h' などの期間、または 'midnight'）
+-- 異議申し立てが認められて再公開するとき、同じ寿命をもう一度与えるために保存する
+-- 既存の投稿は選んだ寿命が分からないため、投稿から期限までの長さを秒数の期間（'21600s' など）として埋める
+ALTER TABLE grumbles ADD COLUMN IF NOT EXISTS lifetime VARCHAR(20);
+UPDATE grumbles
+SET lifetime = floor(extract(epoch FROM expires_at - posted_at))::bigint || 's'
+WHERE lifetime IS NULL;
+ALTER TABLE grumbles ALTER COLUMN lifetime SET NOT NULL;
+
+ALTER TABLE grumbles_archive ADD COLUMN IF NOT EXISTS lifetime VARCHAR(20);
+UPDATE grumbles_archive
+SET lifetime = floor(extract(epoch FROM expires_at - posted_at))::bigint || 's'
+WHERE lifetime IS NULL;
+ALTER TABLE grumbles_archive ALTER COLUMN lifetime SET NOT NULL;